@@ -17,6 +17,7 @@ import (
 	"github.com/hibiken/asynq"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/redis/go-redis/v9"
 	"github.com/swaggest/swgui"
 	"github.com/swaggest/swgui/v5emb"
 	"go.megpoid.dev/go-skel/app/controller"
@@ -26,41 +27,82 @@ import (
 	"go.megpoid.dev/go-skel/config"
 	"go.megpoid.dev/go-skel/oapi"
 	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/conditional"
+	"go.megpoid.dev/go-skel/pkg/health"
 	"go.megpoid.dev/go-skel/pkg/i18n"
+	"go.megpoid.dev/go-skel/pkg/idempotency"
+	"go.megpoid.dev/go-skel/pkg/jwks"
+	"go.megpoid.dev/go-skel/pkg/metrics"
 	mwpkg "go.megpoid.dev/go-skel/pkg/middleware"
+	"go.megpoid.dev/go-skel/pkg/migration"
+	"go.megpoid.dev/go-skel/pkg/principal"
+	"go.megpoid.dev/go-skel/pkg/ratelimit"
+	"go.megpoid.dev/go-skel/pkg/render"
 	"go.megpoid.dev/go-skel/pkg/sql"
+	"go.megpoid.dev/go-skel/pkg/sse"
 	"go.megpoid.dev/go-skel/pkg/task"
 	"go.megpoid.dev/go-skel/pkg/validator"
 	"go.megpoid.dev/go-skel/web"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
 )
 
 const (
 	shutdownTimeout = 30 * time.Second
+	metricsPath     = "/metrics"
+	jwksPath        = "/.well-known/jwks.json"
+	// cleanupInterval is how often the expired idempotency keys and tokens are purged
+	cleanupInterval = time.Hour
+	// keyRefreshInterval is how often the signing keys are rotated, if due, and reloaded from the database
+	keyRefreshInterval = time.Minute
+	// keyRotateTimeout bounds the rotation, which waits for the lock of the active key held by other instances
+	keyRotateTimeout = 30 * time.Second
+	// keyPublishDelay covers the reload of the keys on the other instances and the cache of the key set
+	keyPublishDelay = 2*keyRefreshInterval + jwks.CacheMaxAge
 )
 
 type Config struct {
-	General  config.GeneralSettings
-	Database config.DatabaseSettings
-	Server   config.ServerSettings
-	OIDC     config.OIDCSettings
+	General   config.GeneralSettings
+	Database  config.DatabaseSettings
+	Server    config.ServerSettings
+	OIDC      config.OIDCSettings
+	Telemetry config.TelemetrySettings
+	Storage   config.StorageSettings
 }
 
 type App struct {
-	cfg        Config
-	conn       sql.Database
-	Server     *http.Server
-	EchoServer *echo.Echo
+	cfg       Config
+	conn      sql.Database
+	hub       *sql.Hub
+	hubCancel context.CancelFunc
+	// closes the open task streams on shutdown
+	streamCancel context.CancelFunc
+	health       *health.Registry
+	// remove the expired idempotency keys and tokens in the background
+	idempotencyRepo repository.IdempotencyRepo
+	authUsecase     usecase.Auth
+	cleanupCancel   context.CancelFunc
+	// rotates the asymmetric signing keys, nil if the tokens are signed with the jwt secret
+	signingKeyUsecase usecase.SigningKey
+	// shared store of the rate limits, nil if the limits are kept in memory
+	rateLimitClient *redis.Client
+	Server          *http.Server
+	EchoServer      *echo.Echo
+	// optional listener used only for the metrics
+	metricsServer *http.Server
 }
 
 func NewApp(cfg Config) (*App, error) {
 	s := &App{cfg: cfg}
 
 	dbConfig := sql.Config{
-		DataSourceName:  cfg.Database.DataSourceName,
-		ConnMaxLifetime: cfg.Database.ConnMaxLifetime,
-		ConnMaxIdleTime: cfg.Database.ConnMaxIdleTime,
-		MaxOpenConns:    cfg.Database.MaxOpenConns,
-		MaxIdleConns:    cfg.Database.MaxIdleConns,
+		DataSourceName:     cfg.Database.DataSourceName,
+		ConnMaxLifetime:    cfg.Database.ConnMaxLifetime,
+		ConnMaxIdleTime:    cfg.Database.ConnMaxIdleTime,
+		MaxOpenConns:       cfg.Database.MaxOpenConns,
+		MaxIdleConns:       cfg.Database.MaxIdleConns,
+		SlowQueryThreshold: cfg.Database.SlowQuery,
+		// always collect the query statistics, exposed on the admin endpoint
+		QueryStats: sql.NewQueryStats(0),
 	}
 
 	if cfg.General.Debug {
@@ -68,19 +110,57 @@ func NewApp(cfg Config) (*App, error) {
 		dbConfig.OmitArgs = false
 	}
 
+	// The spec and the rate limits are read before opening any connection, so their errors don't leave one open
+	spec, err := oapi.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("error loading spec: %w", err)
+	}
+
+	spec.Servers = openapi3.Servers{&openapi3.Server{URL: controller.BaseURL()}}
+
+	var limits *rateLimits
+	if cfg.Server.RateLimitStore != "none" {
+		limits, err = newRateLimits(cfg.Server, spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Database initialization
 	pool, err := sql.NewConnection(dbConfig)
 	if err != nil {
 		return nil, err
 	}
 
+	// Apply or check the migrations before anything uses the database
+	if err := migrateDatabase(context.Background(), pool, cfg.General); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
 	s.conn = sql.NewPgxPool(pool)
 
+	// Notification hub, shares the connection settings of the pool
+	s.hub = sql.NewHub(sql.HubConfig{ConnConfig: pool.Config().ConnConfig})
+
+	// The repos join the transaction bound to the request context, if any, so the batch operations share a single
+	// transaction and their writes are rolled back with it
+	executor := sql.NewContextExecutor(s.conn)
+
 	// Repository initialization (not attached to the unit of work)
 	healthcheckRepo := repository.NewHealthCheck(s.conn)
+	eventRepo := repository.NewEvent(s.conn, s.hub)
+	s.idempotencyRepo = repository.NewIdempotency(executor)
 
 	// Unit of Work initialization (all repos are initialized here)
-	unitOfWork := uow.New(s.conn)
+	unitOfWork := uow.New(executor)
+
+	// Storage of the uploaded files
+	storage, err := NewStorage(cfg.Storage)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to create storage: %w", err)
+	}
 
 	// Redis client config
 	redisClient := asynq.RedisClientOpt{
@@ -103,18 +183,111 @@ func NewApp(cfg Config) (*App, error) {
 	}
 
 	// Usecase initialization
-	authUsecase := usecase.NewAuth(cfg.Server.JwtSecret)
-	healthcheckUsecase := usecase.NewHealthcheck(healthcheckRepo)
-	profileUsecase := usecase.NewProfile(unitOfWork)
 	taskUsecase := task.NewClient(redisClient)
 
+	// Health checks of the dependencies, reported on the readiness endpoint
+	s.health = health.NewRegistry()
+	s.health.Register(health.Check{
+		Name:     "database",
+		Check:    healthcheckRepo.Execute,
+		Timeout:  2 * time.Second,
+		Critical: true,
+	})
+	s.health.Register(health.Check{
+		Name: "migrations",
+		// only reads the applied migrations, so it works with a read-only role
+		Check: func(ctx context.Context) error {
+			pending, err := migration.PendingMigrations(ctx, pool, migration.DefaultTableName, migrationAssets())
+			if err != nil {
+				return err
+			}
+			if len(pending) > 0 {
+				return fmt.Errorf("%d pending migrations, next: %s", len(pending), pending[0])
+			}
+			return nil
+		},
+		Timeout: 2 * time.Second,
+		// the pending migrations only prevent the start in strict mode, so they only fail the readiness there
+		Critical: cfg.General.StrictMigrations,
+	})
+	s.health.Register(health.Check{
+		Name:    "redis",
+		Check:   taskUsecase.Ping,
+		Timeout: 2 * time.Second,
+	})
+	s.health.Register(health.Check{
+		Name:    "storage",
+		Check:   storage.Ping,
+		Timeout: 2 * time.Second,
+	})
+	if cfg.OIDC.IssuerURL != "" {
+		s.health.Register(health.Check{
+			Name:  "oidc",
+			Check: health.HTTPCheck(nil, strings.TrimSuffix(cfg.OIDC.IssuerURL, "/")+"/.well-known/openid-configuration"),
+		})
+	}
+
+	// Keys of the access tokens, the asymmetric keys are shared by the instances through the database
+	var tokenKeys jwks.Keys = jwks.Secret(cfg.Server.JwtSecret)
+	var keyRing *jwks.KeyRing
+	if jwks.Asymmetric(cfg.Server.JwtAlgorithm) {
+		keyRing = jwks.NewKeyRing(jwks.WithPublishDelay(keyPublishDelay))
+		s.signingKeyUsecase = usecase.NewSigningKey(unitOfWork, keyRing, usecase.SigningKeySettings{
+			Algorithm: cfg.Server.JwtAlgorithm,
+			Secret:    cfg.Server.JwtSecret,
+			Rotation:  cfg.Server.JwtKeyRotation,
+			Validity:  keyPublishDelay + cfg.Server.AccessTokenTTL,
+		})
+
+		rotateCtx, rotateCancel := context.WithTimeout(context.Background(), keyRotateTimeout)
+		err = s.signingKeyUsecase.Rotate(rotateCtx)
+		rotateCancel()
+		if err != nil {
+			pool.Close()
+			return nil, err
+		}
+		tokenKeys = keyRing
+	}
+
+	authUsecase := usecase.NewAuth(unitOfWork, usecase.AuthSettings{
+		Keys:            tokenKeys,
+		AccessTokenTTL:  cfg.Server.AccessTokenTTL,
+		RefreshTokenTTL: cfg.Server.RefreshTokenTTL,
+		Lockout: usecase.Lockout{
+			MaxAttempts: cfg.Server.LoginMaxAttempts,
+			Duration:    cfg.Server.LoginLockout,
+		},
+	})
+	s.authUsecase = authUsecase
+	healthcheckUsecase := usecase.NewHealthcheck(s.health)
+	profileUsecase := usecase.NewProfile(unitOfWork)
+	profileImportUsecase := usecase.NewProfileImport(unitOfWork, storage)
+	batchUsecase := usecase.NewBatch(unitOfWork)
+	eventUsecase := usecase.NewEvent(eventRepo, usecase.ScopeEventAuthorizer)
+	apiKeyUsecase := usecase.NewAPIKey(unitOfWork)
+
+	// Metrics initialization
+	registry := metrics.NewRegistry()
+	registry.MustRegister(
+		metrics.NewPoolCollector(pool),
+		metrics.NewRepoErrorCollector(),
+		metrics.NewQueueCollector(taskUsecase.Inspector()),
+	)
+
 	// Controller initialization
+	var streamCtx context.Context
+	streamCtx, s.streamCancel = context.WithCancel(context.Background())
+
 	ctrl := controller.Controller{
-		AuthController:        controller.NewAuth(cfg.Server, authUsecase, oidcHandler),
-		ProfileController:     controller.NewProfile(cfg.Server, profileUsecase),
-		HealthcheckController: controller.NewHealthCheck(cfg.Server, healthcheckUsecase),
-		TaskController:        controller.NewTask(cfg.Server, taskUsecase),
-		DelayController:       controller.NewDelay(cfg.Server, taskUsecase),
+		AdminController:         controller.NewAdmin(cfg.Server, dbConfig.QueryStats),
+		AuthController:          controller.NewAuth(cfg.Server, authUsecase, oidcHandler),
+		ProfileController:       controller.NewProfile(cfg.Server, profileUsecase),
+		ProfileImportController: controller.NewProfileImport(cfg.Server, profileImportUsecase, taskUsecase),
+		HealthcheckController:   controller.NewHealthCheck(cfg.Server, healthcheckUsecase),
+		TaskController:          controller.NewTask(cfg.Server, taskUsecase, streamCtx),
+		DelayController:         controller.NewDelay(cfg.Server, taskUsecase),
+		EventController:         controller.NewEvent(cfg.Server, eventUsecase),
+		APIKeyController:        controller.NewAPIKey(cfg.Server, apiKeyUsecase),
 	}
 
 	// HTTP server initialization
@@ -122,15 +295,32 @@ func NewApp(cfg Config) (*App, error) {
 	e.HideBanner = true
 	e.HidePort = true
 	e.Debug = cfg.General.Debug
+	e.IPExtractor = newIPExtractor(cfg.Server.TrustedProxies)
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: cfg.Server.CorsAllowOrigins,
 		AllowMethods: []string{http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete},
+		ExposeHeaders: []string{
+			conditional.HeaderETag, echo.HeaderLastModified, render.HeaderLink, render.HeaderTotalCount,
+			idempotency.HeaderReplayed, echo.HeaderRetryAfter, ratelimit.HeaderRateLimitLimit,
+			ratelimit.HeaderRateLimitRemaining, ratelimit.HeaderRateLimitReset, ratelimit.HeaderRateLimitPolicy,
+		},
 	}))
 	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
 		Skipper: func(ctx echo.Context) bool {
+			if strings.Contains(ctx.Request().Header.Get(echo.HeaderAccept), sse.MIMETextEventStream) {
+				return true
+			}
 			return strings.HasPrefix(ctx.Path(), controller.BaseURL()+"/swagger")
 		},
 	}))
+	e.Use(otelecho.Middleware(cfg.Telemetry.ServiceName, otelecho.WithSkipper(func(ctx echo.Context) bool {
+		return strings.HasPrefix(ctx.Path(), controller.BaseURL()+"/swagger")
+	})))
+	if cfg.Telemetry.Metrics {
+		e.Use(metrics.HTTPMiddleware(registry, func(ctx echo.Context) bool {
+			return ctx.Path() == metricsPath || strings.HasPrefix(ctx.Path(), controller.BaseURL()+"/swagger")
+		}))
+	}
 	e.Use(middleware.Recover())
 	e.Use(i18n.LoadMessagePrinter("user_lang"))
 	e.Use(middleware.Logger())
@@ -140,6 +330,9 @@ func NewApp(cfg Config) (*App, error) {
 	e.HTTPErrorHandler = apperror.ErrorHandler(e)
 	s.EchoServer = e
 
+	// the batch operations are dispatched through the router
+	ctrl.BatchController = controller.NewBatch(cfg.Server, batchUsecase, e)
+
 	// Serve Swagger UI
 	handler := v5emb.NewHandlerWithConfig(swgui.Config{
 		Title:       "Skel API",
@@ -150,27 +343,23 @@ func NewApp(cfg Config) (*App, error) {
 		},
 	})
 
-	spec, err := oapi.GetSwagger()
-	if err != nil {
-		return nil, fmt.Errorf("error loading spec: %w", err)
-	}
-
-	spec.Servers = openapi3.Servers{&openapi3.Server{URL: controller.BaseURL()}}
-
 	skipperFunc := mwpkg.WithSkipperFunc(func(ctx echo.Context) bool {
 		path := ctx.Path()
-		return strings.HasPrefix(path, controller.BaseURL()+"/swagger")
+		return path == metricsPath || path == jwksPath || strings.HasPrefix(path, controller.BaseURL()+"/swagger")
 	})
 
-	jwtAuth := mwpkg.JWTAuth(cfg.Server.JwtSecret)
+	jwtAuth := mwpkg.JWTAuthWithConfig(controller.NewJWTConfig(tokenKeys), authUsecase.TokenRevoked)
 
 	keyAuth := mwpkg.KeyAuthWithConfig(middleware.KeyAuthConfig{
 		KeyLookup: "header:X-API-Key",
 		Validator: func(key string, ctx echo.Context) (bool, error) {
-			if key == "secret" {
-				return true, nil
+			p, err := apiKeyUsecase.Authenticate(ctx.Request().Context(), key)
+			if err != nil {
+				return false, err
 			}
-			return false, nil
+
+			ctx.SetRequest(ctx.Request().WithContext(principal.NewContext(ctx.Request().Context(), p)))
+			return true, nil
 		},
 	})
 
@@ -178,8 +367,47 @@ func NewApp(cfg Config) (*App, error) {
 
 	oapiMiddleware := mwpkg.OapiValidator(spec, skipperFunc, jwtAuth, keyAuth, oidcAuth)
 
+	rateLimitSkipper := func(ctx echo.Context) bool {
+		path := ctx.Path()
+		return path == metricsPath || path == jwksPath ||
+			strings.HasPrefix(path, controller.BaseURL()+"/health") ||
+			strings.HasPrefix(path, controller.BaseURL()+"/swagger")
+	}
+
+	var rateLimitStore ratelimit.Store
+	if limits != nil {
+		rateLimitStore, s.rateLimitClient = NewRateLimitStore(cfg.Server, cfg.General.RedisAddr)
+
+		// runs before the authentication, so the requests with invalid credentials are limited too
+		e.Use(ratelimit.Middleware(ratelimit.Config{
+			Skipper: rateLimitSkipper,
+			Store:   rateLimitStore,
+			Limit:   limits.client,
+			KeyFunc: ratelimit.ClientIP,
+		}))
+	}
+
 	e.Use(oapiMiddleware)
 
+	// runs after the authentication, so the limits are applied to the principal of the request
+	if limits != nil {
+		e.Use(ratelimit.Middleware(ratelimit.Config{
+			Skipper: rateLimitSkipper,
+			Store:   rateLimitStore,
+			Limit:   limits.principal,
+			Routes:  limits.routes,
+		}))
+	}
+
+	// runs after the authentication, so the rejected requests don't take the keys
+	e.Use(idempotency.Middleware(idempotency.Config{
+		Store: s.idempotencyRepo,
+		TTL:   cfg.Server.IdempotencyTTL,
+		// the responses can't take longer than the write timeout, the key of a request still running after that
+		// can be taken over by a retry
+		Lease: cfg.Server.WriteTimeout,
+	}))
+
 	group := e.Group(controller.BaseURL())
 	swagger := echo.WrapHandler(handler)
 	group.GET("/swagger", swagger)
@@ -189,6 +417,19 @@ func NewApp(cfg Config) (*App, error) {
 	assetHandler := http.FileServer(http.FS(oapi.Assets()))
 	group.GET("/swagger/docs/*", echo.WrapHandler(http.StripPrefix(controller.BaseURL()+"/swagger/docs/", assetHandler)))
 
+	if cfg.Telemetry.Metrics {
+		if cfg.Telemetry.MetricsListen != "" {
+			s.metricsServer = metrics.NewServer(cfg.Telemetry.MetricsListen, registry)
+		} else {
+			e.GET(metricsPath, echo.WrapHandler(metrics.Handler(registry)))
+		}
+	}
+
+	// only the asymmetric keys are published, the secret can't verify the tokens without signing them too
+	if keyRing != nil {
+		e.GET(jwksPath, jwks.Handler(keyRing))
+	}
+
 	web.New(e)
 
 	oapi.RegisterHandlersWithBaseURL(e, &ctrl, controller.BaseURL())
@@ -206,6 +447,34 @@ func (s *App) Start() error {
 
 	slog.Info("Starting server", "address", s.cfg.Server.ListenAddress)
 
+	var hubCtx context.Context
+	hubCtx, s.hubCancel = context.WithCancel(context.Background())
+	go func() {
+		if err := s.hub.Run(hubCtx); err != nil {
+			slog.Error("Error starting notification hub", slog.String("error", err.Error()))
+		}
+	}()
+
+	var cleanupCtx context.Context
+	cleanupCtx, s.cleanupCancel = context.WithCancel(context.Background())
+	go s.deleteExpired(cleanupCtx, "idempotency keys", s.idempotencyRepo.DeleteExpired)
+	go s.deleteExpired(cleanupCtx, "tokens", s.authUsecase.DeleteExpiredTokens)
+	if s.signingKeyUsecase != nil {
+		go s.deleteExpired(cleanupCtx, "signing keys", s.signingKeyUsecase.DeleteExpired)
+		go s.refreshSigningKeys(cleanupCtx)
+	}
+
+	if s.metricsServer != nil {
+		slog.Info("Starting metrics server", "address", s.metricsServer.Addr)
+
+		go func() {
+			err := s.metricsServer.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("Error starting metrics server", slog.String("error", err.Error()))
+			}
+		}()
+	}
+
 	go func() {
 		err := s.EchoServer.StartServer(s.Server)
 		if err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -217,6 +486,49 @@ func (s *App) Start() error {
 	return nil
 }
 
+// deleteExpired purges the expired records periodically. The expired idempotency keys are also replaced when
+// reused, and the expired tokens are rejected before they are purged.
+func (s *App) deleteExpired(ctx context.Context, name string, fn func(ctx context.Context) (int64, error)) {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := fn(ctx)
+			if err != nil {
+				slog.Error("Error deleting the expired "+name, slog.String("error", err.Error()))
+				continue
+			}
+			if n > 0 {
+				slog.Debug("Deleted expired "+name, slog.Int64("count", n))
+			}
+		}
+	}
+}
+
+// refreshSigningKeys rotates the signing key when it's due and loads the keys created by the other instances.
+func (s *App) refreshSigningKeys(ctx context.Context) {
+	ticker := time.NewTicker(keyRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rotateCtx, cancel := context.WithTimeout(ctx, keyRotateTimeout)
+			err := s.signingKeyUsecase.Rotate(rotateCtx)
+			cancel()
+			if err != nil {
+				slog.Error("Error refreshing the signing keys", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
 func (s *App) stopHTTPServer() {
 	if s.Server != nil {
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
@@ -235,6 +547,33 @@ func (s *App) stopHTTPServer() {
 }
 
 func (s *App) Shutdown() {
+	// fail the readiness check first, so the load balancer stops sending new requests
+	s.health.Shutdown()
+	if s.cfg.Server.ShutdownDelay > 0 {
+		slog.Info("Waiting before stopping the server", slog.Duration("delay", s.cfg.Server.ShutdownDelay))
+		time.Sleep(s.cfg.Server.ShutdownDelay)
+	}
+
+	// closes the open event streams so the server can shut down gracefully
+	if s.hubCancel != nil {
+		s.hubCancel()
+	}
+	if s.streamCancel != nil {
+		s.streamCancel()
+	}
+	if s.cleanupCancel != nil {
+		s.cleanupCancel()
+	}
 	s.stopHTTPServer()
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Close(); err != nil {
+			slog.Error("App: Shutdown: metrics server close failed", slog.String("error", err.Error()))
+		}
+	}
+	if s.rateLimitClient != nil {
+		if err := s.rateLimitClient.Close(); err != nil {
+			slog.Error("App: Shutdown: rate limit client close failed", slog.String("error", err.Error()))
+		}
+	}
 	s.conn.Close()
 }
@@ -0,0 +1,63 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/oapi"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+type AdminController struct {
+	common
+	queryStats *sql.QueryStats
+}
+
+func NewAdmin(cfg config.ServerSettings, queryStats *sql.QueryStats) AdminController {
+	return AdminController{
+		common:     newCommon(cfg),
+		queryStats: queryStats,
+	}
+}
+
+func (ctrl *AdminController) ListQueryStats(ctx echo.Context, params oapi.ListQueryStatsParams) error {
+	stats := ctrl.queryStats.Snapshot()
+	if params.Limit != nil && *params.Limit >= 0 && *params.Limit < len(stats) {
+		stats = stats[:*params.Limit]
+	}
+
+	response := oapi.QueryStatList{
+		Since: ctrl.queryStats.Since(),
+		Items: make([]oapi.QueryStat, 0, len(stats)),
+	}
+
+	for _, stat := range stats {
+		response.Items = append(response.Items, oapi.QueryStat{
+			Statement: stat.Statement,
+			Calls:     stat.Calls,
+			Errors:    stat.Errors,
+			Rows:      stat.Rows,
+			TotalMs:   toMilliseconds(stat.Total),
+			MeanMs:    toMilliseconds(stat.Mean),
+			P95Ms:     toMilliseconds(stat.P95),
+			MaxMs:     toMilliseconds(stat.Max),
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, &response)
+}
+
+func (ctrl *AdminController) ResetQueryStats(ctx echo.Context) error {
+	ctrl.queryStats.Reset()
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func toMilliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.megpoid.dev/go-skel/app/controller/filter"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/usecase"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/oapi"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/principal"
+	"go.megpoid.dev/go-skel/pkg/render"
+)
+
+type APIKeyController struct {
+	common
+	apiKeyUsecase usecase.APIKey
+}
+
+func NewAPIKey(cfg config.ServerSettings, apiKey usecase.APIKey) APIKeyController {
+	return APIKeyController{
+		common:        newCommon(cfg),
+		apiKeyUsecase: apiKey,
+	}
+}
+
+// apiKeyCreated has the generated key, only returned when the key is created or rotated.
+type apiKeyCreated struct {
+	*model.APIKey
+	Key string `json:"key"`
+}
+
+// authenticated rejects the requests without a principal, the usecase doesn't restrict the keys to an owner when
+// it's missing.
+func (ctrl *APIKeyController) authenticated(ctx echo.Context) error {
+	if _, ok := principal.FromContext(ctx.Request().Context()); !ok {
+		t := ctrl.printer(ctx)
+		return apperror.NewAuthnError(t.Sprintf("Authentication required"), nil)
+	}
+
+	return nil
+}
+
+func (ctrl *APIKeyController) ListApiKeys(ctx echo.Context, params oapi.ListApiKeysParams) error {
+	if err := ctrl.authenticated(ctx); err != nil {
+		return err
+	}
+
+	query, err := filter.NewFilterFromParams(filter.Params(params))
+	if err != nil {
+		return err
+	}
+
+	result, err := ctrl.apiKeyUsecase.ListKeys(ctx.Request().Context(), query)
+	if err != nil {
+		return err
+	}
+
+	return render.List(ctx, http.StatusOK, result)
+}
+
+func (ctrl *APIKeyController) CreateApiKey(ctx echo.Context) error {
+	t := ctrl.printer(ctx)
+
+	if err := ctrl.authenticated(ctx); err != nil {
+		return err
+	}
+
+	var request oapi.ApiKeyRequest
+	if err := ctx.Bind(&request); err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to read request"), err)
+	}
+
+	req := &model.APIKeyRequest{
+		Name:      request.Name,
+		ExpiresAt: request.ExpiresAt,
+	}
+	if request.Owner != nil {
+		req.Owner = *request.Owner
+	}
+	if request.Scopes != nil {
+		req.Scopes = *request.Scopes
+	}
+	if err := ctx.Validate(req); err != nil {
+		return apperror.NewValidationError(t.Sprintf("The request did not pass validation"), err)
+	}
+
+	result, key, err := ctrl.apiKeyUsecase.CreateKey(ctx.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, apiKeyCreated{APIKey: result, Key: key})
+}
+
+func (ctrl *APIKeyController) RevokeApiKey(ctx echo.Context, id oapi.ApiKeyId) error {
+	if err := ctrl.authenticated(ctx); err != nil {
+		return err
+	}
+
+	if err := ctrl.apiKeyUsecase.RevokeKey(ctx.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (ctrl *APIKeyController) RotateApiKey(ctx echo.Context, id oapi.ApiKeyId) error {
+	if err := ctrl.authenticated(ctx); err != nil {
+		return err
+	}
+
+	result, key, err := ctrl.apiKeyUsecase.RotateKey(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, apiKeyCreated{APIKey: result, Key: key})
+}
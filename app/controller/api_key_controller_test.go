@@ -0,0 +1,131 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	appmodel "go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/usecase"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/model"
+	"go.megpoid.dev/go-skel/pkg/principal"
+	"go.megpoid.dev/go-skel/pkg/validator"
+)
+
+func TestAPIKeyController(t *testing.T) {
+	suite.Run(t, &apiKeySuite{})
+}
+
+type apiKeySuite struct {
+	suite.Suite
+	cfg config.ServerSettings
+}
+
+func (s *apiKeySuite) TestCreate() {
+	mockKey := appmodel.APIKey{
+		Model:  model.Model{ID: 1},
+		Name:   "deploy",
+		Owner:  "john",
+		Prefix: "sk_0123456789ab",
+		Hash:   "hash",
+		Scopes: appmodel.Scopes{"read"},
+	}
+
+	uc := usecase.NewMockAPIKey(s.T())
+	uc.EXPECT().CreateKey(mock.Anything, &appmodel.APIKeyRequest{Name: "deploy", Owner: "john", Scopes: []string{"read"}}).
+		Return(&mockKey, "sk_0123456789ab_secret", nil)
+
+	ctrl := NewAPIKey(s.cfg, uc)
+
+	e := echo.New()
+	e.Validator = validator.NewCustomValidator()
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"name":"deploy","owner":"john","scopes":["read"]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req = req.WithContext(principal.NewContext(req.Context(), &principal.Principal{Type: principal.TypeUser, Subject: "john"}))
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.CreateApiKey(ctx)
+	s.Require().NoError(err)
+	s.Equal(http.StatusCreated, rec.Code)
+	s.Contains(rec.Body.String(), `"key":"sk_0123456789ab_secret"`)
+	s.NotContains(rec.Body.String(), `"hash"`)
+}
+
+func (s *apiKeySuite) TestCreateInvalid() {
+	ctrl := NewAPIKey(s.cfg, usecase.NewMockAPIKey(s.T()))
+
+	e := echo.New()
+	e.Validator = validator.NewCustomValidator()
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"name":"","owner":"john"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req = req.WithContext(principal.NewContext(req.Context(), &principal.Principal{Type: principal.TypeUser, Subject: "john"}))
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.CreateApiKey(ctx)
+	var appErr *apperror.Error
+	s.Require().ErrorAs(err, &appErr)
+	s.Equal(http.StatusBadRequest, appErr.StatusCode)
+}
+
+func (s *apiKeySuite) TestRotate() {
+	mockKey := appmodel.APIKey{Model: model.Model{ID: 1}, Prefix: "sk_ba9876543210"}
+
+	uc := usecase.NewMockAPIKey(s.T())
+	uc.EXPECT().RotateKey(mock.Anything, int64(1)).Return(&mockKey, "sk_ba9876543210_secret", nil)
+
+	ctrl := NewAPIKey(s.cfg, uc)
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.POST, "/", nil)
+	req = req.WithContext(principal.NewContext(req.Context(), &principal.Principal{Type: principal.TypeUser, Subject: "john"}))
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.RotateApiKey(ctx, 1)
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"key":"sk_ba9876543210_secret"`)
+}
+
+func (s *apiKeySuite) TestRevoke() {
+	uc := usecase.NewMockAPIKey(s.T())
+	uc.EXPECT().RevokeKey(mock.Anything, int64(1)).Return(nil)
+
+	ctrl := NewAPIKey(s.cfg, uc)
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.DELETE, "/", nil)
+	req = req.WithContext(principal.NewContext(req.Context(), &principal.Principal{Type: principal.TypeUser, Subject: "john"}))
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.RevokeApiKey(ctx, 1)
+	s.Require().NoError(err)
+	s.Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *apiKeySuite) TestUnauthenticated() {
+	ctrl := NewAPIKey(s.cfg, usecase.NewMockAPIKey(s.T()))
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.DELETE, "/", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.RevokeApiKey(ctx, 1)
+	var appErr *apperror.Error
+	s.Require().ErrorAs(err, &appErr)
+	s.Equal(http.StatusUnauthorized, appErr.StatusCode)
+}
@@ -6,8 +6,10 @@ package controller
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
+	"go.megpoid.dev/go-skel/app/model"
 	"go.megpoid.dev/go-skel/app/usecase"
 	"go.megpoid.dev/go-skel/config"
 	"go.megpoid.dev/go-skel/oapi"
@@ -41,12 +43,112 @@ func (ctrl *AuthController) Login(ctx echo.Context) error {
 		return err
 	}
 
-	result, err := ctrl.auth.Login(ctx.Request().Context(), request.Username, request.Password)
+	result, err := ctrl.auth.Login(ctx.Request().Context(), request.Username, request.Password, ctx.RealIP())
 	if err != nil {
 		return err
 	}
 
-	return ctx.JSON(http.StatusOK, &oapi.Token{Token: result})
+	return ctx.JSON(http.StatusOK, newToken(result))
+}
+
+func (ctrl *AuthController) RefreshToken(ctx echo.Context) error {
+	t := ctrl.printer(ctx)
+
+	request := oapi.RefreshRequest{}
+	if err := ctx.Bind(&request); err != nil {
+		return apperror.NewValidationError(t.Sprintf("Invalid refresh request"), err)
+	}
+	if request.RefreshToken == "" {
+		return apperror.NewValidationError(t.Sprintf("Invalid refresh request"), nil)
+	}
+
+	result, err := ctrl.auth.Refresh(ctx.Request().Context(), request.RefreshToken, ctx.RealIP())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, newToken(result))
+}
+
+func (ctrl *AuthController) Logout(ctx echo.Context) error {
+	t := ctrl.printer(ctx)
+
+	claims, ok := ctrl.getClaims(ctx)
+	if !ok {
+		return apperror.NewAuthnError(t.Sprintf("The token doesn't belong to a user"), nil)
+	}
+
+	userID, err := strconv.ParseInt(claims.UserID, 10, 64)
+	if err != nil {
+		return apperror.NewAuthnError(t.Sprintf("The token doesn't belong to a user"), err)
+	}
+
+	request := oapi.LogoutRequest{}
+	if err := ctx.Bind(&request); err != nil {
+		return apperror.NewValidationError(t.Sprintf("Invalid logout request"), err)
+	}
+
+	session := &model.Session{UserID: userID, TokenID: claims.ID}
+	if claims.ExpiresAt != nil {
+		session.ExpiresAt = claims.ExpiresAt.Time
+	}
+
+	var refreshToken string
+	if request.RefreshToken != nil {
+		refreshToken = *request.RefreshToken
+	}
+
+	if err := ctrl.auth.Logout(ctx.Request().Context(), session, refreshToken, ctx.RealIP()); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (ctrl *AuthController) Register(ctx echo.Context) error {
+	t := ctrl.printer(ctx)
+
+	request := oapi.RegisterRequest{}
+	if err := ctx.Bind(&request); err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to read request"), err)
+	}
+
+	req := &model.UserRequest{Username: request.Username, Password: request.Password}
+	if err := ctx.Validate(req); err != nil {
+		return apperror.NewValidationError(t.Sprintf("The request did not pass validation"), err)
+	}
+
+	result, err := ctrl.auth.Register(ctx.Request().Context(), req, ctx.RealIP())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, result)
+}
+
+func (ctrl *AuthController) ChangePassword(ctx echo.Context) error {
+	t := ctrl.printer(ctx)
+
+	userID, err := strconv.ParseInt(ctrl.GetUserID(ctx), 10, 64)
+	if err != nil {
+		return apperror.NewAuthnError(t.Sprintf("The token doesn't belong to a user"), err)
+	}
+
+	request := oapi.PasswordChangeRequest{}
+	if err := ctx.Bind(&request); err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to read request"), err)
+	}
+
+	req := &model.PasswordChangeRequest{CurrentPassword: request.CurrentPassword, NewPassword: request.NewPassword}
+	if err := ctx.Validate(req); err != nil {
+		return apperror.NewValidationError(t.Sprintf("The request did not pass validation"), err)
+	}
+
+	if err := ctrl.auth.ChangePassword(ctx.Request().Context(), userID, req, ctx.RealIP()); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
 }
 
 func (ctrl *AuthController) OAuthLogin(ctx echo.Context) error {
@@ -84,3 +186,11 @@ func (ctrl *AuthController) OAuthCallback(ctx echo.Context) error {
 
 	return ctx.Redirect(http.StatusFound, "/")
 }
+
+func newToken(tokens *model.AuthTokens) *oapi.Token {
+	return &oapi.Token{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		ExpiresAt:    tokens.ExpiresAt,
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"go.megpoid.dev/go-skel/app/usecase"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/oapi"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/batch"
+	"go.megpoid.dev/go-skel/pkg/sse"
+)
+
+const batchPath = "/batch"
+
+// errOperationFailed marks the operation as failed, so its savepoint is rolled back.
+var errOperationFailed = errors.New("batch operation failed")
+
+type BatchController struct {
+	common
+	batchUsecase usecase.Batch
+	dispatcher   *batch.Dispatcher
+}
+
+// NewBatch creates the batch controller, the operations are dispatched through the handler, usually the server
+// router.
+func NewBatch(cfg config.ServerSettings, batchUsecase usecase.Batch, handler http.Handler) BatchController {
+	return BatchController{
+		common:       newCommon(cfg),
+		batchUsecase: batchUsecase,
+		dispatcher:   batch.NewDispatcher(handler, BaseURL()),
+	}
+}
+
+// batchResponse has the result of every operation, the ones after a failure that aborted the batch are null.
+type batchResponse struct {
+	Committed bool            `json:"committed"`
+	Results   []*batch.Result `json:"results"`
+}
+
+func (ctrl *BatchController) Batch(ctx echo.Context) error {
+	t := ctrl.printer(ctx)
+
+	var request oapi.BatchRequest
+	if err := ctx.Bind(&request); err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to read request"), err)
+	}
+
+	operations := make([]batch.Operation, len(request.Operations))
+	for i, op := range request.Operations {
+		operations[i] = batch.Operation{Method: op.Method, Path: op.Path}
+		if op.Headers != nil {
+			operations[i].Headers = *op.Headers
+		}
+		if op.Body != nil {
+			body, err := json.Marshal(op.Body)
+			if err != nil {
+				return apperror.NewAppError(t.Sprintf("Failed to read request"), err)
+			}
+			operations[i].Body = body
+		}
+	}
+
+	continueOnError := request.ContinueOnError != nil && *request.ContinueOnError
+	results := make([]*batch.Result, len(operations))
+	parent := ctx.Request()
+
+	committed, err := ctrl.batchUsecase.Run(parent.Context(), len(operations), continueOnError, func(opCtx context.Context, i int) error {
+		results[i] = ctrl.dispatch(ctx, opCtx, operations[i], results[:i])
+		if results[i].Failed() {
+			return errOperationFailed
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, batchResponse{Committed: committed, Results: results})
+}
+
+// dispatch runs a single operation, the invalid ones get an error result without reaching the router.
+func (ctrl *BatchController) dispatch(ctx echo.Context, opCtx context.Context, op batch.Operation, previous []*batch.Result) *batch.Result {
+	t := ctrl.printer(ctx)
+
+	op, err := batch.Resolve(op, previous)
+	if err != nil {
+		return errorResult(apperror.NewValidationError(t.Sprintf("Invalid batch operation"), err))
+	}
+
+	req, err := ctrl.dispatcher.Request(opCtx, ctx.Request(), op)
+	if err != nil {
+		return errorResult(apperror.NewValidationError(t.Sprintf("Invalid batch operation"), err))
+	}
+	if req.URL.Path == BaseURL()+batchPath {
+		return errorResult(apperror.NewValidationError(t.Sprintf("Batch requests cannot be nested"), nil))
+	}
+	if streaming(req) {
+		return errorResult(apperror.NewValidationError(t.Sprintf("Streaming operations cannot be batched"), nil))
+	}
+
+	return ctrl.dispatcher.Dispatch(req)
+}
+
+// streaming reports if the operation would open an event stream, which never ends inside a batch and would hold
+// its transaction open.
+func streaming(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, "/events") || strings.Contains(req.Header.Get(echo.HeaderAccept), sse.MIMETextEventStream)
+}
+
+func errorResult(appErr *apperror.Error) *batch.Result {
+	body, _ := json.Marshal(apperror.Error{Message: appErr.Message, StatusCode: appErr.StatusCode})
+	return &batch.Result{
+		Status:  appErr.StatusCode,
+		Headers: map[string]string{echo.HeaderContentType: echo.MIMEApplicationJSON},
+		Body:    body,
+	}
+}
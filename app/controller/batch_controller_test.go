@@ -0,0 +1,129 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.megpoid.dev/go-skel/app/usecase"
+	"go.megpoid.dev/go-skel/config"
+)
+
+func TestBatchController(t *testing.T) {
+	suite.Run(t, &batchSuite{})
+}
+
+type batchSuite struct {
+	suite.Suite
+	cfg config.ServerSettings
+}
+
+// newRouter returns a router with fake profile routes and the batch endpoint.
+func (s *batchSuite) newRouter(uc usecase.Batch) *echo.Echo {
+	e := echo.New()
+	e.POST(BaseURL()+"/profiles", func(ctx echo.Context) error {
+		return ctx.JSON(http.StatusCreated, map[string]any{"id": 7})
+	})
+	e.PATCH(BaseURL()+"/profiles/:id", func(ctx echo.Context) error {
+		var body map[string]any
+		if err := ctx.Bind(&body); err != nil {
+			return err
+		}
+		body["id"] = ctx.Param("id")
+		return ctx.JSON(http.StatusOK, body)
+	})
+
+	ctrl := NewBatch(s.cfg, uc, e)
+	e.POST(BaseURL()+batchPath, ctrl.Batch)
+
+	return e
+}
+
+// runBatch runs the operations like the usecase, without the transaction.
+func runBatch(ctx context.Context, operations int, continueOnError bool, fn usecase.BatchOperation) (bool, error) {
+	for i := range operations {
+		if err := fn(ctx, i); err != nil && !continueOnError {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (s *batchSuite) serve(e *echo.Echo, body string) (int, map[string]any) {
+	req := httptest.NewRequest(http.MethodPost, BaseURL()+batchPath, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	var response map[string]any
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &response))
+	return rec.Code, response
+}
+
+func (s *batchSuite) TestBatch() {
+	uc := usecase.NewMockBatch(s.T())
+	uc.EXPECT().Run(mock.Anything, 2, false, mock.Anything).RunAndReturn(runBatch)
+
+	code, response := s.serve(s.newRouter(uc), `{"operations":[
+		{"method":"POST","path":"/profiles","body":{"first_name":"John"}},
+		{"method":"PATCH","path":"/profiles/$0.id","body":{"owner":"$0.id"}}
+	]}`)
+
+	s.Equal(http.StatusOK, code)
+	s.Equal(true, response["committed"])
+	results := response["results"].([]any)
+	s.Require().Len(results, 2)
+	s.EqualValues(http.StatusCreated, results[0].(map[string]any)["status"])
+	second := results[1].(map[string]any)
+	s.EqualValues(http.StatusOK, second["status"])
+	s.Equal(map[string]any{"id": "7", "owner": float64(7)}, second["body"])
+}
+
+func (s *batchSuite) TestBatchAbort() {
+	uc := usecase.NewMockBatch(s.T())
+	uc.EXPECT().Run(mock.Anything, 3, false, mock.Anything).RunAndReturn(runBatch)
+
+	code, response := s.serve(s.newRouter(uc), `{"operations":[
+		{"method":"POST","path":"/profiles"},
+		{"method":"DELETE","path":"/unknown"},
+		{"method":"POST","path":"/profiles"}
+	]}`)
+
+	s.Equal(http.StatusOK, code)
+	s.Equal(false, response["committed"])
+	results := response["results"].([]any)
+	s.Require().Len(results, 3)
+	s.EqualValues(http.StatusNotFound, results[1].(map[string]any)["status"])
+	s.Nil(results[2])
+}
+
+func (s *batchSuite) TestBatchInvalid() {
+	uc := usecase.NewMockBatch(s.T())
+	uc.EXPECT().Run(mock.Anything, 5, true, mock.Anything).RunAndReturn(runBatch)
+
+	_, response := s.serve(s.newRouter(uc), `{"continue_on_error":true,"operations":[
+		{"method":"POST","path":"/batch","body":{"operations":[]}},
+		{"method":"PATCH","path":"/profiles/$0.id"},
+		{"method":"GET","path":"/events?resources=profiles"},
+		{"method":"GET","path":"/profiles","headers":{"Accept":"text/event-stream"}},
+		{"method":"POST","path":"/profiles"}
+	]}`)
+
+	s.Equal(true, response["committed"])
+	results := response["results"].([]any)
+	s.Require().Len(results, 5)
+	for _, result := range results[:4] {
+		s.EqualValues(http.StatusBadRequest, result.(map[string]any)["status"])
+	}
+	s.EqualValues(http.StatusCreated, results[4].(map[string]any)["status"])
+}
@@ -11,6 +11,9 @@ import (
 	"go.megpoid.dev/go-skel/config"
 	"go.megpoid.dev/go-skel/oapi"
 	"go.megpoid.dev/go-skel/pkg/i18n"
+	"go.megpoid.dev/go-skel/pkg/jwks"
+	"go.megpoid.dev/go-skel/pkg/middleware"
+	"go.megpoid.dev/go-skel/pkg/principal"
 	"golang.org/x/text/message"
 )
 
@@ -26,11 +29,16 @@ func BaseURL() string {
 var _ oapi.ServerInterface = &Controller{}
 
 type Controller struct {
+	AdminController
+	APIKeyController
 	AuthController
+	BatchController
 	ProfileController
+	ProfileImportController
 	HealthcheckController
 	TaskController
 	DelayController
+	EventController
 }
 
 type common struct {
@@ -49,23 +57,69 @@ func (a *common) printer(ctx echo.Context) *message.Printer {
 }
 
 type JwtCustomClaims struct {
-	UserID string `json:"user_id"`
+	UserID string   `json:"user_id"`
+	User   string   `json:"user"`
+	Scopes []string `json:"scopes,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func (a *common) UseJWT(g *echo.Group) {
-	jwtConfig := echojwt.Config{
-		SigningKey: a.config.JwtSecret,
+// GetTokenID returns the jti claim, used to check if the token was revoked.
+func (c *JwtCustomClaims) GetTokenID() string {
+	return c.ID
+}
+
+// NewJWTConfig returns the config to validate the tokens issued by the login, the keys reject the tokens signed with
+// another algorithm. The user of a valid token is set as the principal of the request.
+func NewJWTConfig(keys jwks.Keys) echojwt.Config {
+	return echojwt.Config{
+		KeyFunc: keys.Keyfunc,
 		NewClaimsFunc: func(c echo.Context) jwt.Claims {
 			return &JwtCustomClaims{}
 		},
+		SuccessHandler: func(c echo.Context) {
+			token, ok := c.Get("user").(*jwt.Token)
+			if !ok {
+				return
+			}
+			claims, ok := token.Claims.(*JwtCustomClaims)
+			if !ok {
+				return
+			}
+
+			p := &principal.Principal{
+				Type:    principal.TypeUser,
+				ID:      claims.UserID,
+				Subject: claims.User,
+				Scopes:  claims.Scopes,
+			}
+			c.SetRequest(c.Request().WithContext(principal.NewContext(c.Request().Context(), p)))
+		},
 	}
+}
 
-	g.Use(echojwt.WithConfig(jwtConfig))
+// UseJWT requires a valid token on the requests of the group, rejecting the revoked ones if revoked isn't nil.
+func (a *common) UseJWT(g *echo.Group, keys jwks.Keys, revoked middleware.TokenRevoked) {
+	g.Use(middleware.RevocableJWT(NewJWTConfig(keys), revoked))
 }
 
+// getClaims returns the claims of the JWT of the request, if it was authenticated with a token issued by the login.
+func (a *common) getClaims(c echo.Context) (*JwtCustomClaims, bool) {
+	token, ok := c.Get("user").(*jwt.Token)
+	if !ok {
+		return nil, false
+	}
+
+	claims, ok := token.Claims.(*JwtCustomClaims)
+	return claims, ok
+}
+
+// GetUserID returns the user of the JWT of the request, or an empty string if the request wasn't authenticated
+// with a token issued by the login.
 func (a *common) GetUserID(c echo.Context) string {
-	token := c.Get("user").(*jwt.Token)
-	claims := token.Claims.(*JwtCustomClaims)
+	claims, ok := a.getClaims(c)
+	if !ok {
+		return ""
+	}
+
 	return claims.UserID
 }
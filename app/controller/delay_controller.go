@@ -53,9 +53,11 @@ func (ctrl *DelayController) ProcessBackground(ctx echo.Context) error {
 	}
 
 	response := oapi.TaskCreationResponse{
-		Location: "",
+		Location: taskLocation(task.DefaultQueueName, taskId),
 		TaskId:   taskId,
 	}
 
+	ctx.Response().Header().Set(echo.HeaderLocation, response.Location)
+
 	return ctx.JSON(http.StatusAccepted, response)
 }
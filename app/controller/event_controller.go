@@ -0,0 +1,68 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/usecase"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/oapi"
+	"go.megpoid.dev/go-skel/pkg/sse"
+)
+
+type EventController struct {
+	common
+	eventUsecase usecase.Event
+}
+
+func NewEvent(cfg config.ServerSettings, event usecase.Event) EventController {
+	return EventController{
+		common:       newCommon(cfg),
+		eventUsecase: event,
+	}
+}
+
+// StreamEvents sends the changes of the requested resources as Server-Sent Events until the client disconnects
+// or the server shuts down.
+func (ctrl *EventController) StreamEvents(ctx echo.Context, params oapi.StreamEventsParams) error {
+	events, err := ctrl.eventUsecase.Stream(ctx.Request().Context(), params.Resources, params.LastEventID)
+	if err != nil {
+		return err
+	}
+
+	stream, err := sse.NewWriter(ctx.Response())
+	if err != nil {
+		return err
+	}
+
+	keepAlive := time.NewTicker(sse.DefaultKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			name := "change"
+			if event.Operation == model.EventOperationReset {
+				name = "reset"
+			}
+
+			if err := stream.Send(sse.Event{ID: strconv.FormatInt(event.ID, 10), Event: name, Data: event}); err != nil {
+				return nil
+			}
+		case <-keepAlive.C:
+			if err := stream.KeepAlive(); err != nil {
+				return nil
+			}
+		}
+	}
+}
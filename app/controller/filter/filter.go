@@ -23,6 +23,8 @@ type Params struct {
 	Filters  *oapi.Filters
 	Fields   *oapi.Fields
 	Sort     *oapi.Sort
+	// Format is read by the renderer of the response
+	Format *oapi.Format
 }
 
 func NewFilterFromParams(params Params) (*request.QueryParams, error) {
@@ -5,7 +5,6 @@
 package controller
 
 import (
-	"fmt"
 	"net/http"
 	"strings"
 
@@ -13,6 +12,7 @@ import (
 	"go.megpoid.dev/go-skel/app/usecase"
 	"go.megpoid.dev/go-skel/config"
 	"go.megpoid.dev/go-skel/oapi"
+	"go.megpoid.dev/go-skel/pkg/types"
 )
 
 type HealthcheckController struct {
@@ -37,24 +37,35 @@ func (ctrl *HealthcheckController) LiveCheck(ctx echo.Context, params oapi.LiveC
 }
 
 func (ctrl *HealthcheckController) ReadyCheck(ctx echo.Context, params oapi.ReadyCheckParams) error {
-	err := ctrl.healthcheckUsecase.Execute(ctx.Request().Context())
-	if params.Verbose != nil && *params.Verbose {
-		var check strings.Builder
+	report := ctrl.healthcheckUsecase.Execute(ctx.Request().Context())
+	verbose := params.Verbose != nil && *params.Verbose
 
-		if err != nil {
-			check.WriteString(fmt.Sprintf("[+] ping err: %s\n", err.Error()))
-			check.WriteString("ready check failed\n")
-		} else {
-			check.WriteString("[+] ping ok\n")
-			check.WriteString("ready check passed\n")
-		}
+	response := oapi.HealthReport{
+		Status:       oapi.HealthReportStatus(report.Status),
+		ShuttingDown: report.ShuttingDown,
+		Checks:       make([]oapi.HealthCheck, 0, len(report.Checks)),
+	}
 
-		return ctx.String(http.StatusOK, check.String())
+	for _, result := range report.Checks {
+		check := oapi.HealthCheck{
+			Name:      result.Name,
+			Status:    oapi.HealthCheckStatus(result.Status),
+			Critical:  result.Critical,
+			LatencyMs: toMilliseconds(result.Latency),
+		}
+		// the errors may leak internal details, like hostnames
+		if verbose && result.Err != nil {
+			check.Error = types.AsPointer(result.Err.Error())
+		}
+		response.Checks = append(response.Checks, check)
 	}
 
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "error")
+	// don't let the proxies cache the probe results
+	ctx.Response().Header().Set("Cache-Control", "no-store")
+
+	if !report.Ready() {
+		return ctx.JSON(http.StatusServiceUnavailable, &response)
 	}
 
-	return ctx.String(http.StatusOK, "ok")
+	return ctx.JSON(http.StatusOK, &response)
 }
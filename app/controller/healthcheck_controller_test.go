@@ -8,6 +8,7 @@ import (
 	"errors"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/mock"
@@ -15,6 +16,8 @@ import (
 	"go.megpoid.dev/go-skel/app/usecase"
 	"go.megpoid.dev/go-skel/config"
 	"go.megpoid.dev/go-skel/oapi"
+	"go.megpoid.dev/go-skel/pkg/health"
+	"go.megpoid.dev/go-skel/pkg/types"
 )
 
 func TestHealthcheckController(t *testing.T) {
@@ -43,7 +46,10 @@ func (s *healthcheckSuite) TestLive() {
 
 func (s *healthcheckSuite) TestReady() {
 	uc := usecase.NewMockHealthcheck(s.T())
-	uc.EXPECT().Execute(mock.Anything).Return(nil)
+	uc.EXPECT().Execute(mock.Anything).Return(&health.Report{
+		Status: health.StatusUp,
+		Checks: []health.Result{{Name: "database", Status: health.StatusUp, Critical: true, Latency: time.Millisecond}},
+	})
 
 	ctrl := NewHealthCheck(s.cfg, uc)
 
@@ -54,13 +60,36 @@ func (s *healthcheckSuite) TestReady() {
 
 	err := ctrl.ReadyCheck(ctx, oapi.ReadyCheckParams{})
 	s.NoError(err)
-	s.Equal("ok", rec.Body.String())
 	s.Equal(200, rec.Result().StatusCode)
+	s.JSONEq(`{"status":"up","shutting_down":false,"checks":[{"name":"database","status":"up","critical":true,"latency_ms":1}]}`, rec.Body.String())
+}
+
+func (s *healthcheckSuite) TestReadyDegraded() {
+	uc := usecase.NewMockHealthcheck(s.T())
+	uc.EXPECT().Execute(mock.Anything).Return(&health.Report{
+		Status: health.StatusDegraded,
+		Checks: []health.Result{{Name: "oidc", Status: health.StatusDown, Err: errors.New("an error occurred")}},
+	})
+
+	ctrl := NewHealthCheck(s.cfg, uc)
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.ReadyCheck(ctx, oapi.ReadyCheckParams{Verbose: types.AsPointer(true)})
+	s.NoError(err)
+	s.Equal(200, rec.Result().StatusCode)
+	s.Contains(rec.Body.String(), `"error":"an error occurred"`)
 }
 
 func (s *healthcheckSuite) TestReadyFailed() {
 	uc := usecase.NewMockHealthcheck(s.T())
-	uc.EXPECT().Execute(mock.Anything).Return(errors.New("an error occurred"))
+	uc.EXPECT().Execute(mock.Anything).Return(&health.Report{
+		Status: health.StatusDown,
+		Checks: []health.Result{{Name: "database", Status: health.StatusDown, Critical: true, Err: errors.New("an error occurred")}},
+	})
 
 	ctrl := NewHealthCheck(s.cfg, uc)
 
@@ -70,5 +99,24 @@ func (s *healthcheckSuite) TestReadyFailed() {
 	ctx := e.NewContext(req, rec)
 
 	err := ctrl.ReadyCheck(ctx, oapi.ReadyCheckParams{})
-	s.Error(err)
+	s.NoError(err)
+	s.Equal(503, rec.Result().StatusCode)
+	s.NotContains(rec.Body.String(), "an error occurred")
+}
+
+func (s *healthcheckSuite) TestReadyShuttingDown() {
+	uc := usecase.NewMockHealthcheck(s.T())
+	uc.EXPECT().Execute(mock.Anything).Return(&health.Report{Status: health.StatusDown, ShuttingDown: true})
+
+	ctrl := NewHealthCheck(s.cfg, uc)
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.ReadyCheck(ctx, oapi.ReadyCheckParams{})
+	s.NoError(err)
+	s.Equal(503, rec.Result().StatusCode)
+	s.JSONEq(`{"status":"down","shutting_down":true,"checks":[]}`, rec.Body.String())
 }
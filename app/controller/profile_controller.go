@@ -5,7 +5,9 @@
 package controller
 
 import (
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"go.megpoid.dev/go-skel/app/controller/filter"
@@ -14,6 +16,9 @@ import (
 	"go.megpoid.dev/go-skel/config"
 	"go.megpoid.dev/go-skel/oapi"
 	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/conditional"
+	"go.megpoid.dev/go-skel/pkg/patch"
+	"go.megpoid.dev/go-skel/pkg/render"
 )
 
 type ProfileController struct {
@@ -39,7 +44,7 @@ func (ctrl *ProfileController) ListProfiles(ctx echo.Context, params oapi.ListPr
 		return err
 	}
 
-	return ctx.JSON(http.StatusOK, result)
+	return render.List(ctx, http.StatusOK, result)
 }
 
 func (ctrl *ProfileController) SaveProfile(ctx echo.Context) error {
@@ -62,7 +67,7 @@ func (ctrl *ProfileController) SaveProfile(ctx echo.Context) error {
 }
 
 func (ctrl *ProfileController) RemoveProfile(ctx echo.Context, id oapi.ProfileId) error {
-	err := ctrl.profileUsecase.RemoveProfile(ctx.Request().Context(), id)
+	err := ctrl.profileUsecase.RemoveProfile(ctx.Request().Context(), id, ctrl.precondition(ctx))
 	if err != nil {
 		return err
 	}
@@ -76,10 +81,18 @@ func (ctrl *ProfileController) GetProfile(ctx echo.Context, id oapi.ProfileId) e
 		return err
 	}
 
+	if conditional.NotModified(ctx, conditional.ETag(result.ID, result.UpdatedAt), result.UpdatedAt) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+
 	return ctx.JSON(http.StatusOK, result)
 }
 
 func (ctrl *ProfileController) UpdateProfile(ctx echo.Context, id oapi.ProfileId) error {
+	if patch.IsPatch(ctx.Request().Header.Get(echo.HeaderContentType)) {
+		return ctrl.patchProfile(ctx, id)
+	}
+
 	var request oapi.ProfileRequest
 	if err := ctx.Bind(&request); err != nil {
 		return err
@@ -88,10 +101,51 @@ func (ctrl *ProfileController) UpdateProfile(ctx echo.Context, id oapi.ProfileId
 		return err
 	}
 
-	result, err := ctrl.profileUsecase.UpdateProfile(ctx.Request().Context(), id, (*model.ProfileRequest)(&request))
+	result, err := ctrl.profileUsecase.UpdateProfile(ctx.Request().Context(), id, (*model.ProfileRequest)(&request), ctrl.precondition(ctx))
+	if err != nil {
+		return err
+	}
+
+	conditional.SetValidators(ctx, conditional.ETag(result.ID, result.UpdatedAt), result.UpdatedAt)
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// patchProfile applies a JSON Merge Patch or JSON Patch document to the profile, only the fields modified by the
+// patch are saved.
+func (ctrl *ProfileController) patchProfile(ctx echo.Context, id oapi.ProfileId) error {
+	t := ctrl.printer(ctx)
+	req := ctx.Request()
+
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to read request"), err)
+	}
+
+	p, err := patch.Parse(req.Header.Get(echo.HeaderContentType), data)
+	if err != nil {
+		return apperror.NewValidationError(t.Sprintf("Failed to read request"), err)
+	}
+
+	result, err := ctrl.profileUsecase.PatchProfile(req.Context(), id, p, ctrl.precondition(ctx))
 	if err != nil {
 		return err
 	}
 
+	conditional.SetValidators(ctx, conditional.ETag(result.ID, result.UpdatedAt), result.UpdatedAt)
+
 	return ctx.JSON(http.StatusOK, result)
 }
+
+// precondition checks the If-Match and If-Unmodified-Since headers against the stored profile, nil if the
+// request doesn't have them.
+func (ctrl *ProfileController) precondition(ctx echo.Context) usecase.Precondition {
+	req := ctx.Request()
+	if !conditional.HasPreconditions(req) {
+		return nil
+	}
+
+	return func(id int64, updatedAt time.Time) error {
+		return conditional.CheckPreconditions(req, conditional.ETag(id, updatedAt), updatedAt)
+	}
+}
@@ -5,8 +5,12 @@
 package controller
 
 import (
+	"context"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/mock"
@@ -15,9 +19,13 @@ import (
 	"go.megpoid.dev/go-skel/app/usecase"
 	"go.megpoid.dev/go-skel/config"
 	"go.megpoid.dev/go-skel/oapi"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/conditional"
 	"go.megpoid.dev/go-skel/pkg/model"
 	"go.megpoid.dev/go-skel/pkg/paginator"
+	"go.megpoid.dev/go-skel/pkg/patch"
 	"go.megpoid.dev/go-skel/pkg/response"
+	"go.megpoid.dev/go-skel/pkg/validator"
 )
 
 func TestProfileController(t *testing.T) {
@@ -51,6 +59,90 @@ func (s *profileSuite) TestGet() {
 	s.NoError(err)
 }
 
+func (s *profileSuite) TestGetNotModified() {
+	updatedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockProfile := appmodel.Profile{
+		Model: model.Model{ID: 1, UpdatedAt: updatedAt},
+	}
+
+	uc := usecase.NewMockProfile(s.T())
+	uc.EXPECT().GetProfile(mock.Anything, int64(1)).Return(&mockProfile, nil)
+
+	ctrl := NewProfile(s.cfg, uc)
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/", nil)
+	req.Header.Set(conditional.HeaderIfNoneMatch, conditional.ETag(1, updatedAt))
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.GetProfile(ctx, 1)
+	s.NoError(err)
+	s.Equal(http.StatusNotModified, rec.Code)
+	s.Empty(rec.Body.String())
+	s.Equal(conditional.ETag(1, updatedAt), rec.Header().Get(conditional.HeaderETag))
+}
+
+func (s *profileSuite) TestUpdateIfMatch() {
+	updatedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	uc := usecase.NewMockProfile(s.T())
+	uc.EXPECT().UpdateProfile(mock.Anything, int64(1), mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, id int64, req *appmodel.ProfileRequest, precondition usecase.Precondition) (*appmodel.Profile, error) {
+			s.Require().NotNil(precondition)
+			s.NoError(precondition(id, updatedAt))
+			s.ErrorIs(precondition(id, updatedAt.Add(time.Second)), conditional.ErrPreconditionFailed)
+			return req.Profile(model.WithID(id)), nil
+		})
+
+	ctrl := NewProfile(s.cfg, uc)
+
+	e := echo.New()
+	e.Validator = validator.NewCustomValidator()
+	req := httptest.NewRequest(echo.PATCH, "/", strings.NewReader(`{"email":"john.doe@example.com","first_name":"John","last_name":"Doe"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(conditional.HeaderIfMatch, conditional.ETag(1, updatedAt))
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.UpdateProfile(ctx, 1)
+	s.NoError(err)
+	s.NotEmpty(rec.Header().Get(conditional.HeaderETag))
+}
+
+func (s *profileSuite) TestPatch() {
+	uc := usecase.NewMockProfile(s.T())
+	uc.EXPECT().PatchProfile(mock.Anything, int64(1), mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, id int64, p patch.Patch, _ usecase.Precondition) (*appmodel.Profile, error) {
+			var req appmodel.ProfileRequest
+			err := patch.ApplyTo(p, &appmodel.ProfileRequest{FirstName: "John", LastName: "Doe"}, &req)
+			s.Require().NoError(err)
+			return req.Profile(model.WithID(id)), nil
+		})
+
+	ctrl := NewProfile(s.cfg, uc)
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.PATCH, "/", strings.NewReader(`[{"op":"replace","path":"/first_name","value":"Jane"}]`))
+	req.Header.Set(echo.HeaderContentType, patch.MIMEApplicationJSONPatch)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.UpdateProfile(ctx, 1)
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Body.String(), `"first_name":"Jane","last_name":"Doe"`)
+
+	req = httptest.NewRequest(echo.PATCH, "/", strings.NewReader(`{"op":"replace"}`))
+	req.Header.Set(echo.HeaderContentType, patch.MIMEApplicationJSONPatch)
+	ctx = e.NewContext(req, httptest.NewRecorder())
+
+	err = ctrl.UpdateProfile(ctx, 1)
+	var appErr *apperror.Error
+	s.Require().ErrorAs(err, &appErr)
+	s.Equal(http.StatusBadRequest, appErr.StatusCode)
+}
+
 func (s *profileSuite) TestList() {
 	mockProfiles := []*appmodel.Profile{{
 		Model:     model.Model{ID: 1},
@@ -74,3 +166,30 @@ func (s *profileSuite) TestList() {
 	err := ctrl.ListProfiles(ctx, oapi.ListProfilesParams{})
 	s.NoError(err)
 }
+
+func (s *profileSuite) TestListCSV() {
+	mockProfiles := []*appmodel.Profile{{
+		Model:     model.Model{ID: 1},
+		FirstName: "John",
+		LastName:  "Doe",
+		Email:     "john.doe@example.com",
+	}}
+
+	resp := response.NewListResponse(mockProfiles, &paginator.Cursor{})
+
+	uc := usecase.NewMockProfile(s.T())
+	uc.EXPECT().ListProfiles(mock.Anything, mock.Anything).Return(resp, nil)
+
+	ctrl := NewProfile(s.cfg, uc)
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/", nil)
+	req.Header.Set(echo.HeaderAccept, "text/csv")
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.ListProfiles(ctx, oapi.ListProfilesParams{})
+	s.NoError(err)
+	s.Equal("id,created_at,updated_at,first_name,last_name,email\n"+
+		"1,0001-01-01T00:00:00Z,0001-01-01T00:00:00Z,John,Doe,john.doe@example.com\n", rec.Body.String())
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"go.megpoid.dev/go-skel/app/tasks"
+	"go.megpoid.dev/go-skel/app/usecase"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/oapi"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/task"
+)
+
+type ProfileImportController struct {
+	common
+	profileImportUsecase usecase.ProfileImport
+	task                 task.Task
+}
+
+func NewProfileImport(cfg config.ServerSettings, profileImport usecase.ProfileImport, task task.Task) ProfileImportController {
+	return ProfileImportController{
+		common:               newCommon(cfg),
+		profileImportUsecase: profileImport,
+		task:                 task,
+	}
+}
+
+func (ctrl *ProfileImportController) CreateProfileImport(ctx echo.Context) error {
+	t := ctrl.printer(ctx)
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return apperror.NewValidationError(t.Sprintf("Failed to read request"), err)
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to read request"), err)
+	}
+	defer file.Close()
+
+	reqCtx := ctx.Request().Context()
+
+	result, err := ctrl.profileImportUsecase.CreateImport(reqCtx, fileHeader.Filename, ctx.FormValue("format"), file)
+	if err != nil {
+		return err
+	}
+
+	importTask, err := tasks.NewProfileImportTask(result.ID)
+	if err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to create task"), err)
+	}
+
+	if _, err := ctrl.task.Enqueue(reqCtx, importTask); err != nil {
+		// the import would stay pending forever otherwise
+		if failErr := ctrl.profileImportUsecase.FailImport(reqCtx, result.ID, err); failErr != nil {
+			err = errors.Join(err, failErr)
+		}
+		return apperror.NewAppError(t.Sprintf("Failed to enqueue task"), err)
+	}
+
+	ctx.Response().Header().Set(echo.HeaderLocation, profileImportLocation(result.ID))
+
+	return ctx.JSON(http.StatusAccepted, result)
+}
+
+func (ctrl *ProfileImportController) GetProfileImport(ctx echo.Context, id oapi.ImportId) error {
+	result, err := ctrl.profileImportUsecase.GetImport(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, result)
+}
+
+func (ctrl *ProfileImportController) GetProfileImportErrors(ctx echo.Context, id oapi.ImportId) error {
+	reqCtx := ctx.Request().Context()
+
+	// fails before writing the headers if the import doesn't exist
+	if _, err := ctrl.profileImportUsecase.GetImport(reqCtx, id); err != nil {
+		return err
+	}
+
+	filename := "profile-import-" + strconv.FormatInt(id, 10) + "-errors.csv"
+	ctx.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="`+filename+`"`)
+	ctx.Response().WriteHeader(http.StatusOK)
+
+	return ctrl.profileImportUsecase.WriteErrorReport(reqCtx, id, ctx.Response())
+}
+
+func profileImportLocation(id int64) string {
+	return BaseURL() + "/profiles/imports/" + strconv.FormatInt(id, 10)
+}
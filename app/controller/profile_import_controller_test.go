@@ -0,0 +1,114 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	appmodel "go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/tasks"
+	"go.megpoid.dev/go-skel/app/usecase"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/pkg/model"
+)
+
+func TestProfileImportController(t *testing.T) {
+	suite.Run(t, &profileImportSuite{})
+}
+
+type profileImportSuite struct {
+	suite.Suite
+	cfg config.ServerSettings
+}
+
+func (s *profileImportSuite) newUploadRequest() *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "profiles.csv")
+	s.Require().NoError(err)
+	_, err = part.Write([]byte("email,first_name,last_name\njohn.doe@example.com,John,Doe\n"))
+	s.Require().NoError(err)
+	s.Require().NoError(writer.WriteField("format", "csv"))
+	s.Require().NoError(writer.Close())
+
+	req := httptest.NewRequest(echo.POST, "/", &body)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	return req
+}
+
+func (s *profileImportSuite) TestCreate() {
+	mockImport := appmodel.NewProfileImport(model.WithID(1))
+
+	uc := usecase.NewMockProfileImport(s.T())
+	uc.EXPECT().CreateImport(mock.Anything, "profiles.csv", "csv", mock.Anything).
+		RunAndReturn(func(_ context.Context, _, _ string, r io.Reader) (*appmodel.ProfileImport, error) {
+			data, err := io.ReadAll(r)
+			s.NoError(err)
+			s.Contains(string(data), "john.doe@example.com")
+			return mockImport, nil
+		})
+
+	queue := &fakeTask{}
+
+	ctrl := NewProfileImport(s.cfg, uc, queue)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(s.newUploadRequest(), rec)
+
+	err := ctrl.CreateProfileImport(ctx)
+	s.NoError(err)
+	s.Equal(http.StatusAccepted, rec.Code)
+	s.Equal(BaseURL()+"/profiles/imports/1", rec.Header().Get(echo.HeaderLocation))
+	s.Require().Len(queue.enqueued, 1)
+	s.Equal(tasks.TypeProfileImport, queue.enqueued[0].Type())
+}
+
+func (s *profileImportSuite) TestCreateEnqueueFailure() {
+	uc := usecase.NewMockProfileImport(s.T())
+	uc.EXPECT().CreateImport(mock.Anything, "profiles.csv", "csv", mock.Anything).Return(appmodel.NewProfileImport(model.WithID(1)), nil)
+	uc.EXPECT().FailImport(mock.Anything, int64(1), mock.Anything).Return(nil)
+
+	queue := &fakeTask{enqueueErr: errors.New("redis is down")}
+
+	ctrl := NewProfileImport(s.cfg, uc, queue)
+
+	e := echo.New()
+	ctx := e.NewContext(s.newUploadRequest(), httptest.NewRecorder())
+
+	err := ctrl.CreateProfileImport(ctx)
+	s.Error(err)
+}
+
+func (s *profileImportSuite) TestGetErrors() {
+	uc := usecase.NewMockProfileImport(s.T())
+	uc.EXPECT().GetImport(mock.Anything, int64(1)).Return(appmodel.NewProfileImport(model.WithID(1)), nil)
+	uc.EXPECT().WriteErrorReport(mock.Anything, int64(1), mock.Anything).RunAndReturn(func(_ context.Context, _ int64, w io.Writer) error {
+		_, err := io.WriteString(w, "line,email,error\n")
+		return err
+	})
+
+	ctrl := NewProfileImport(s.cfg, uc, &fakeTask{})
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(httptest.NewRequest(echo.GET, "/", nil), rec)
+
+	err := ctrl.GetProfileImportErrors(ctx, 1)
+	s.NoError(err)
+	s.Equal(http.StatusOK, rec.Code)
+	s.Contains(rec.Header().Get(echo.HeaderContentDisposition), "profile-import-1-errors.csv")
+	s.Equal("line,email,error\n", rec.Body.String())
+}
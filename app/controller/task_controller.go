@@ -5,23 +5,34 @@
 package controller
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"go.megpoid.dev/go-skel/config"
 	"go.megpoid.dev/go-skel/oapi"
+	"go.megpoid.dev/go-skel/pkg/sse"
 	"go.megpoid.dev/go-skel/pkg/task"
 )
 
+const (
+	taskPollInterval = time.Second
+)
+
 type TaskController struct {
 	common
 	task task.Task
+	// shutdown is canceled when the server stops, closing the open streams
+	shutdown context.Context
 }
 
-func NewTask(cfg config.ServerSettings, task task.Task) TaskController {
+func NewTask(cfg config.ServerSettings, task task.Task, shutdown context.Context) TaskController {
 	return TaskController{
-		common: newCommon(cfg),
-		task:   task,
+		common:   newCommon(cfg),
+		task:     task,
+		shutdown: shutdown,
 	}
 }
 
@@ -31,14 +42,7 @@ func (ctrl *TaskController) GetTask(ctx echo.Context, queueName string, taskId o
 		return err
 	}
 
-	response := oapi.Task{
-		State:  oapi.TaskState(info.State),
-		TaskId: info.ID,
-	}
-
-	if info.Error != "" {
-		response.Error = nil
-	}
+	response := newTaskResponse(info)
 
 	return ctx.JSON(http.StatusOK, &response)
 }
@@ -56,3 +60,120 @@ func (ctrl *TaskController) GetTaskResponse(ctx echo.Context, queueName string,
 		return ctx.Blob(http.StatusOK, info.ContentType, info.Data.([]byte))
 	}
 }
+
+// StreamTask sends the task state and progress changes as Server-Sent Events until the task finishes or the server
+// shuts down.
+func (ctrl *TaskController) StreamTask(ctx echo.Context, queueName string, taskId oapi.TaskId) error {
+	reqCtx := ctx.Request().Context()
+
+	// fetch the task before starting the stream, so a missing task returns a regular error response
+	info, err := ctrl.task.GetTaskInfo(reqCtx, queueName, taskId)
+	if err != nil {
+		return err
+	}
+
+	stream, err := sse.NewWriter(ctx.Response())
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(taskPollInterval)
+	defer ticker.Stop()
+
+	keepAlive := time.NewTicker(sse.DefaultKeepAlive)
+	defer keepAlive.Stop()
+
+	var last *task.Info
+
+	for {
+		if last == nil || last.State != info.State {
+			if err := stream.Send(sse.Event{Event: "state", Data: newTaskResponse(info)}); err != nil {
+				return nil
+			}
+		}
+
+		if info.Progress != nil && (last == nil || last.Progress == nil || *last.Progress != *info.Progress) {
+			if err := stream.Send(sse.Event{Event: "progress", Data: info.Progress}); err != nil {
+				return nil
+			}
+		}
+
+		switch info.State {
+		case "succeeded":
+			ctrl.sendTaskResult(ctx, stream, queueName, taskId)
+			return nil
+		case "failed":
+			_ = stream.Send(sse.Event{Event: "error", Data: task.Error{Code: "task_failed", Message: info.Error}})
+			return nil
+		}
+
+		last = info
+
+		select {
+		case <-reqCtx.Done():
+			return nil
+		case <-ctrl.shutdown.Done():
+			return nil
+		case <-keepAlive.C:
+			if err := stream.KeepAlive(); err != nil {
+				return nil
+			}
+			continue
+		case <-ticker.C:
+		}
+
+		info, err = ctrl.task.GetTaskInfo(reqCtx, queueName, taskId)
+		if err != nil {
+			slog.ErrorContext(reqCtx, "Failed to get task info", slog.String("task_id", taskId), slog.String("error", err.Error()))
+			_ = stream.Send(sse.Event{Event: "error", Data: task.Error{Code: "task_unavailable", Message: err.Error()}})
+			return nil
+		}
+	}
+}
+
+func (ctrl *TaskController) sendTaskResult(ctx echo.Context, stream *sse.Writer, queueName, taskId string) {
+	result, err := ctrl.task.GetTaskResponse(ctx.Request().Context(), queueName, taskId)
+	if err != nil {
+		_ = stream.Send(sse.Event{Event: "error", Data: task.Error{Code: "result_unavailable", Message: err.Error()}})
+		return
+	}
+
+	switch result.ContentType {
+	case echo.MIMEApplicationJSON:
+		_ = stream.Send(sse.Event{Event: "result", Data: result.Data})
+	default:
+		_ = stream.Send(sse.Event{Event: "result", Data: result})
+	}
+}
+
+func newTaskResponse(info *task.Info) oapi.Task {
+	response := oapi.Task{
+		State:  oapi.TaskState(info.State),
+		TaskId: info.ID,
+	}
+
+	if info.Error != "" {
+		response.Error = &struct {
+			Code    string                  `json:"code"`
+			Details *map[string]interface{} `json:"details,omitempty"`
+			Message string                  `json:"message"`
+		}{Code: "task_failed", Message: info.Error}
+	}
+
+	if info.Progress != nil {
+		response.Progress = &oapi.TaskProgress{
+			Current: info.Progress.Current,
+			Total:   info.Progress.Total,
+		}
+		if info.Progress.Message != "" {
+			response.Progress.Message = &info.Progress.Message
+		}
+	}
+
+	return response
+}
+
+// taskLocation returns the URL of the task status resource.
+func taskLocation(queueName, taskId string) string {
+	return BaseURL() + "/queues/" + queueName + "/tasks/" + taskId
+}
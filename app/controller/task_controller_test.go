@@ -0,0 +1,132 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package controller
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/pkg/task"
+)
+
+func TestTaskController(t *testing.T) {
+	suite.Run(t, &taskSuite{})
+}
+
+type taskSuite struct {
+	suite.Suite
+	cfg config.ServerSettings
+}
+
+type fakeTask struct {
+	infos      []*task.Info
+	response   *task.Response
+	enqueued   []*asynq.Task
+	enqueueErr error
+}
+
+func (f *fakeTask) Enqueue(_ context.Context, t *asynq.Task, _ ...asynq.Option) (string, error) {
+	if f.enqueueErr != nil {
+		return "", f.enqueueErr
+	}
+	f.enqueued = append(f.enqueued, t)
+	return "1", nil
+}
+
+func (f *fakeTask) GetTaskInfo(_ context.Context, _, _ string) (*task.Info, error) {
+	info := f.infos[0]
+	if len(f.infos) > 1 {
+		f.infos = f.infos[1:]
+	}
+	return info, nil
+}
+
+func (f *fakeTask) GetTaskResponse(_ context.Context, _, _ string) (*task.Response, error) {
+	return f.response, nil
+}
+
+func (s *taskSuite) TestStream() {
+	fake := &fakeTask{
+		infos: []*task.Info{
+			{ID: "1", State: "running", Progress: &task.Progress{Current: 0, Total: 1}},
+			{ID: "1", State: "succeeded", Progress: &task.Progress{Current: 1, Total: 1}},
+		},
+		response: &task.Response{ContentType: echo.MIMEApplicationJSON, Data: map[string]string{"foo": "bar"}},
+	}
+
+	ctrl := NewTask(s.cfg, fake, context.Background())
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.StreamTask(ctx, task.DefaultQueueName, "1")
+	s.NoError(err)
+	s.Equal("text/event-stream", rec.Header().Get(echo.HeaderContentType))
+	s.Equal("event: state\ndata: {\"progress\":{\"current\":0,\"total\":1},\"state\":\"running\",\"task_id\":\"1\"}\n\n"+
+		"event: progress\ndata: {\"current\":0,\"total\":1}\n\n"+
+		"event: state\ndata: {\"progress\":{\"current\":1,\"total\":1},\"state\":\"succeeded\",\"task_id\":\"1\"}\n\n"+
+		"event: progress\ndata: {\"current\":1,\"total\":1}\n\n"+
+		"event: result\ndata: {\"foo\":\"bar\"}\n\n", rec.Body.String())
+}
+
+func (s *taskSuite) TestDelayLocation() {
+	ctrl := NewDelay(s.cfg, &fakeTask{})
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"delay": "1s"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.ProcessBackground(ctx)
+	s.NoError(err)
+	s.Equal(BaseURL()+"/queues/default/tasks/1", rec.Header().Get(echo.HeaderLocation))
+}
+
+func (s *taskSuite) TestStreamFailed() {
+	fake := &fakeTask{
+		infos: []*task.Info{{ID: "1", State: "failed", Error: "boom"}},
+	}
+
+	ctrl := NewTask(s.cfg, fake, context.Background())
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	err := ctrl.StreamTask(ctx, task.DefaultQueueName, "1")
+	s.NoError(err)
+	s.Equal("event: state\ndata: {\"error\":{\"code\":\"task_failed\",\"message\":\"boom\"},\"state\":\"failed\",\"task_id\":\"1\"}\n\n"+
+		"event: error\ndata: {\"code\":\"task_failed\",\"message\":\"boom\"}\n\n", rec.Body.String())
+}
+
+func (s *taskSuite) TestStreamShutdown() {
+	fake := &fakeTask{
+		infos: []*task.Info{{ID: "1", State: "running"}},
+	}
+
+	shutdown, cancel := context.WithCancel(context.Background())
+	cancel()
+	ctrl := NewTask(s.cfg, fake, shutdown)
+
+	e := echo.New()
+	req := httptest.NewRequest(echo.GET, "/", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	// returns after the first event instead of polling until the task finishes
+	err := ctrl.StreamTask(ctx, task.DefaultQueueName, "1")
+	s.NoError(err)
+	s.Equal("event: state\ndata: {\"state\":\"running\",\"task_id\":\"1\"}\n\n", rec.Body.String())
+}
@@ -0,0 +1,30 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"net"
+
+	"github.com/labstack/echo/v4"
+)
+
+// newIPExtractor returns the client IP of the requests, used by the rate limits and the idempotency keys. The
+// X-Forwarded-For header is only read from the trusted proxies, otherwise the client IP is the address of the
+// connection, so the clients can't pick their own.
+func newIPExtractor(trustedProxies []string) echo.IPExtractor {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	options := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, proxy := range trustedProxies {
+		// validated by the settings
+		if _, network, err := net.ParseCIDR(proxy); err == nil {
+			options = append(options, echo.TrustIPRange(network))
+		}
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...)
+}
@@ -0,0 +1,33 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPExtractor(t *testing.T) {
+	request := func(remoteAddr string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set(echo.HeaderXForwardedFor, "198.51.100.7")
+		req.Header.Set(echo.HeaderXRealIP, "198.51.100.8")
+		return req
+	}
+
+	// without trusted proxies the headers are ignored
+	extract := newIPExtractor(nil)
+	assert.Equal(t, "10.0.0.1", extract(request("10.0.0.1:1234")))
+
+	extract = newIPExtractor([]string{"10.0.0.0/24"})
+	assert.Equal(t, "198.51.100.7", extract(request("10.0.0.1:1234")))
+	// the private networks aren't trusted by default
+	assert.Equal(t, "192.168.0.1", extract(request("192.168.0.1:1234")))
+}
@@ -0,0 +1,69 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/db"
+	"go.megpoid.dev/go-skel/pkg/migration"
+)
+
+func migrationAssets() migration.AssetOptions {
+	return migration.AssetOptions{
+		FS:           db.Assets(),
+		Root:         "migrations",
+		GoMigrations: db.GoMigrations(),
+	}
+}
+
+// migrateDatabase applies the pending migrations if enabled. Otherwise, it checks that the schema isn't
+// behind the embedded migrations and fails in strict mode or only warns. Both give up after the migration timeout.
+func migrateDatabase(ctx context.Context, pool *pgxpool.Pool, cfg config.GeneralSettings) error {
+	if cfg.MigrationTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.MigrationTimeout)
+		defer cancel()
+	}
+
+	if cfg.RunMigrations {
+		slog.Info("Applying pending migrations")
+
+		// takes the advisory lock, so the other instances wait until the migrations are applied
+		err := migration.RunMigrations(ctx, pool, migration.Options{
+			TableName:      migration.DefaultTableName,
+			MigrationAsset: migrationAssets(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to run migrations: %w", err)
+		}
+
+		return nil
+	}
+
+	pending, err := migration.PendingMigrations(ctx, pool, migration.DefaultTableName, migrationAssets())
+	if err != nil {
+		return fmt.Errorf("failed to check migrations: %w", err)
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if cfg.StrictMigrations {
+		return fmt.Errorf("the database schema is behind by %d migrations, next: %s", len(pending), pending[0])
+	}
+
+	slog.Warn("The database schema is behind the embedded migrations",
+		slog.Int("pending", len(pending)),
+		slog.String("next", pending[0]),
+	)
+
+	return nil
+}
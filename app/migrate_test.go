@@ -0,0 +1,48 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.megpoid.dev/go-skel/config"
+)
+
+func TestMigrateDatabaseTimeout(t *testing.T) {
+	// accepts the connections but never answers, like a database stuck on the migration lock
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	pool, err := pgxpool.New(context.Background(), "postgres://user:pass@"+listener.Addr().String()+"/db?sslmode=disable")
+	require.NoError(t, err)
+	defer pool.Close()
+
+	for _, run := range []bool{true, false} {
+		start := time.Now()
+		err = migrateDatabase(context.Background(), pool, config.GeneralSettings{
+			RunMigrations:    run,
+			MigrationTimeout: 100 * time.Millisecond,
+		})
+		assert.Error(t, err)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	}
+}
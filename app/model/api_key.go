@@ -0,0 +1,78 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"database/sql/driver"
+	"strings"
+	"time"
+
+	"go.megpoid.dev/go-skel/pkg/model"
+)
+
+// Scopes are the permissions granted to a key, stored as a text array.
+type Scopes []string
+
+// Value returns the scopes as an array literal, so the query builder doesn't expand them as a list of values.
+func (s Scopes) Value() (driver.Value, error) {
+	quoted := make([]string, len(s))
+	for i, scope := range s {
+		scope = strings.ReplaceAll(scope, `\`, `\\`)
+		quoted[i] = `"` + strings.ReplaceAll(scope, `"`, `\"`) + `"`
+	}
+
+	return "{" + strings.Join(quoted, ",") + "}", nil
+}
+
+// APIKey is a key used on the X-API-Key header, only its hash is stored.
+type APIKey struct {
+	model.Model
+	Name  string `json:"name"`
+	Owner string `json:"owner"`
+	// Prefix identifies the key, it's shown so the owner can tell the keys apart
+	Prefix     string     `json:"prefix"`
+	Hash       string     `json:"-"`
+	Scopes     Scopes     `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
+
+func NewAPIKey(opts ...model.Option) *APIKey {
+	k := &APIKey{
+		Model:  model.NewModel(opts...),
+		Scopes: Scopes{},
+	}
+	return k
+}
+
+// Active returns true if the key wasn't revoked and isn't expired.
+func (k *APIKey) Active(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+type APIKeyRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+	// Owner defaults to the caller, only an admin can set another owner
+	Owner     string     `json:"owner" validate:"max=255"`
+	Scopes    []string   `json:"scopes" validate:"max=32,dive,required,max=64,printascii"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func (r *APIKeyRequest) APIKey(opts ...model.Option) *APIKey {
+	key := NewAPIKey(opts...)
+	key.Name = r.Name
+	key.Owner = r.Owner
+	if r.Scopes != nil {
+		key.Scopes = r.Scopes
+	}
+	key.ExpiresAt = r.ExpiresAt
+
+	return key
+}
@@ -0,0 +1,38 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopesValue(t *testing.T) {
+	value, err := Scopes{}.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "{}", value)
+
+	value, err = Scopes{"read", `say "hi"`, `a\b`}.Value()
+	require.NoError(t, err)
+	assert.Equal(t, `{"read","say \"hi\"","a\\b"}`, value)
+}
+
+func TestAPIKeyActive(t *testing.T) {
+	now := time.Now()
+	key := NewAPIKey()
+	assert.True(t, key.Active(now))
+
+	expires := now.Add(time.Hour)
+	key.ExpiresAt = &expires
+	assert.True(t, key.Active(now))
+	assert.False(t, key.Active(expires))
+
+	key.ExpiresAt = nil
+	key.RevokedAt = &now
+	assert.False(t, key.Active(now))
+}
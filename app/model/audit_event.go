@@ -0,0 +1,42 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package model
+
+import "time"
+
+const (
+	AuditActionLogin          = "login"
+	AuditActionRegister       = "register"
+	AuditActionPasswordChange = "password_change"
+	AuditActionRefresh        = "refresh"
+	AuditActionLogout         = "logout"
+)
+
+const (
+	AuditReasonInvalidCredentials = "invalid_credentials"
+	AuditReasonLocked             = "locked"
+	// AuditReasonTokenReused is a refresh token used after it was rotated, its family is revoked
+	AuditReasonTokenReused = "token_reused"
+)
+
+// AuditEvent records a security relevant action, like a login attempt.
+type AuditEvent struct {
+	ID        int64     `json:"id" goqu:"skipinsert,skipupdate"`
+	CreatedAt time.Time `json:"created_at"`
+	Action    string    `json:"action"`
+	Success   bool      `json:"success"`
+	UserID    *int64    `json:"user_id,omitempty"`
+	Username  string    `json:"username"`
+	IPAddress string    `json:"ip_address"`
+	Reason    string    `json:"reason,omitempty"`
+}
+
+func (e *AuditEvent) GetID() int64 {
+	return e.ID
+}
+
+func (e *AuditEvent) SetID(id int64) {
+	e.ID = id
+}
@@ -0,0 +1,33 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package model
+
+import "time"
+
+const (
+	EventOperationInsert = "INSERT"
+	EventOperationUpdate = "UPDATE"
+	EventOperationDelete = "DELETE"
+	// EventOperationReset is sent when the requested events are no longer in the log,
+	// the client must reload the resources.
+	EventOperationReset = "RESET"
+)
+
+// Event is a change made to a resource, recorded by a database trigger.
+type Event struct {
+	ID         int64     `json:"id" goqu:"skipinsert,skipupdate"`
+	CreatedAt  time.Time `json:"created_at"`
+	Operation  string    `json:"operation"`
+	Resource   string    `json:"resource"`
+	ResourceID int64     `json:"resource_id"`
+}
+
+func (e *Event) GetID() int64 {
+	return e.ID
+}
+
+func (e *Event) SetID(id int64) {
+	e.ID = id
+}
@@ -20,10 +20,12 @@ func NewProfile(opts ...model.Option) *Profile {
 	return p
 }
 
+// ProfileRequest has the validation rules of the table constraints, used where the request doesn't go through the
+// OpenAPI validator (e.g. the bulk import)
 type ProfileRequest struct {
-	Email     string `json:"email"`
-	FirstName string `json:"first_name"`
-	LastName  string `json:"last_name"`
+	Email     string `json:"email" validate:"required,email,max=254"`
+	FirstName string `json:"first_name" validate:"required,max=255"`
+	LastName  string `json:"last_name" validate:"required,max=255"`
 }
 
 func (p *ProfileRequest) Profile(opts ...model.Option) *Profile {
@@ -34,3 +36,28 @@ func (p *ProfileRequest) Profile(opts ...model.Option) *Profile {
 
 	return profile
 }
+
+// Request returns the editable fields of the profile, used as the document of the patch requests
+func (p *Profile) Request() *ProfileRequest {
+	return &ProfileRequest{
+		Email:     p.Email,
+		FirstName: p.FirstName,
+		LastName:  p.LastName,
+	}
+}
+
+// Changes returns the columns that differ from the profile, with the new values
+func (p *ProfileRequest) Changes(profile *Profile) map[string]any {
+	changes := map[string]any{}
+	if p.Email != profile.Email {
+		changes["email"] = p.Email
+	}
+	if p.FirstName != profile.FirstName {
+		changes["first_name"] = p.FirstName
+	}
+	if p.LastName != profile.LastName {
+		changes["last_name"] = p.LastName
+	}
+
+	return changes
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"time"
+
+	"go.megpoid.dev/go-skel/pkg/model"
+)
+
+const (
+	ProfileImportPending   = "pending"
+	ProfileImportRunning   = "running"
+	ProfileImportCompleted = "completed"
+	ProfileImportFailed    = "failed"
+)
+
+// ProfileImport tracks the progress of a bulk profile import.
+type ProfileImport struct {
+	model.Model
+	Status   string `json:"status"`
+	Filename string `json:"filename"`
+	Format   string `json:"format"`
+	// FileKey is the location of the uploaded file on the storage
+	FileKey    string     `json:"-"`
+	Total      int64      `json:"total"`
+	Processed  int64      `json:"processed"`
+	Imported   int64      `json:"imported"`
+	Failed     int64      `json:"failed"`
+	Error      *string    `json:"error,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+func NewProfileImport(opts ...model.Option) *ProfileImport {
+	p := &ProfileImport{
+		Model:  model.NewModel(opts...),
+		Status: ProfileImportPending,
+	}
+	return p
+}
+
+// Finished returns true if the import won't make more progress.
+func (p *ProfileImport) Finished() bool {
+	return p.Status == ProfileImportCompleted || p.Status == ProfileImportFailed
+}
+
+// ProfileImportError is a row of the import that couldn't be validated or saved.
+type ProfileImportError struct {
+	ID       int64  `json:"-" goqu:"skipinsert,skipupdate"`
+	ImportID int64  `json:"import_id"`
+	Line     int64  `json:"line"`
+	Email    string `json:"email"`
+	Message  string `json:"message"`
+}
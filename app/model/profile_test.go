@@ -19,3 +19,13 @@ func TestNewProfile(t *testing.T) {
 	assert.Equal(t, "John", profile.FirstName)
 	assert.Equal(t, "Doe", profile.LastName)
 }
+
+func TestProfileRequestChanges(t *testing.T) {
+	profile := &Profile{FirstName: "John", LastName: "Doe", Email: "john.doe@example.com"}
+
+	request := profile.Request()
+	assert.Empty(t, request.Changes(profile))
+
+	request.LastName = "Smith"
+	assert.Equal(t, map[string]any{"last_name": "Smith"}, request.Changes(profile))
+}
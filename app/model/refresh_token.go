@@ -0,0 +1,57 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package model
+
+import "time"
+
+// RefreshToken is exchanged for a new access token, it can only be used once. The tokens rotated from the same
+// login share the family, so all of them can be revoked if a used token is presented again.
+type RefreshToken struct {
+	ID        int64      `json:"id" goqu:"skipinsert,skipupdate"`
+	CreatedAt time.Time  `json:"created_at"`
+	UserID    int64      `json:"user_id"`
+	FamilyID  string     `json:"family_id"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+func (t *RefreshToken) GetID() int64 {
+	return t.ID
+}
+
+func (t *RefreshToken) SetID(id int64) {
+	t.ID = id
+}
+
+// RevokedToken is an access token that can't be used anymore, kept until it expires.
+type RevokedToken struct {
+	ID        int64     `json:"id" goqu:"skipinsert,skipupdate"`
+	TokenID   string    `json:"token_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (t *RevokedToken) GetID() int64 {
+	return t.ID
+}
+
+func (t *RevokedToken) SetID(id int64) {
+	t.ID = id
+}
+
+// AuthTokens are issued by the login and the refresh of the tokens.
+type AuthTokens struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+// Session is the access token used by a request.
+type Session struct {
+	UserID    int64
+	TokenID   string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,26 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package model
+
+import "time"
+
+// SigningKey signs the access tokens while active. Once retired it only verifies them, until it expires.
+type SigningKey struct {
+	ID         int64      `json:"id" goqu:"skipinsert,skipupdate"`
+	CreatedAt  time.Time  `json:"created_at"`
+	KeyID      string     `json:"kid"`
+	Algorithm  string     `json:"algorithm"`
+	PrivateKey []byte     `json:"-"`
+	RetiredAt  *time.Time `json:"retired_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+}
+
+func (k *SigningKey) GetID() int64 {
+	return k.ID
+}
+
+func (k *SigningKey) SetID(id int64) {
+	k.ID = id
+}
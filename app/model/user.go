@@ -0,0 +1,66 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package model
+
+import (
+	"strings"
+	"time"
+
+	"go.megpoid.dev/go-skel/pkg/model"
+)
+
+// User is an account that can log in with a password, only its hash is stored.
+type User struct {
+	model.Model
+	Username     string `json:"username"`
+	PasswordHash string `json:"-"`
+	// Scopes are granted to the access tokens of the user
+	Scopes Scopes `json:"scopes"`
+	// FailedLogins counts the consecutive failed logins, reset after a successful one or when the account is locked
+	FailedLogins int        `json:"-"`
+	LockedUntil  *time.Time `json:"-"`
+	LastLoginAt  *time.Time `json:"last_login_at,omitempty"`
+}
+
+func NewUser(opts ...model.Option) *User {
+	u := &User{
+		Model:  model.NewModel(opts...),
+		Scopes: Scopes{},
+	}
+	return u
+}
+
+// Locked returns true if the account can't log in because of the failed logins.
+func (u *User) Locked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
+}
+
+// NormalizeUsername returns the username as stored, so the logins aren't case-sensitive.
+func NormalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+type UserRequest struct {
+	Username string `json:"username" validate:"required,min=3,max=64,printascii"`
+	Password string `json:"password" validate:"required,min=8,max=128"`
+	// Scopes can only be granted with the CLI, the registration ignores them
+	Scopes []string `json:"-" validate:"max=32,dive,required,max=64,printascii"`
+}
+
+// User returns the new user, without the password hash.
+func (r *UserRequest) User(opts ...model.Option) *User {
+	user := NewUser(opts...)
+	user.Username = NormalizeUsername(r.Username)
+	if r.Scopes != nil {
+		user.Scopes = r.Scopes
+	}
+
+	return user
+}
+
+type PasswordChangeRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,min=8,max=128,nefield=CurrentPassword"`
+}
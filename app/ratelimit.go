@@ -0,0 +1,112 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/redis/go-redis/v9"
+	"go.megpoid.dev/go-skel/app/controller"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/pkg/ratelimit"
+)
+
+// rateLimitTimeout bounds the calls to redis, so a failing server is replaced by the fallback quickly
+const rateLimitTimeout = 100 * time.Millisecond
+
+var pathParam = regexp.MustCompile(`\{([^}]+)}`)
+
+// rateLimits are the limits of the requests, read from the settings.
+type rateLimits struct {
+	// client applies to every client IP, before the authentication
+	client ratelimit.Limit
+	// principal applies to every principal, after the authentication
+	principal ratelimit.Limit
+	routes    map[string]ratelimit.Limit
+}
+
+func newRateLimits(cfg config.ServerSettings, spec *openapi3.T) (*rateLimits, error) {
+	client, err := ratelimit.ParseLimit(cfg.RateLimitIP)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the client rate limit: %w", err)
+	}
+
+	principal, err := ratelimit.ParseLimit(cfg.RateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the rate limit: %w", err)
+	}
+
+	routes, err := rateLimitRoutes(cfg.RateLimitRoutes, spec)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the rate limit routes: %w", err)
+	}
+
+	return &rateLimits{client: client, principal: principal, routes: routes}, nil
+}
+
+// NewRateLimitStore returns the store of the rate limits, the redis client is only returned if it's used.
+func NewRateLimitStore(cfg config.ServerSettings, redisAddr string) (ratelimit.Store, *redis.Client) {
+	if cfg.RateLimitStore == "memory" {
+		return ratelimit.NewMemoryStore(), nil
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:         redisAddr,
+		DialTimeout:  rateLimitTimeout,
+		ReadTimeout:  rateLimitTimeout,
+		WriteTimeout: rateLimitTimeout,
+	})
+
+	return ratelimit.NewFallbackStore(ratelimit.NewRedisStore(client, "ratelimit:"), ratelimit.NewMemoryStore()), client
+}
+
+// rateLimitRoutes reads the limits of the routes, keyed by an operation ID of the spec or by the method and
+// path, like "POST /profiles/{id}".
+func rateLimitRoutes(routes []string, spec *openapi3.T) (map[string]ratelimit.Limit, error) {
+	operations := make(map[string]string)
+	for path, item := range spec.Paths.Map() {
+		for method, operation := range item.Operations() {
+			if operation.OperationID != "" {
+				operations[operation.OperationID] = routeKey(method, path)
+			}
+		}
+	}
+
+	limits := make(map[string]ratelimit.Limit, len(routes))
+	for _, route := range routes {
+		name, value, ok := strings.Cut(route, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate limit route %q, must be name=requests/period", route)
+		}
+
+		limit, err := ratelimit.ParseLimit(value)
+		if err != nil {
+			return nil, err
+		}
+
+		name = strings.TrimSpace(name)
+		if key, ok := operations[name]; ok {
+			limits[key] = limit
+			continue
+		}
+
+		method, path, ok := strings.Cut(name, " ")
+		if !ok {
+			return nil, fmt.Errorf("unknown operation %q in the rate limit routes", name)
+		}
+		limits[routeKey(method, strings.TrimSpace(path))] = limit
+	}
+
+	return limits, nil
+}
+
+// routeKey returns the key of the route used by the middleware, with the path of the router.
+func routeKey(method, path string) string {
+	return strings.ToUpper(method) + " " + controller.BaseURL() + pathParam.ReplaceAllString(path, ":$1")
+}
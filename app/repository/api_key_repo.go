@@ -0,0 +1,40 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/repo/filter"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+type APIKeyRepoImpl struct {
+	*repo.GenericStoreImpl[*model.APIKey]
+}
+
+func NewAPIKey(conn sql.Executor) *APIKeyRepoImpl {
+	s := &APIKeyRepoImpl{
+		GenericStoreImpl: repo.NewStore(conn, repo.WithFilters[*model.APIKey](
+			filter.Rule{
+				Key:  "name",
+				Type: "string",
+			}, filter.Rule{
+				Key:  "owner",
+				Type: "string",
+			}, filter.Rule{
+				Key:  "created_at",
+				Type: "timestamp",
+			},
+		)),
+	}
+	return s
+}
+
+func (s *APIKeyRepoImpl) GetByPrefix(ctx context.Context, prefix string) (*model.APIKey, error) {
+	return s.GetBy(ctx, repo.Ex{"prefix": prefix})
+}
@@ -0,0 +1,55 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+)
+
+func TestAPIKeyRepo(t *testing.T) {
+	suite.Run(t, &apiKeySuite{})
+}
+
+type apiKeySuite struct {
+	suite.Suite
+	conn *repo.Connection
+}
+
+func (s *apiKeySuite) SetupTest() {
+	s.conn = repo.NewTestConnection(s.T(), true)
+}
+
+func (s *apiKeySuite) TearDownTest() {
+	if s.conn != nil {
+		s.conn.Close(s.T())
+	}
+}
+
+func (s *apiKeySuite) TestGetByPrefix() {
+	ctx := context.Background()
+	r := NewAPIKey(s.conn.Store)
+
+	key := model.NewAPIKey()
+	key.Name = "deploy"
+	key.Owner = "john"
+	key.Prefix = "sk_0123456789ab"
+	key.Hash = "hash"
+	key.Scopes = model.Scopes{"read", `quoted"scope`}
+	s.Require().NoError(r.Insert(ctx, key))
+
+	found, err := r.GetByPrefix(ctx, "sk_0123456789ab")
+	s.Require().NoError(err)
+	s.Equal(key.ID, found.ID)
+	s.Equal("hash", found.Hash)
+	s.Equal(model.Scopes{"read", `quoted"scope`}, found.Scopes)
+
+	_, err = r.GetByPrefix(ctx, "sk_ba9876543210")
+	s.ErrorIs(err, repo.ErrNotFound)
+}
@@ -0,0 +1,34 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/repo/filter"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+type AuditEventRepoImpl struct {
+	*repo.GenericStoreImpl[*model.AuditEvent]
+}
+
+func NewAuditEvent(conn sql.Executor) *AuditEventRepoImpl {
+	s := &AuditEventRepoImpl{
+		GenericStoreImpl: repo.NewStore(conn, repo.WithFilters[*model.AuditEvent](
+			filter.Rule{
+				Key:  "action",
+				Type: "string",
+			}, filter.Rule{
+				Key:  "user_id",
+				Type: "integer",
+			}, filter.Rule{
+				Key:  "created_at",
+				Type: "timestamp",
+			},
+		)),
+	}
+	return s
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+// EventChannel is the notification channel used by the notify_change trigger.
+const EventChannel = "events"
+
+type EventRepoImpl struct {
+	conn    sql.Executor
+	hub     *sql.Hub
+	builder goqu.DialectWrapper
+}
+
+func NewEvent(conn sql.Executor, hub *sql.Hub) *EventRepoImpl {
+	return &EventRepoImpl{
+		conn:    conn,
+		hub:     hub,
+		builder: sql.NewQueryBuilder(),
+	}
+}
+
+// ListAfter returns the logged events with an ID greater than the given one, ordered by ID.
+func (s *EventRepoImpl) ListAfter(ctx context.Context, id int64, resources []string, limit uint) ([]*model.Event, error) {
+	query, args, err := s.builder.From("events").
+		Where(goqu.C("id").Gt(id), goqu.C("resource").In(resources)).
+		Order(goqu.C("id").Asc()).
+		Limit(limit).
+		Prepared(true).ToSQL()
+	if err != nil {
+		return nil, repo.NewRepoError(repo.ErrBackend, err)
+	}
+
+	results := make([]*model.Event, 0)
+	if err := s.conn.Select(ctx, &results, query, args...); err != nil {
+		return nil, repo.NewRepoError(repo.ErrBackend, err)
+	}
+
+	return results, nil
+}
+
+// FirstID returns the ID of the oldest event still available in the log, or zero if the log is empty.
+func (s *EventRepoImpl) FirstID(ctx context.Context) (int64, error) {
+	var id int64
+	if err := s.conn.Get(ctx, &id, "SELECT coalesce(min(id), 0) FROM events"); err != nil {
+		return 0, repo.NewRepoError(repo.ErrBackend, err)
+	}
+
+	return id, nil
+}
+
+// Subscribe delivers the events notified by the database until the context is canceled.
+func (s *EventRepoImpl) Subscribe(ctx context.Context) <-chan *model.Event {
+	sub := s.hub.Subscribe(EventChannel)
+	events := make(chan *model.Event)
+
+	go func() {
+		defer close(events)
+		defer sub.Close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n, ok := <-sub.C:
+				if !ok {
+					return
+				}
+
+				event, err := sql.Decode[model.Event](n)
+				if err != nil {
+					slog.ErrorContext(ctx, "Failed to decode event", slog.String("error", err.Error()))
+					continue
+				}
+
+				select {
+				case events <- &event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.megpoid.dev/go-skel/pkg/idempotency"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+// acquireAttempts limits the retries when the record of a key expires between the insert and the select
+const acquireAttempts = 3
+
+type IdempotencyRepoImpl struct {
+	conn sql.Executor
+}
+
+func NewIdempotency(conn sql.Executor) *IdempotencyRepoImpl {
+	return &IdempotencyRepoImpl{conn: conn}
+}
+
+type idempotencyRecord struct {
+	Fingerprint     string
+	Completed       bool
+	LockedUntil     time.Time
+	ResponseStatus  *int
+	ResponseHeaders http.Header
+	ResponseBody    []byte
+}
+
+// Acquire inserts the key, replacing it if it's expired or its lease ended before the request was completed. If
+// another request has the key then its record is returned.
+func (s *IdempotencyRepoImpl) Acquire(ctx context.Context, key, fingerprint string, lease, ttl time.Duration) (*idempotency.Record, bool, error) {
+	for range acquireAttempts {
+		// the lease identifies the request, so it's truncated to the precision of the database
+		now := time.Now().Truncate(time.Microsecond)
+		lockedUntil := now.Add(lease)
+		result, err := s.conn.Exec(ctx, `INSERT INTO idempotency_keys (key, fingerprint, created_at, locked_until, expires_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (key) DO UPDATE SET fingerprint = excluded.fingerprint, completed = false,
+				response_status = NULL, response_headers = NULL, response_body = NULL,
+				created_at = excluded.created_at, locked_until = excluded.locked_until, expires_at = excluded.expires_at
+			WHERE idempotency_keys.expires_at <= excluded.created_at
+				OR (NOT idempotency_keys.completed AND idempotency_keys.locked_until <= excluded.created_at)`,
+			key, fingerprint, now, lockedUntil, now.Add(ttl))
+		if err != nil {
+			return nil, false, repo.NewRepoError(repo.ErrBackend, err)
+		}
+		if result.RowsAffected() == 1 {
+			return &idempotency.Record{Fingerprint: fingerprint, LockedUntil: lockedUntil}, true, nil
+		}
+
+		var record idempotencyRecord
+		err = s.conn.Get(ctx, &record, `SELECT fingerprint, completed, locked_until, response_status, response_headers, response_body
+			FROM idempotency_keys WHERE key = $1`, key)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			// released or expired after the insert, try again
+			continue
+		case err != nil:
+			return nil, false, repo.NewRepoError(repo.ErrBackend, err)
+		}
+
+		current := &idempotency.Record{
+			Fingerprint: record.Fingerprint,
+			Completed:   record.Completed,
+			LockedUntil: record.LockedUntil,
+			Header:      record.ResponseHeaders,
+			Body:        record.ResponseBody,
+		}
+		if record.ResponseStatus != nil {
+			current.Status = *record.ResponseStatus
+		}
+
+		return current, false, nil
+	}
+
+	return nil, false, repo.NewRepoError(repo.ErrBackend, errors.New("cannot acquire the idempotency key"))
+}
+
+// Complete saves the response of the key, if it's still held by the request that acquired it.
+func (s *IdempotencyRepoImpl) Complete(ctx context.Context, key string, record *idempotency.Record) error {
+	_, err := s.conn.Exec(ctx, `UPDATE idempotency_keys
+		SET completed = true, response_status = $3, response_headers = $4, response_body = $5
+		WHERE key = $1 AND locked_until = $2`,
+		key, record.LockedUntil, record.Status, record.Header, record.Body)
+	if err != nil {
+		return repo.NewRepoError(repo.ErrBackend, err)
+	}
+
+	return nil
+}
+
+// Release removes the key, if it's still held by the request that acquired it.
+func (s *IdempotencyRepoImpl) Release(ctx context.Context, key string, record *idempotency.Record) error {
+	_, err := s.conn.Exec(ctx, "DELETE FROM idempotency_keys WHERE key = $1 AND locked_until = $2",
+		key, record.LockedUntil)
+	if err != nil {
+		return repo.NewRepoError(repo.ErrBackend, err)
+	}
+
+	return nil
+}
+
+// DeleteExpired removes the expired keys, returning the number of deleted keys.
+func (s *IdempotencyRepoImpl) DeleteExpired(ctx context.Context) (int64, error) {
+	result, err := s.conn.Exec(ctx, "DELETE FROM idempotency_keys WHERE expires_at <= $1", time.Now())
+	if err != nil {
+		return 0, repo.NewRepoError(repo.ErrBackend, err)
+	}
+
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.megpoid.dev/go-skel/pkg/idempotency"
+	"go.megpoid.dev/go-skel/pkg/repo"
+)
+
+func TestIdempotencyStore(t *testing.T) {
+	suite.Run(t, &idempotencySuite{})
+}
+
+type idempotencySuite struct {
+	suite.Suite
+	conn *repo.Connection
+}
+
+func (s *idempotencySuite) SetupTest() {
+	s.conn = repo.NewTestConnection(s.T(), true)
+}
+
+func (s *idempotencySuite) TearDownTest() {
+	if s.conn != nil {
+		s.conn.Close(s.T())
+	}
+}
+
+func (s *idempotencySuite) TestAcquire() {
+	ctx := context.Background()
+	store := NewIdempotency(s.conn.Store)
+
+	acquiredRecord, acquired, err := store.Acquire(ctx, "scope:key", "fingerprint", time.Minute, time.Hour)
+	s.Require().NoError(err)
+	s.True(acquired)
+
+	record, acquired, err := store.Acquire(ctx, "scope:key", "other", time.Minute, time.Hour)
+	s.Require().NoError(err)
+	s.False(acquired)
+	s.Equal("fingerprint", record.Fingerprint)
+	s.False(record.Completed)
+	s.True(acquiredRecord.LockedUntil.Equal(record.LockedUntil))
+
+	s.Require().NoError(store.Complete(ctx, "scope:key", &idempotency.Record{
+		LockedUntil: acquiredRecord.LockedUntil,
+		Status:      http.StatusCreated,
+		Header:      http.Header{"Location": []string{"/profiles/1"}},
+		Body:        []byte(`{"id":1}`),
+	}))
+
+	record, acquired, err = store.Acquire(ctx, "scope:key", "fingerprint", time.Minute, time.Hour)
+	s.Require().NoError(err)
+	s.False(acquired)
+	s.True(record.Completed)
+	s.Equal(http.StatusCreated, record.Status)
+	s.Equal("/profiles/1", record.Header.Get("Location"))
+	s.Equal(`{"id":1}`, string(record.Body))
+
+	s.Require().NoError(store.Release(ctx, "scope:key", acquiredRecord))
+	_, acquired, err = store.Acquire(ctx, "scope:key", "fingerprint", time.Minute, time.Hour)
+	s.Require().NoError(err)
+	s.True(acquired)
+}
+
+func (s *idempotencySuite) TestLease() {
+	ctx := context.Background()
+	store := NewIdempotency(s.conn.Store)
+
+	stalled, acquired, err := store.Acquire(ctx, "scope:lease", "fingerprint", -time.Second, time.Hour)
+	s.Require().NoError(err)
+	s.True(acquired)
+
+	// the lease ended before the request was completed, so the retry takes over the key
+	retry, acquired, err := store.Acquire(ctx, "scope:lease", "fingerprint", time.Minute, time.Hour)
+	s.Require().NoError(err)
+	s.True(acquired)
+
+	// the stalled request can't complete nor release the key anymore
+	s.Require().NoError(store.Complete(ctx, "scope:lease", &idempotency.Record{LockedUntil: stalled.LockedUntil, Status: http.StatusOK}))
+	s.Require().NoError(store.Release(ctx, "scope:lease", stalled))
+	record, acquired, err := store.Acquire(ctx, "scope:lease", "fingerprint", time.Minute, time.Hour)
+	s.Require().NoError(err)
+	s.False(acquired)
+	s.False(record.Completed)
+
+	s.Require().NoError(store.Release(ctx, "scope:lease", retry))
+	_, acquired, err = store.Acquire(ctx, "scope:lease", "fingerprint", time.Minute, time.Hour)
+	s.Require().NoError(err)
+	s.True(acquired)
+}
+
+func (s *idempotencySuite) TestExpired() {
+	ctx := context.Background()
+	store := NewIdempotency(s.conn.Store)
+
+	_, acquired, err := store.Acquire(ctx, "scope:expired", "fingerprint", time.Minute, -time.Second)
+	s.Require().NoError(err)
+	s.True(acquired)
+
+	// the expired key is replaced by the new request
+	_, acquired, err = store.Acquire(ctx, "scope:expired", "other", time.Minute, -time.Second)
+	s.Require().NoError(err)
+	s.True(acquired)
+
+	n, err := store.DeleteExpired(ctx)
+	s.Require().NoError(err)
+	s.Equal(int64(1), n)
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+const (
+	profileImportErrorsTable = "profile_import_errors"
+	// errors read at once when generating the report
+	profileImportErrorsBatch = 500
+)
+
+type ProfileImportRepoImpl struct {
+	*repo.GenericStoreImpl[*model.ProfileImport]
+}
+
+func NewProfileImport(conn sql.Executor) *ProfileImportRepoImpl {
+	s := &ProfileImportRepoImpl{
+		GenericStoreImpl: repo.NewStore[*model.ProfileImport](conn),
+	}
+	return s
+}
+
+// AddErrors saves the rows of the import that failed.
+func (s *ProfileImportRepoImpl) AddErrors(ctx context.Context, errs []*model.ProfileImportError) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	query, args, err := s.Builder.Insert(profileImportErrorsTable).Rows(errs).Prepared(true).ToSQL()
+	if err != nil {
+		return repo.NewRepoError(repo.ErrBackend, err)
+	}
+
+	if _, err := s.Conn.Exec(ctx, query, args...); err != nil {
+		return repo.NewRepoError(repo.ErrBackend, err)
+	}
+
+	return nil
+}
+
+// DeleteErrors removes the saved errors of the import, so it can be processed again.
+func (s *ProfileImportRepoImpl) DeleteErrors(ctx context.Context, importID int64) error {
+	query, args, err := s.Builder.Delete(profileImportErrorsTable).
+		Where(goqu.C("import_id").Eq(importID)).
+		Prepared(true).ToSQL()
+	if err != nil {
+		return repo.NewRepoError(repo.ErrBackend, err)
+	}
+
+	if _, err := s.Conn.Exec(ctx, query, args...); err != nil {
+		return repo.NewRepoError(repo.ErrBackend, err)
+	}
+
+	return nil
+}
+
+// ListErrors calls fn for every error of the import in insertion order, reading them in batches.
+func (s *ProfileImportRepoImpl) ListErrors(ctx context.Context, importID int64, fn func(item *model.ProfileImportError) error) error {
+	var lastID int64
+	for {
+		query, args, err := s.Builder.From(profileImportErrorsTable).
+			Where(goqu.C("import_id").Eq(importID), goqu.C("id").Gt(lastID)).
+			Order(goqu.C("id").Asc()).
+			Limit(profileImportErrorsBatch).
+			Prepared(true).ToSQL()
+		if err != nil {
+			return repo.NewRepoError(repo.ErrBackend, err)
+		}
+
+		results := make([]*model.ProfileImportError, 0, profileImportErrorsBatch)
+		if err := s.Conn.Select(ctx, &results, query, args...); err != nil {
+			return repo.NewRepoError(repo.ErrBackend, err)
+		}
+
+		for _, item := range results {
+			if err := fn(item); err != nil {
+				return err
+			}
+			lastID = item.ID
+		}
+
+		if len(results) < profileImportErrorsBatch {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+)
+
+func TestProfileImportStore(t *testing.T) {
+	suite.Run(t, &profileImportSuite{})
+}
+
+type profileImportSuite struct {
+	suite.Suite
+	conn *repo.Connection
+}
+
+func (s *profileImportSuite) SetupTest() {
+	s.conn = repo.NewTestConnection(s.T(), true)
+}
+
+func (s *profileImportSuite) TearDownTest() {
+	if s.conn != nil {
+		s.conn.Close(s.T())
+	}
+}
+
+func (s *profileImportSuite) TestErrors() {
+	ctx := context.Background()
+	store := NewProfileImport(s.conn.Store)
+
+	profileImport := model.NewProfileImport()
+	profileImport.Filename = "profiles.csv"
+	profileImport.Format = "csv"
+	profileImport.FileKey = "imports/profiles/1/profiles.csv"
+	s.Require().NoError(store.Insert(ctx, profileImport))
+
+	errs := make([]*model.ProfileImportError, 0, profileImportErrorsBatch+1)
+	for i := range profileImportErrorsBatch + 1 {
+		errs = append(errs, &model.ProfileImportError{
+			ImportID: profileImport.ID,
+			Line:     int64(i + 2),
+			Email:    "invalid",
+			Message:  "invalid fields: email: email",
+		})
+	}
+	s.Require().NoError(store.AddErrors(ctx, errs))
+
+	var lines []int64
+	err := store.ListErrors(ctx, profileImport.ID, func(item *model.ProfileImportError) error {
+		lines = append(lines, item.Line)
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Len(lines, profileImportErrorsBatch+1)
+	s.Equal(int64(2), lines[0])
+
+	s.Require().NoError(store.DeleteErrors(ctx, profileImport.ID))
+
+	lines = nil
+	s.Require().NoError(store.ListErrors(ctx, profileImport.ID, func(item *model.ProfileImportError) error {
+		lines = append(lines, item.Line)
+		return nil
+	}))
+	s.Empty(lines)
+}
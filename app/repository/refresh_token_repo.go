@@ -0,0 +1,29 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+type RefreshTokenRepoImpl struct {
+	*repo.GenericStoreImpl[*model.RefreshToken]
+}
+
+func NewRefreshToken(conn sql.Executor) *RefreshTokenRepoImpl {
+	s := &RefreshTokenRepoImpl{
+		GenericStoreImpl: repo.NewStore[*model.RefreshToken](conn),
+	}
+	return s
+}
+
+// GetByHashForUpdate returns the token with the hash, locking it until the end of the transaction.
+func (s *RefreshTokenRepoImpl) GetByHashForUpdate(ctx context.Context, hash string) (*model.RefreshToken, error) {
+	return s.GetForUpdate(ctx, repo.Ex{"token_hash": hash})
+}
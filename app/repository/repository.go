@@ -6,8 +6,10 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/idempotency"
 	"go.megpoid.dev/go-skel/pkg/repo"
 )
 
@@ -16,7 +18,64 @@ type HealthcheckRepo interface {
 	Execute(ctx context.Context) error
 }
 
+// EventRepo reads the change log of the resources and listens to new changes
+type EventRepo interface {
+	ListAfter(ctx context.Context, id int64, resources []string, limit uint) ([]*model.Event, error)
+	FirstID(ctx context.Context) (int64, error)
+	Subscribe(ctx context.Context) <-chan *model.Event
+}
+
+// APIKeyRepo stores the hashes of the API keys
+type APIKeyRepo interface {
+	repo.GenericStore[*model.APIKey]
+	GetByPrefix(ctx context.Context, prefix string) (*model.APIKey, error)
+}
+
+// UserRepo stores the accounts that log in with a password
+type UserRepo interface {
+	repo.GenericStore[*model.User]
+	GetByUsername(ctx context.Context, username string) (*model.User, error)
+}
+
+// AuditEventRepo records the security relevant actions
+type AuditEventRepo interface {
+	repo.GenericStore[*model.AuditEvent]
+}
+
+// RefreshTokenRepo stores the hashes of the refresh tokens
+type RefreshTokenRepo interface {
+	repo.GenericStore[*model.RefreshToken]
+	GetByHashForUpdate(ctx context.Context, hash string) (*model.RefreshToken, error)
+}
+
+// RevokedTokenRepo is the list of access tokens revoked before they expire
+type RevokedTokenRepo interface {
+	repo.GenericStore[*model.RevokedToken]
+	IsRevoked(ctx context.Context, tokenID string) (bool, error)
+}
+
+// SigningKeyRepo stores the encrypted keys that sign the access tokens
+type SigningKeyRepo interface {
+	repo.GenericStore[*model.SigningKey]
+	GetActiveForUpdate(ctx context.Context) (*model.SigningKey, error)
+	ListValid(ctx context.Context, now time.Time) ([]*model.SigningKey, error)
+}
+
+// IdempotencyRepo keeps the responses of the requests sent with an Idempotency-Key
+type IdempotencyRepo interface {
+	idempotency.Store
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
 type ProfileRepo interface {
 	repo.GenericStore[*model.Profile]
 	GetByEmail(ctx context.Context, email string) (*model.Profile, error)
 }
+
+// ProfileImportRepo tracks the bulk profile imports and the rows that failed
+type ProfileImportRepo interface {
+	repo.GenericStore[*model.ProfileImport]
+	AddErrors(ctx context.Context, errs []*model.ProfileImportError) error
+	DeleteErrors(ctx context.Context, importID int64) error
+	ListErrors(ctx context.Context, importID int64, fn func(item *model.ProfileImportError) error) error
+}
@@ -6,10 +6,12 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 	"go.megpoid.dev/go-skel/app/model"
 	"go.megpoid.dev/go-skel/pkg/clause"
+	"go.megpoid.dev/go-skel/pkg/idempotency"
 	"go.megpoid.dev/go-skel/pkg/repo"
 	"go.megpoid.dev/go-skel/pkg/response"
 )
@@ -86,13 +88,13 @@ func (_c *MockHealthcheckRepo_Execute_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
-// NewMockProfileRepo creates a new instance of MockProfileRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// NewMockAPIKeyRepo creates a new instance of MockAPIKeyRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
-func NewMockProfileRepo(t interface {
+func NewMockAPIKeyRepo(t interface {
 	mock.TestingT
 	Cleanup(func())
-}) *MockProfileRepo {
-	mock := &MockProfileRepo{}
+}) *MockAPIKeyRepo {
+	mock := &MockAPIKeyRepo{}
 	mock.Mock.Test(t)
 
 	t.Cleanup(func() { mock.AssertExpectations(t) })
@@ -100,21 +102,21 @@ func NewMockProfileRepo(t interface {
 	return mock
 }
 
-// MockProfileRepo is an autogenerated mock type for the ProfileRepo type
-type MockProfileRepo struct {
+// MockAPIKeyRepo is an autogenerated mock type for the APIKeyRepo type
+type MockAPIKeyRepo struct {
 	mock.Mock
 }
 
-type MockProfileRepo_Expecter struct {
+type MockAPIKeyRepo_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockProfileRepo) EXPECT() *MockProfileRepo_Expecter {
-	return &MockProfileRepo_Expecter{mock: &_m.Mock}
+func (_m *MockAPIKeyRepo) EXPECT() *MockAPIKeyRepo_Expecter {
+	return &MockAPIKeyRepo_Expecter{mock: &_m.Mock}
 }
 
-// CountBy provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) CountBy(ctx context.Context, expr repo.Expression) (int64, error) {
+// CountBy provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) CountBy(ctx context.Context, expr repo.Expression) (int64, error) {
 	ret := _mock.Called(ctx, expr)
 
 	if len(ret) == 0 {
@@ -139,37 +141,37 @@ func (_mock *MockProfileRepo) CountBy(ctx context.Context, expr repo.Expression)
 	return r0, r1
 }
 
-// MockProfileRepo_CountBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBy'
-type MockProfileRepo_CountBy_Call struct {
+// MockAPIKeyRepo_CountBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBy'
+type MockAPIKeyRepo_CountBy_Call struct {
 	*mock.Call
 }
 
 // CountBy is a helper method to define mock.On call
 //   - ctx
 //   - expr
-func (_e *MockProfileRepo_Expecter) CountBy(ctx interface{}, expr interface{}) *MockProfileRepo_CountBy_Call {
-	return &MockProfileRepo_CountBy_Call{Call: _e.mock.On("CountBy", ctx, expr)}
+func (_e *MockAPIKeyRepo_Expecter) CountBy(ctx interface{}, expr interface{}) *MockAPIKeyRepo_CountBy_Call {
+	return &MockAPIKeyRepo_CountBy_Call{Call: _e.mock.On("CountBy", ctx, expr)}
 }
 
-func (_c *MockProfileRepo_CountBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockProfileRepo_CountBy_Call {
+func (_c *MockAPIKeyRepo_CountBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockAPIKeyRepo_CountBy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(repo.Expression))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_CountBy_Call) Return(n int64, err error) *MockProfileRepo_CountBy_Call {
+func (_c *MockAPIKeyRepo_CountBy_Call) Return(n int64, err error) *MockAPIKeyRepo_CountBy_Call {
 	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_CountBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (int64, error)) *MockProfileRepo_CountBy_Call {
+func (_c *MockAPIKeyRepo_CountBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (int64, error)) *MockAPIKeyRepo_CountBy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) Delete(ctx context.Context, id int64) error {
+// Delete provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) Delete(ctx context.Context, id int64) error {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
@@ -185,37 +187,37 @@ func (_mock *MockProfileRepo) Delete(ctx context.Context, id int64) error {
 	return r0
 }
 
-// MockProfileRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockProfileRepo_Delete_Call struct {
+// MockAPIKeyRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAPIKeyRepo_Delete_Call struct {
 	*mock.Call
 }
 
 // Delete is a helper method to define mock.On call
 //   - ctx
 //   - id
-func (_e *MockProfileRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockProfileRepo_Delete_Call {
-	return &MockProfileRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+func (_e *MockAPIKeyRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockAPIKeyRepo_Delete_Call {
+	return &MockAPIKeyRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
 }
 
-func (_c *MockProfileRepo_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockProfileRepo_Delete_Call {
+func (_c *MockAPIKeyRepo_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockAPIKeyRepo_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_Delete_Call) Return(err error) *MockProfileRepo_Delete_Call {
+func (_c *MockAPIKeyRepo_Delete_Call) Return(err error) *MockAPIKeyRepo_Delete_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProfileRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockProfileRepo_Delete_Call {
+func (_c *MockAPIKeyRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockAPIKeyRepo_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteBy provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) DeleteBy(ctx context.Context, expr repo.Ex) (int64, error) {
+// DeleteBy provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) DeleteBy(ctx context.Context, expr repo.Ex) (int64, error) {
 	ret := _mock.Called(ctx, expr)
 
 	if len(ret) == 0 {
@@ -240,37 +242,37 @@ func (_mock *MockProfileRepo) DeleteBy(ctx context.Context, expr repo.Ex) (int64
 	return r0, r1
 }
 
-// MockProfileRepo_DeleteBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBy'
-type MockProfileRepo_DeleteBy_Call struct {
+// MockAPIKeyRepo_DeleteBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBy'
+type MockAPIKeyRepo_DeleteBy_Call struct {
 	*mock.Call
 }
 
 // DeleteBy is a helper method to define mock.On call
 //   - ctx
 //   - expr
-func (_e *MockProfileRepo_Expecter) DeleteBy(ctx interface{}, expr interface{}) *MockProfileRepo_DeleteBy_Call {
-	return &MockProfileRepo_DeleteBy_Call{Call: _e.mock.On("DeleteBy", ctx, expr)}
+func (_e *MockAPIKeyRepo_Expecter) DeleteBy(ctx interface{}, expr interface{}) *MockAPIKeyRepo_DeleteBy_Call {
+	return &MockAPIKeyRepo_DeleteBy_Call{Call: _e.mock.On("DeleteBy", ctx, expr)}
 }
 
-func (_c *MockProfileRepo_DeleteBy_Call) Run(run func(ctx context.Context, expr repo.Ex)) *MockProfileRepo_DeleteBy_Call {
+func (_c *MockAPIKeyRepo_DeleteBy_Call) Run(run func(ctx context.Context, expr repo.Ex)) *MockAPIKeyRepo_DeleteBy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(repo.Ex))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_DeleteBy_Call) Return(n int64, err error) *MockProfileRepo_DeleteBy_Call {
+func (_c *MockAPIKeyRepo_DeleteBy_Call) Return(n int64, err error) *MockAPIKeyRepo_DeleteBy_Call {
 	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_DeleteBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Ex) (int64, error)) *MockProfileRepo_DeleteBy_Call {
+func (_c *MockAPIKeyRepo_DeleteBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Ex) (int64, error)) *MockAPIKeyRepo_DeleteBy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Exists provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) Exists(ctx context.Context, expr repo.Expression) (bool, error) {
+// Exists provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) Exists(ctx context.Context, expr repo.Expression) (bool, error) {
 	ret := _mock.Called(ctx, expr)
 
 	if len(ret) == 0 {
@@ -295,37 +297,37 @@ func (_mock *MockProfileRepo) Exists(ctx context.Context, expr repo.Expression)
 	return r0, r1
 }
 
-// MockProfileRepo_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
-type MockProfileRepo_Exists_Call struct {
+// MockAPIKeyRepo_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockAPIKeyRepo_Exists_Call struct {
 	*mock.Call
 }
 
 // Exists is a helper method to define mock.On call
 //   - ctx
 //   - expr
-func (_e *MockProfileRepo_Expecter) Exists(ctx interface{}, expr interface{}) *MockProfileRepo_Exists_Call {
-	return &MockProfileRepo_Exists_Call{Call: _e.mock.On("Exists", ctx, expr)}
+func (_e *MockAPIKeyRepo_Expecter) Exists(ctx interface{}, expr interface{}) *MockAPIKeyRepo_Exists_Call {
+	return &MockAPIKeyRepo_Exists_Call{Call: _e.mock.On("Exists", ctx, expr)}
 }
 
-func (_c *MockProfileRepo_Exists_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockProfileRepo_Exists_Call {
+func (_c *MockAPIKeyRepo_Exists_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockAPIKeyRepo_Exists_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(repo.Expression))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_Exists_Call) Return(b bool, err error) *MockProfileRepo_Exists_Call {
+func (_c *MockAPIKeyRepo_Exists_Call) Return(b bool, err error) *MockAPIKeyRepo_Exists_Call {
 	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_Exists_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (bool, error)) *MockProfileRepo_Exists_Call {
+func (_c *MockAPIKeyRepo_Exists_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (bool, error)) *MockAPIKeyRepo_Exists_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Find provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) Find(ctx context.Context, dest *model.Profile, id int64) error {
+// Find provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) Find(ctx context.Context, dest *model.APIKey, id int64) error {
 	ret := _mock.Called(ctx, dest, id)
 
 	if len(ret) == 0 {
@@ -333,7 +335,7 @@ func (_mock *MockProfileRepo) Find(ctx context.Context, dest *model.Profile, id
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Profile, int64) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.APIKey, int64) error); ok {
 		r0 = returnFunc(ctx, dest, id)
 	} else {
 		r0 = ret.Error(0)
@@ -341,8 +343,8 @@ func (_mock *MockProfileRepo) Find(ctx context.Context, dest *model.Profile, id
 	return r0
 }
 
-// MockProfileRepo_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
-type MockProfileRepo_Find_Call struct {
+// MockAPIKeyRepo_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockAPIKeyRepo_Find_Call struct {
 	*mock.Call
 }
 
@@ -350,29 +352,29 @@ type MockProfileRepo_Find_Call struct {
 //   - ctx
 //   - dest
 //   - id
-func (_e *MockProfileRepo_Expecter) Find(ctx interface{}, dest interface{}, id interface{}) *MockProfileRepo_Find_Call {
-	return &MockProfileRepo_Find_Call{Call: _e.mock.On("Find", ctx, dest, id)}
+func (_e *MockAPIKeyRepo_Expecter) Find(ctx interface{}, dest interface{}, id interface{}) *MockAPIKeyRepo_Find_Call {
+	return &MockAPIKeyRepo_Find_Call{Call: _e.mock.On("Find", ctx, dest, id)}
 }
 
-func (_c *MockProfileRepo_Find_Call) Run(run func(ctx context.Context, dest *model.Profile, id int64)) *MockProfileRepo_Find_Call {
+func (_c *MockAPIKeyRepo_Find_Call) Run(run func(ctx context.Context, dest *model.APIKey, id int64)) *MockAPIKeyRepo_Find_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Profile), args[2].(int64))
+		run(args[0].(context.Context), args[1].(*model.APIKey), args[2].(int64))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_Find_Call) Return(err error) *MockProfileRepo_Find_Call {
+func (_c *MockAPIKeyRepo_Find_Call) Return(err error) *MockAPIKeyRepo_Find_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProfileRepo_Find_Call) RunAndReturn(run func(ctx context.Context, dest *model.Profile, id int64) error) *MockProfileRepo_Find_Call {
+func (_c *MockAPIKeyRepo_Find_Call) RunAndReturn(run func(ctx context.Context, dest *model.APIKey, id int64) error) *MockAPIKeyRepo_Find_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// First provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) First(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.Profile, error) {
+// First provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) First(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.APIKey, error) {
 	var tmpRet mock.Arguments
 	if len(order) > 0 {
 		tmpRet = _mock.Called(ctx, expr, order)
@@ -385,16 +387,16 @@ func (_mock *MockProfileRepo) First(ctx context.Context, expr repo.Expression, o
 		panic("no return value specified for First")
 	}
 
-	var r0 *model.Profile
+	var r0 *model.APIKey
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.Profile, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.APIKey, error)); ok {
 		return returnFunc(ctx, expr, order...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.Profile); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.APIKey); ok {
 		r0 = returnFunc(ctx, expr, order...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Profile)
+			r0 = ret.Get(0).(*model.APIKey)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
@@ -405,8 +407,8 @@ func (_mock *MockProfileRepo) First(ctx context.Context, expr repo.Expression, o
 	return r0, r1
 }
 
-// MockProfileRepo_First_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'First'
-type MockProfileRepo_First_Call struct {
+// MockAPIKeyRepo_First_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'First'
+type MockAPIKeyRepo_First_Call struct {
 	*mock.Call
 }
 
@@ -414,12 +416,12 @@ type MockProfileRepo_First_Call struct {
 //   - ctx
 //   - expr
 //   - order
-func (_e *MockProfileRepo_Expecter) First(ctx interface{}, expr interface{}, order ...interface{}) *MockProfileRepo_First_Call {
-	return &MockProfileRepo_First_Call{Call: _e.mock.On("First",
+func (_e *MockAPIKeyRepo_Expecter) First(ctx interface{}, expr interface{}, order ...interface{}) *MockAPIKeyRepo_First_Call {
+	return &MockAPIKeyRepo_First_Call{Call: _e.mock.On("First",
 		append([]interface{}{ctx, expr}, order...)...)}
 }
 
-func (_c *MockProfileRepo_First_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockProfileRepo_First_Call {
+func (_c *MockAPIKeyRepo_First_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockAPIKeyRepo_First_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := args[2].([]repo.OrderedExpression)
 		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
@@ -427,34 +429,34 @@ func (_c *MockProfileRepo_First_Call) Run(run func(ctx context.Context, expr rep
 	return _c
 }
 
-func (_c *MockProfileRepo_First_Call) Return(profile *model.Profile, err error) *MockProfileRepo_First_Call {
-	_c.Call.Return(profile, err)
+func (_c *MockAPIKeyRepo_First_Call) Return(aPIKey *model.APIKey, err error) *MockAPIKeyRepo_First_Call {
+	_c.Call.Return(aPIKey, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_First_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.Profile, error)) *MockProfileRepo_First_Call {
+func (_c *MockAPIKeyRepo_First_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.APIKey, error)) *MockAPIKeyRepo_First_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) Get(ctx context.Context, id int64) (*model.Profile, error) {
+// Get provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) Get(ctx context.Context, id int64) (*model.APIKey, error) {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
 	}
 
-	var r0 *model.Profile
+	var r0 *model.APIKey
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.Profile, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.APIKey, error)); ok {
 		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.Profile); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.APIKey); ok {
 		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Profile)
+			r0 = ret.Get(0).(*model.APIKey)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
@@ -465,53 +467,53 @@ func (_mock *MockProfileRepo) Get(ctx context.Context, id int64) (*model.Profile
 	return r0, r1
 }
 
-// MockProfileRepo_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockProfileRepo_Get_Call struct {
+// MockAPIKeyRepo_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockAPIKeyRepo_Get_Call struct {
 	*mock.Call
 }
 
 // Get is a helper method to define mock.On call
 //   - ctx
 //   - id
-func (_e *MockProfileRepo_Expecter) Get(ctx interface{}, id interface{}) *MockProfileRepo_Get_Call {
-	return &MockProfileRepo_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+func (_e *MockAPIKeyRepo_Expecter) Get(ctx interface{}, id interface{}) *MockAPIKeyRepo_Get_Call {
+	return &MockAPIKeyRepo_Get_Call{Call: _e.mock.On("Get", ctx, id)}
 }
 
-func (_c *MockProfileRepo_Get_Call) Run(run func(ctx context.Context, id int64)) *MockProfileRepo_Get_Call {
+func (_c *MockAPIKeyRepo_Get_Call) Run(run func(ctx context.Context, id int64)) *MockAPIKeyRepo_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_Get_Call) Return(profile *model.Profile, err error) *MockProfileRepo_Get_Call {
-	_c.Call.Return(profile, err)
+func (_c *MockAPIKeyRepo_Get_Call) Return(aPIKey *model.APIKey, err error) *MockAPIKeyRepo_Get_Call {
+	_c.Call.Return(aPIKey, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_Get_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.Profile, error)) *MockProfileRepo_Get_Call {
+func (_c *MockAPIKeyRepo_Get_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.APIKey, error)) *MockAPIKeyRepo_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetBy provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) GetBy(ctx context.Context, expr repo.Expression) (*model.Profile, error) {
+// GetBy provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) GetBy(ctx context.Context, expr repo.Expression) (*model.APIKey, error) {
 	ret := _mock.Called(ctx, expr)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetBy")
 	}
 
-	var r0 *model.Profile
+	var r0 *model.APIKey
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (*model.Profile, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (*model.APIKey, error)); ok {
 		return returnFunc(ctx, expr)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) *model.Profile); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) *model.APIKey); ok {
 		r0 = returnFunc(ctx, expr)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Profile)
+			r0 = ret.Get(0).(*model.APIKey)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
@@ -522,94 +524,94 @@ func (_mock *MockProfileRepo) GetBy(ctx context.Context, expr repo.Expression) (
 	return r0, r1
 }
 
-// MockProfileRepo_GetBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBy'
-type MockProfileRepo_GetBy_Call struct {
+// MockAPIKeyRepo_GetBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBy'
+type MockAPIKeyRepo_GetBy_Call struct {
 	*mock.Call
 }
 
 // GetBy is a helper method to define mock.On call
 //   - ctx
 //   - expr
-func (_e *MockProfileRepo_Expecter) GetBy(ctx interface{}, expr interface{}) *MockProfileRepo_GetBy_Call {
-	return &MockProfileRepo_GetBy_Call{Call: _e.mock.On("GetBy", ctx, expr)}
+func (_e *MockAPIKeyRepo_Expecter) GetBy(ctx interface{}, expr interface{}) *MockAPIKeyRepo_GetBy_Call {
+	return &MockAPIKeyRepo_GetBy_Call{Call: _e.mock.On("GetBy", ctx, expr)}
 }
 
-func (_c *MockProfileRepo_GetBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockProfileRepo_GetBy_Call {
+func (_c *MockAPIKeyRepo_GetBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockAPIKeyRepo_GetBy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(repo.Expression))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_GetBy_Call) Return(profile *model.Profile, err error) *MockProfileRepo_GetBy_Call {
-	_c.Call.Return(profile, err)
+func (_c *MockAPIKeyRepo_GetBy_Call) Return(aPIKey *model.APIKey, err error) *MockAPIKeyRepo_GetBy_Call {
+	_c.Call.Return(aPIKey, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_GetBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (*model.Profile, error)) *MockProfileRepo_GetBy_Call {
+func (_c *MockAPIKeyRepo_GetBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (*model.APIKey, error)) *MockAPIKeyRepo_GetBy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByEmail provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) GetByEmail(ctx context.Context, email string) (*model.Profile, error) {
-	ret := _mock.Called(ctx, email)
+// GetByPrefix provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) GetByPrefix(ctx context.Context, prefix string) (*model.APIKey, error) {
+	ret := _mock.Called(ctx, prefix)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByEmail")
+		panic("no return value specified for GetByPrefix")
 	}
 
-	var r0 *model.Profile
+	var r0 *model.APIKey
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.Profile, error)); ok {
-		return returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.APIKey, error)); ok {
+		return returnFunc(ctx, prefix)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.Profile); ok {
-		r0 = returnFunc(ctx, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.APIKey); ok {
+		r0 = returnFunc(ctx, prefix)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Profile)
+			r0 = ret.Get(0).(*model.APIKey)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, email)
+		r1 = returnFunc(ctx, prefix)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockProfileRepo_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
-type MockProfileRepo_GetByEmail_Call struct {
+// MockAPIKeyRepo_GetByPrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPrefix'
+type MockAPIKeyRepo_GetByPrefix_Call struct {
 	*mock.Call
 }
 
-// GetByEmail is a helper method to define mock.On call
+// GetByPrefix is a helper method to define mock.On call
 //   - ctx
-//   - email
-func (_e *MockProfileRepo_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockProfileRepo_GetByEmail_Call {
-	return &MockProfileRepo_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+//   - prefix
+func (_e *MockAPIKeyRepo_Expecter) GetByPrefix(ctx interface{}, prefix interface{}) *MockAPIKeyRepo_GetByPrefix_Call {
+	return &MockAPIKeyRepo_GetByPrefix_Call{Call: _e.mock.On("GetByPrefix", ctx, prefix)}
 }
 
-func (_c *MockProfileRepo_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockProfileRepo_GetByEmail_Call {
+func (_c *MockAPIKeyRepo_GetByPrefix_Call) Run(run func(ctx context.Context, prefix string)) *MockAPIKeyRepo_GetByPrefix_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_GetByEmail_Call) Return(profile *model.Profile, err error) *MockProfileRepo_GetByEmail_Call {
-	_c.Call.Return(profile, err)
+func (_c *MockAPIKeyRepo_GetByPrefix_Call) Return(aPIKey *model.APIKey, err error) *MockAPIKeyRepo_GetByPrefix_Call {
+	_c.Call.Return(aPIKey, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*model.Profile, error)) *MockProfileRepo_GetByEmail_Call {
+func (_c *MockAPIKeyRepo_GetByPrefix_Call) RunAndReturn(run func(ctx context.Context, prefix string) (*model.APIKey, error)) *MockAPIKeyRepo_GetByPrefix_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetForUpdate provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) GetForUpdate(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.Profile, error) {
+// GetForUpdate provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) GetForUpdate(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.APIKey, error) {
 	var tmpRet mock.Arguments
 	if len(order) > 0 {
 		tmpRet = _mock.Called(ctx, expr, order)
@@ -622,16 +624,16 @@ func (_mock *MockProfileRepo) GetForUpdate(ctx context.Context, expr repo.Expres
 		panic("no return value specified for GetForUpdate")
 	}
 
-	var r0 *model.Profile
+	var r0 *model.APIKey
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.Profile, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.APIKey, error)); ok {
 		return returnFunc(ctx, expr, order...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.Profile); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.APIKey); ok {
 		r0 = returnFunc(ctx, expr, order...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Profile)
+			r0 = ret.Get(0).(*model.APIKey)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
@@ -642,8 +644,8 @@ func (_mock *MockProfileRepo) GetForUpdate(ctx context.Context, expr repo.Expres
 	return r0, r1
 }
 
-// MockProfileRepo_GetForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUpdate'
-type MockProfileRepo_GetForUpdate_Call struct {
+// MockAPIKeyRepo_GetForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUpdate'
+type MockAPIKeyRepo_GetForUpdate_Call struct {
 	*mock.Call
 }
 
@@ -651,12 +653,12 @@ type MockProfileRepo_GetForUpdate_Call struct {
 //   - ctx
 //   - expr
 //   - order
-func (_e *MockProfileRepo_Expecter) GetForUpdate(ctx interface{}, expr interface{}, order ...interface{}) *MockProfileRepo_GetForUpdate_Call {
-	return &MockProfileRepo_GetForUpdate_Call{Call: _e.mock.On("GetForUpdate",
+func (_e *MockAPIKeyRepo_Expecter) GetForUpdate(ctx interface{}, expr interface{}, order ...interface{}) *MockAPIKeyRepo_GetForUpdate_Call {
+	return &MockAPIKeyRepo_GetForUpdate_Call{Call: _e.mock.On("GetForUpdate",
 		append([]interface{}{ctx, expr}, order...)...)}
 }
 
-func (_c *MockProfileRepo_GetForUpdate_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockProfileRepo_GetForUpdate_Call {
+func (_c *MockAPIKeyRepo_GetForUpdate_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockAPIKeyRepo_GetForUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := args[2].([]repo.OrderedExpression)
 		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
@@ -664,18 +666,18 @@ func (_c *MockProfileRepo_GetForUpdate_Call) Run(run func(ctx context.Context, e
 	return _c
 }
 
-func (_c *MockProfileRepo_GetForUpdate_Call) Return(profile *model.Profile, err error) *MockProfileRepo_GetForUpdate_Call {
-	_c.Call.Return(profile, err)
+func (_c *MockAPIKeyRepo_GetForUpdate_Call) Return(aPIKey *model.APIKey, err error) *MockAPIKeyRepo_GetForUpdate_Call {
+	_c.Call.Return(aPIKey, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_GetForUpdate_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.Profile, error)) *MockProfileRepo_GetForUpdate_Call {
+func (_c *MockAPIKeyRepo_GetForUpdate_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.APIKey, error)) *MockAPIKeyRepo_GetForUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Insert provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) Insert(ctx context.Context, req *model.Profile) error {
+// Insert provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) Insert(ctx context.Context, req *model.APIKey) error {
 	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
@@ -683,7 +685,7 @@ func (_mock *MockProfileRepo) Insert(ctx context.Context, req *model.Profile) er
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Profile) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.APIKey) error); ok {
 		r0 = returnFunc(ctx, req)
 	} else {
 		r0 = ret.Error(0)
@@ -691,37 +693,37 @@ func (_mock *MockProfileRepo) Insert(ctx context.Context, req *model.Profile) er
 	return r0
 }
 
-// MockProfileRepo_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
-type MockProfileRepo_Insert_Call struct {
+// MockAPIKeyRepo_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockAPIKeyRepo_Insert_Call struct {
 	*mock.Call
 }
 
 // Insert is a helper method to define mock.On call
 //   - ctx
 //   - req
-func (_e *MockProfileRepo_Expecter) Insert(ctx interface{}, req interface{}) *MockProfileRepo_Insert_Call {
-	return &MockProfileRepo_Insert_Call{Call: _e.mock.On("Insert", ctx, req)}
+func (_e *MockAPIKeyRepo_Expecter) Insert(ctx interface{}, req interface{}) *MockAPIKeyRepo_Insert_Call {
+	return &MockAPIKeyRepo_Insert_Call{Call: _e.mock.On("Insert", ctx, req)}
 }
 
-func (_c *MockProfileRepo_Insert_Call) Run(run func(ctx context.Context, req *model.Profile)) *MockProfileRepo_Insert_Call {
+func (_c *MockAPIKeyRepo_Insert_Call) Run(run func(ctx context.Context, req *model.APIKey)) *MockAPIKeyRepo_Insert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Profile))
+		run(args[0].(context.Context), args[1].(*model.APIKey))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_Insert_Call) Return(err error) *MockProfileRepo_Insert_Call {
+func (_c *MockAPIKeyRepo_Insert_Call) Return(err error) *MockAPIKeyRepo_Insert_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProfileRepo_Insert_Call) RunAndReturn(run func(ctx context.Context, req *model.Profile) error) *MockProfileRepo_Insert_Call {
+func (_c *MockAPIKeyRepo_Insert_Call) RunAndReturn(run func(ctx context.Context, req *model.APIKey) error) *MockAPIKeyRepo_Insert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// List provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) List(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.Profile], error) {
+// List provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) List(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.APIKey], error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, opts)
@@ -734,16 +736,16 @@ func (_mock *MockProfileRepo) List(ctx context.Context, opts ...clause.FilterOpt
 		panic("no return value specified for List")
 	}
 
-	var r0 *response.ListResponse[*model.Profile]
+	var r0 *response.ListResponse[*model.APIKey]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) (*response.ListResponse[*model.Profile], error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) (*response.ListResponse[*model.APIKey], error)); ok {
 		return returnFunc(ctx, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) *response.ListResponse[*model.Profile]); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) *response.ListResponse[*model.APIKey]); ok {
 		r0 = returnFunc(ctx, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*response.ListResponse[*model.Profile])
+			r0 = ret.Get(0).(*response.ListResponse[*model.APIKey])
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, ...clause.FilterOption) error); ok {
@@ -754,20 +756,20 @@ func (_mock *MockProfileRepo) List(ctx context.Context, opts ...clause.FilterOpt
 	return r0, r1
 }
 
-// MockProfileRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
-type MockProfileRepo_List_Call struct {
+// MockAPIKeyRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAPIKeyRepo_List_Call struct {
 	*mock.Call
 }
 
 // List is a helper method to define mock.On call
 //   - ctx
 //   - opts
-func (_e *MockProfileRepo_Expecter) List(ctx interface{}, opts ...interface{}) *MockProfileRepo_List_Call {
-	return &MockProfileRepo_List_Call{Call: _e.mock.On("List",
+func (_e *MockAPIKeyRepo_Expecter) List(ctx interface{}, opts ...interface{}) *MockAPIKeyRepo_List_Call {
+	return &MockAPIKeyRepo_List_Call{Call: _e.mock.On("List",
 		append([]interface{}{ctx}, opts...)...)}
 }
 
-func (_c *MockProfileRepo_List_Call) Run(run func(ctx context.Context, opts ...clause.FilterOption)) *MockProfileRepo_List_Call {
+func (_c *MockAPIKeyRepo_List_Call) Run(run func(ctx context.Context, opts ...clause.FilterOption)) *MockAPIKeyRepo_List_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := args[1].([]clause.FilterOption)
 		run(args[0].(context.Context), variadicArgs...)
@@ -775,18 +777,18 @@ func (_c *MockProfileRepo_List_Call) Run(run func(ctx context.Context, opts ...c
 	return _c
 }
 
-func (_c *MockProfileRepo_List_Call) Return(listResponse *response.ListResponse[*model.Profile], err error) *MockProfileRepo_List_Call {
+func (_c *MockAPIKeyRepo_List_Call) Return(listResponse *response.ListResponse[*model.APIKey], err error) *MockAPIKeyRepo_List_Call {
 	_c.Call.Return(listResponse, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_List_Call) RunAndReturn(run func(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.Profile], error)) *MockProfileRepo_List_Call {
+func (_c *MockAPIKeyRepo_List_Call) RunAndReturn(run func(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.APIKey], error)) *MockAPIKeyRepo_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListBy provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) ListBy(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.Profile], error) {
+// ListBy provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) ListBy(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.APIKey], error) {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, expr, opts)
@@ -799,16 +801,16 @@ func (_mock *MockProfileRepo) ListBy(ctx context.Context, expr repo.Expression,
 		panic("no return value specified for ListBy")
 	}
 
-	var r0 *response.ListResponse[*model.Profile]
+	var r0 *response.ListResponse[*model.APIKey]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) (*response.ListResponse[*model.Profile], error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) (*response.ListResponse[*model.APIKey], error)); ok {
 		return returnFunc(ctx, expr, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) *response.ListResponse[*model.Profile]); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) *response.ListResponse[*model.APIKey]); ok {
 		r0 = returnFunc(ctx, expr, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*response.ListResponse[*model.Profile])
+			r0 = ret.Get(0).(*response.ListResponse[*model.APIKey])
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...clause.FilterOption) error); ok {
@@ -819,8 +821,8 @@ func (_mock *MockProfileRepo) ListBy(ctx context.Context, expr repo.Expression,
 	return r0, r1
 }
 
-// MockProfileRepo_ListBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBy'
-type MockProfileRepo_ListBy_Call struct {
+// MockAPIKeyRepo_ListBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBy'
+type MockAPIKeyRepo_ListBy_Call struct {
 	*mock.Call
 }
 
@@ -828,12 +830,12 @@ type MockProfileRepo_ListBy_Call struct {
 //   - ctx
 //   - expr
 //   - opts
-func (_e *MockProfileRepo_Expecter) ListBy(ctx interface{}, expr interface{}, opts ...interface{}) *MockProfileRepo_ListBy_Call {
-	return &MockProfileRepo_ListBy_Call{Call: _e.mock.On("ListBy",
+func (_e *MockAPIKeyRepo_Expecter) ListBy(ctx interface{}, expr interface{}, opts ...interface{}) *MockAPIKeyRepo_ListBy_Call {
+	return &MockAPIKeyRepo_ListBy_Call{Call: _e.mock.On("ListBy",
 		append([]interface{}{ctx, expr}, opts...)...)}
 }
 
-func (_c *MockProfileRepo_ListBy_Call) Run(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption)) *MockProfileRepo_ListBy_Call {
+func (_c *MockAPIKeyRepo_ListBy_Call) Run(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption)) *MockAPIKeyRepo_ListBy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := args[2].([]clause.FilterOption)
 		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
@@ -841,18 +843,18 @@ func (_c *MockProfileRepo_ListBy_Call) Run(run func(ctx context.Context, expr re
 	return _c
 }
 
-func (_c *MockProfileRepo_ListBy_Call) Return(listResponse *response.ListResponse[*model.Profile], err error) *MockProfileRepo_ListBy_Call {
+func (_c *MockAPIKeyRepo_ListBy_Call) Return(listResponse *response.ListResponse[*model.APIKey], err error) *MockAPIKeyRepo_ListBy_Call {
 	_c.Call.Return(listResponse, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_ListBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.Profile], error)) *MockProfileRepo_ListBy_Call {
+func (_c *MockAPIKeyRepo_ListBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.APIKey], error)) *MockAPIKeyRepo_ListBy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListByEach provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) ListByEach(ctx context.Context, expr repo.Expression, fn func(item *model.Profile) error, opts ...clause.FilterOption) error {
+// ListByEach provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) ListByEach(ctx context.Context, expr repo.Expression, fn func(item *model.APIKey) error, opts ...clause.FilterOption) error {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, expr, fn, opts)
@@ -866,7 +868,7 @@ func (_mock *MockProfileRepo) ListByEach(ctx context.Context, expr repo.Expressi
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, func(item *model.Profile) error, ...clause.FilterOption) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, func(item *model.APIKey) error, ...clause.FilterOption) error); ok {
 		r0 = returnFunc(ctx, expr, fn, opts...)
 	} else {
 		r0 = ret.Error(0)
@@ -874,8 +876,8 @@ func (_mock *MockProfileRepo) ListByEach(ctx context.Context, expr repo.Expressi
 	return r0
 }
 
-// MockProfileRepo_ListByEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEach'
-type MockProfileRepo_ListByEach_Call struct {
+// MockAPIKeyRepo_ListByEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEach'
+type MockAPIKeyRepo_ListByEach_Call struct {
 	*mock.Call
 }
 
@@ -884,47 +886,47 @@ type MockProfileRepo_ListByEach_Call struct {
 //   - expr
 //   - fn
 //   - opts
-func (_e *MockProfileRepo_Expecter) ListByEach(ctx interface{}, expr interface{}, fn interface{}, opts ...interface{}) *MockProfileRepo_ListByEach_Call {
-	return &MockProfileRepo_ListByEach_Call{Call: _e.mock.On("ListByEach",
+func (_e *MockAPIKeyRepo_Expecter) ListByEach(ctx interface{}, expr interface{}, fn interface{}, opts ...interface{}) *MockAPIKeyRepo_ListByEach_Call {
+	return &MockAPIKeyRepo_ListByEach_Call{Call: _e.mock.On("ListByEach",
 		append([]interface{}{ctx, expr, fn}, opts...)...)}
 }
 
-func (_c *MockProfileRepo_ListByEach_Call) Run(run func(ctx context.Context, expr repo.Expression, fn func(item *model.Profile) error, opts ...clause.FilterOption)) *MockProfileRepo_ListByEach_Call {
+func (_c *MockAPIKeyRepo_ListByEach_Call) Run(run func(ctx context.Context, expr repo.Expression, fn func(item *model.APIKey) error, opts ...clause.FilterOption)) *MockAPIKeyRepo_ListByEach_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := args[3].([]clause.FilterOption)
-		run(args[0].(context.Context), args[1].(repo.Expression), args[2].(func(item *model.Profile) error), variadicArgs...)
+		run(args[0].(context.Context), args[1].(repo.Expression), args[2].(func(item *model.APIKey) error), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_ListByEach_Call) Return(err error) *MockProfileRepo_ListByEach_Call {
+func (_c *MockAPIKeyRepo_ListByEach_Call) Return(err error) *MockAPIKeyRepo_ListByEach_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProfileRepo_ListByEach_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, fn func(item *model.Profile) error, opts ...clause.FilterOption) error) *MockProfileRepo_ListByEach_Call {
+func (_c *MockAPIKeyRepo_ListByEach_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, fn func(item *model.APIKey) error, opts ...clause.FilterOption) error) *MockAPIKeyRepo_ListByEach_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListByIDs provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) ListByIDs(ctx context.Context, ids []int64) (*response.ListResponse[*model.Profile], error) {
+// ListByIDs provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) ListByIDs(ctx context.Context, ids []int64) (*response.ListResponse[*model.APIKey], error) {
 	ret := _mock.Called(ctx, ids)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListByIDs")
 	}
 
-	var r0 *response.ListResponse[*model.Profile]
+	var r0 *response.ListResponse[*model.APIKey]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) (*response.ListResponse[*model.Profile], error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) (*response.ListResponse[*model.APIKey], error)); ok {
 		return returnFunc(ctx, ids)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) *response.ListResponse[*model.Profile]); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) *response.ListResponse[*model.APIKey]); ok {
 		r0 = returnFunc(ctx, ids)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*response.ListResponse[*model.Profile])
+			r0 = ret.Get(0).(*response.ListResponse[*model.APIKey])
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
@@ -935,37 +937,37 @@ func (_mock *MockProfileRepo) ListByIDs(ctx context.Context, ids []int64) (*resp
 	return r0, r1
 }
 
-// MockProfileRepo_ListByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIDs'
-type MockProfileRepo_ListByIDs_Call struct {
+// MockAPIKeyRepo_ListByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIDs'
+type MockAPIKeyRepo_ListByIDs_Call struct {
 	*mock.Call
 }
 
 // ListByIDs is a helper method to define mock.On call
 //   - ctx
 //   - ids
-func (_e *MockProfileRepo_Expecter) ListByIDs(ctx interface{}, ids interface{}) *MockProfileRepo_ListByIDs_Call {
-	return &MockProfileRepo_ListByIDs_Call{Call: _e.mock.On("ListByIDs", ctx, ids)}
+func (_e *MockAPIKeyRepo_Expecter) ListByIDs(ctx interface{}, ids interface{}) *MockAPIKeyRepo_ListByIDs_Call {
+	return &MockAPIKeyRepo_ListByIDs_Call{Call: _e.mock.On("ListByIDs", ctx, ids)}
 }
 
-func (_c *MockProfileRepo_ListByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockProfileRepo_ListByIDs_Call {
+func (_c *MockAPIKeyRepo_ListByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockAPIKeyRepo_ListByIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].([]int64))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_ListByIDs_Call) Return(listResponse *response.ListResponse[*model.Profile], err error) *MockProfileRepo_ListByIDs_Call {
+func (_c *MockAPIKeyRepo_ListByIDs_Call) Return(listResponse *response.ListResponse[*model.APIKey], err error) *MockAPIKeyRepo_ListByIDs_Call {
 	_c.Call.Return(listResponse, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_ListByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []int64) (*response.ListResponse[*model.Profile], error)) *MockProfileRepo_ListByIDs_Call {
+func (_c *MockAPIKeyRepo_ListByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []int64) (*response.ListResponse[*model.APIKey], error)) *MockAPIKeyRepo_ListByIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListEach provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) ListEach(ctx context.Context, fn func(item *model.Profile) error, opts ...clause.FilterOption) error {
+// ListEach provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) ListEach(ctx context.Context, fn func(item *model.APIKey) error, opts ...clause.FilterOption) error {
 	var tmpRet mock.Arguments
 	if len(opts) > 0 {
 		tmpRet = _mock.Called(ctx, fn, opts)
@@ -979,7 +981,7 @@ func (_mock *MockProfileRepo) ListEach(ctx context.Context, fn func(item *model.
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, func(item *model.Profile) error, ...clause.FilterOption) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(item *model.APIKey) error, ...clause.FilterOption) error); ok {
 		r0 = returnFunc(ctx, fn, opts...)
 	} else {
 		r0 = ret.Error(0)
@@ -987,8 +989,8 @@ func (_mock *MockProfileRepo) ListEach(ctx context.Context, fn func(item *model.
 	return r0
 }
 
-// MockProfileRepo_ListEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEach'
-type MockProfileRepo_ListEach_Call struct {
+// MockAPIKeyRepo_ListEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEach'
+type MockAPIKeyRepo_ListEach_Call struct {
 	*mock.Call
 }
 
@@ -996,31 +998,31 @@ type MockProfileRepo_ListEach_Call struct {
 //   - ctx
 //   - fn
 //   - opts
-func (_e *MockProfileRepo_Expecter) ListEach(ctx interface{}, fn interface{}, opts ...interface{}) *MockProfileRepo_ListEach_Call {
-	return &MockProfileRepo_ListEach_Call{Call: _e.mock.On("ListEach",
+func (_e *MockAPIKeyRepo_Expecter) ListEach(ctx interface{}, fn interface{}, opts ...interface{}) *MockAPIKeyRepo_ListEach_Call {
+	return &MockAPIKeyRepo_ListEach_Call{Call: _e.mock.On("ListEach",
 		append([]interface{}{ctx, fn}, opts...)...)}
 }
 
-func (_c *MockProfileRepo_ListEach_Call) Run(run func(ctx context.Context, fn func(item *model.Profile) error, opts ...clause.FilterOption)) *MockProfileRepo_ListEach_Call {
+func (_c *MockAPIKeyRepo_ListEach_Call) Run(run func(ctx context.Context, fn func(item *model.APIKey) error, opts ...clause.FilterOption)) *MockAPIKeyRepo_ListEach_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := args[2].([]clause.FilterOption)
-		run(args[0].(context.Context), args[1].(func(item *model.Profile) error), variadicArgs...)
+		run(args[0].(context.Context), args[1].(func(item *model.APIKey) error), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_ListEach_Call) Return(err error) *MockProfileRepo_ListEach_Call {
+func (_c *MockAPIKeyRepo_ListEach_Call) Return(err error) *MockAPIKeyRepo_ListEach_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProfileRepo_ListEach_Call) RunAndReturn(run func(ctx context.Context, fn func(item *model.Profile) error, opts ...clause.FilterOption) error) *MockProfileRepo_ListEach_Call {
+func (_c *MockAPIKeyRepo_ListEach_Call) RunAndReturn(run func(ctx context.Context, fn func(item *model.APIKey) error, opts ...clause.FilterOption) error) *MockAPIKeyRepo_ListEach_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) Update(ctx context.Context, req *model.Profile) error {
+// Update provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) Update(ctx context.Context, req *model.APIKey) error {
 	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
@@ -1028,7 +1030,7 @@ func (_mock *MockProfileRepo) Update(ctx context.Context, req *model.Profile) er
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Profile) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.APIKey) error); ok {
 		r0 = returnFunc(ctx, req)
 	} else {
 		r0 = ret.Error(0)
@@ -1036,37 +1038,37 @@ func (_mock *MockProfileRepo) Update(ctx context.Context, req *model.Profile) er
 	return r0
 }
 
-// MockProfileRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockProfileRepo_Update_Call struct {
+// MockAPIKeyRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockAPIKeyRepo_Update_Call struct {
 	*mock.Call
 }
 
 // Update is a helper method to define mock.On call
 //   - ctx
 //   - req
-func (_e *MockProfileRepo_Expecter) Update(ctx interface{}, req interface{}) *MockProfileRepo_Update_Call {
-	return &MockProfileRepo_Update_Call{Call: _e.mock.On("Update", ctx, req)}
+func (_e *MockAPIKeyRepo_Expecter) Update(ctx interface{}, req interface{}) *MockAPIKeyRepo_Update_Call {
+	return &MockAPIKeyRepo_Update_Call{Call: _e.mock.On("Update", ctx, req)}
 }
 
-func (_c *MockProfileRepo_Update_Call) Run(run func(ctx context.Context, req *model.Profile)) *MockProfileRepo_Update_Call {
+func (_c *MockAPIKeyRepo_Update_Call) Run(run func(ctx context.Context, req *model.APIKey)) *MockAPIKeyRepo_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Profile))
+		run(args[0].(context.Context), args[1].(*model.APIKey))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_Update_Call) Return(err error) *MockProfileRepo_Update_Call {
+func (_c *MockAPIKeyRepo_Update_Call) Return(err error) *MockAPIKeyRepo_Update_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProfileRepo_Update_Call) RunAndReturn(run func(ctx context.Context, req *model.Profile) error) *MockProfileRepo_Update_Call {
+func (_c *MockAPIKeyRepo_Update_Call) RunAndReturn(run func(ctx context.Context, req *model.APIKey) error) *MockAPIKeyRepo_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateMap provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) UpdateMap(ctx context.Context, id int64, req map[string]any) error {
+// UpdateMap provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) UpdateMap(ctx context.Context, id int64, req map[string]any) error {
 	ret := _mock.Called(ctx, id, req)
 
 	if len(ret) == 0 {
@@ -1082,8 +1084,8 @@ func (_mock *MockProfileRepo) UpdateMap(ctx context.Context, id int64, req map[s
 	return r0
 }
 
-// MockProfileRepo_UpdateMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMap'
-type MockProfileRepo_UpdateMap_Call struct {
+// MockAPIKeyRepo_UpdateMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMap'
+type MockAPIKeyRepo_UpdateMap_Call struct {
 	*mock.Call
 }
 
@@ -1091,29 +1093,29 @@ type MockProfileRepo_UpdateMap_Call struct {
 //   - ctx
 //   - id
 //   - req
-func (_e *MockProfileRepo_Expecter) UpdateMap(ctx interface{}, id interface{}, req interface{}) *MockProfileRepo_UpdateMap_Call {
-	return &MockProfileRepo_UpdateMap_Call{Call: _e.mock.On("UpdateMap", ctx, id, req)}
+func (_e *MockAPIKeyRepo_Expecter) UpdateMap(ctx interface{}, id interface{}, req interface{}) *MockAPIKeyRepo_UpdateMap_Call {
+	return &MockAPIKeyRepo_UpdateMap_Call{Call: _e.mock.On("UpdateMap", ctx, id, req)}
 }
 
-func (_c *MockProfileRepo_UpdateMap_Call) Run(run func(ctx context.Context, id int64, req map[string]any)) *MockProfileRepo_UpdateMap_Call {
+func (_c *MockAPIKeyRepo_UpdateMap_Call) Run(run func(ctx context.Context, id int64, req map[string]any)) *MockAPIKeyRepo_UpdateMap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(int64), args[2].(map[string]any))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_UpdateMap_Call) Return(err error) *MockProfileRepo_UpdateMap_Call {
+func (_c *MockAPIKeyRepo_UpdateMap_Call) Return(err error) *MockAPIKeyRepo_UpdateMap_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProfileRepo_UpdateMap_Call) RunAndReturn(run func(ctx context.Context, id int64, req map[string]any) error) *MockProfileRepo_UpdateMap_Call {
+func (_c *MockAPIKeyRepo_UpdateMap_Call) RunAndReturn(run func(ctx context.Context, id int64, req map[string]any) error) *MockAPIKeyRepo_UpdateMap_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateMapBy provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) UpdateMapBy(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error) {
+// UpdateMapBy provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) UpdateMapBy(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error) {
 	ret := _mock.Called(ctx, req, expr)
 
 	if len(ret) == 0 {
@@ -1138,8 +1140,8 @@ func (_mock *MockProfileRepo) UpdateMapBy(ctx context.Context, req map[string]an
 	return r0, r1
 }
 
-// MockProfileRepo_UpdateMapBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMapBy'
-type MockProfileRepo_UpdateMapBy_Call struct {
+// MockAPIKeyRepo_UpdateMapBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMapBy'
+type MockAPIKeyRepo_UpdateMapBy_Call struct {
 	*mock.Call
 }
 
@@ -1147,29 +1149,29 @@ type MockProfileRepo_UpdateMapBy_Call struct {
 //   - ctx
 //   - req
 //   - expr
-func (_e *MockProfileRepo_Expecter) UpdateMapBy(ctx interface{}, req interface{}, expr interface{}) *MockProfileRepo_UpdateMapBy_Call {
-	return &MockProfileRepo_UpdateMapBy_Call{Call: _e.mock.On("UpdateMapBy", ctx, req, expr)}
+func (_e *MockAPIKeyRepo_Expecter) UpdateMapBy(ctx interface{}, req interface{}, expr interface{}) *MockAPIKeyRepo_UpdateMapBy_Call {
+	return &MockAPIKeyRepo_UpdateMapBy_Call{Call: _e.mock.On("UpdateMapBy", ctx, req, expr)}
 }
 
-func (_c *MockProfileRepo_UpdateMapBy_Call) Run(run func(ctx context.Context, req map[string]any, expr repo.Expression)) *MockProfileRepo_UpdateMapBy_Call {
+func (_c *MockAPIKeyRepo_UpdateMapBy_Call) Run(run func(ctx context.Context, req map[string]any, expr repo.Expression)) *MockAPIKeyRepo_UpdateMapBy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(map[string]any), args[2].(repo.Expression))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_UpdateMapBy_Call) Return(n int64, err error) *MockProfileRepo_UpdateMapBy_Call {
+func (_c *MockAPIKeyRepo_UpdateMapBy_Call) Return(n int64, err error) *MockAPIKeyRepo_UpdateMapBy_Call {
 	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_UpdateMapBy_Call) RunAndReturn(run func(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error)) *MockProfileRepo_UpdateMapBy_Call {
+func (_c *MockAPIKeyRepo_UpdateMapBy_Call) RunAndReturn(run func(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error)) *MockAPIKeyRepo_UpdateMapBy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Upsert provides a mock function for the type MockProfileRepo
-func (_mock *MockProfileRepo) Upsert(ctx context.Context, req *model.Profile, target string) (bool, error) {
+// Upsert provides a mock function for the type MockAPIKeyRepo
+func (_mock *MockAPIKeyRepo) Upsert(ctx context.Context, req *model.APIKey, target string) (bool, error) {
 	ret := _mock.Called(ctx, req, target)
 
 	if len(ret) == 0 {
@@ -1178,15 +1180,15 @@ func (_mock *MockProfileRepo) Upsert(ctx context.Context, req *model.Profile, ta
 
 	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Profile, string) (bool, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.APIKey, string) (bool, error)); ok {
 		return returnFunc(ctx, req, target)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Profile, string) bool); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.APIKey, string) bool); ok {
 		r0 = returnFunc(ctx, req, target)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Profile, string) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.APIKey, string) error); ok {
 		r1 = returnFunc(ctx, req, target)
 	} else {
 		r1 = ret.Error(1)
@@ -1194,8 +1196,8 @@ func (_mock *MockProfileRepo) Upsert(ctx context.Context, req *model.Profile, ta
 	return r0, r1
 }
 
-// MockProfileRepo_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
-type MockProfileRepo_Upsert_Call struct {
+// MockAPIKeyRepo_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockAPIKeyRepo_Upsert_Call struct {
 	*mock.Call
 }
 
@@ -1203,23 +1205,8496 @@ type MockProfileRepo_Upsert_Call struct {
 //   - ctx
 //   - req
 //   - target
-func (_e *MockProfileRepo_Expecter) Upsert(ctx interface{}, req interface{}, target interface{}) *MockProfileRepo_Upsert_Call {
-	return &MockProfileRepo_Upsert_Call{Call: _e.mock.On("Upsert", ctx, req, target)}
+func (_e *MockAPIKeyRepo_Expecter) Upsert(ctx interface{}, req interface{}, target interface{}) *MockAPIKeyRepo_Upsert_Call {
+	return &MockAPIKeyRepo_Upsert_Call{Call: _e.mock.On("Upsert", ctx, req, target)}
 }
 
-func (_c *MockProfileRepo_Upsert_Call) Run(run func(ctx context.Context, req *model.Profile, target string)) *MockProfileRepo_Upsert_Call {
+func (_c *MockAPIKeyRepo_Upsert_Call) Run(run func(ctx context.Context, req *model.APIKey, target string)) *MockAPIKeyRepo_Upsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.Profile), args[2].(string))
+		run(args[0].(context.Context), args[1].(*model.APIKey), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *MockProfileRepo_Upsert_Call) Return(b bool, err error) *MockProfileRepo_Upsert_Call {
+func (_c *MockAPIKeyRepo_Upsert_Call) Return(b bool, err error) *MockAPIKeyRepo_Upsert_Call {
 	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockProfileRepo_Upsert_Call) RunAndReturn(run func(ctx context.Context, req *model.Profile, target string) (bool, error)) *MockProfileRepo_Upsert_Call {
+func (_c *MockAPIKeyRepo_Upsert_Call) RunAndReturn(run func(ctx context.Context, req *model.APIKey, target string) (bool, error)) *MockAPIKeyRepo_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuditEventRepo creates a new instance of MockAuditEventRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuditEventRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuditEventRepo {
+	mock := &MockAuditEventRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuditEventRepo is an autogenerated mock type for the AuditEventRepo type
+type MockAuditEventRepo struct {
+	mock.Mock
+}
+
+type MockAuditEventRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditEventRepo) EXPECT() *MockAuditEventRepo_Expecter {
+	return &MockAuditEventRepo_Expecter{mock: &_m.Mock}
+}
+
+// CountBy provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) CountBy(ctx context.Context, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_CountBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBy'
+type MockAuditEventRepo_CountBy_Call struct {
+	*mock.Call
+}
+
+// CountBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockAuditEventRepo_Expecter) CountBy(ctx interface{}, expr interface{}) *MockAuditEventRepo_CountBy_Call {
+	return &MockAuditEventRepo_CountBy_Call{Call: _e.mock.On("CountBy", ctx, expr)}
+}
+
+func (_c *MockAuditEventRepo_CountBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockAuditEventRepo_CountBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_CountBy_Call) Return(n int64, err error) *MockAuditEventRepo_CountBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_CountBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (int64, error)) *MockAuditEventRepo_CountBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuditEventRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockAuditEventRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockAuditEventRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockAuditEventRepo_Delete_Call {
+	return &MockAuditEventRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockAuditEventRepo_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockAuditEventRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Delete_Call) Return(err error) *MockAuditEventRepo_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockAuditEventRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBy provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) DeleteBy(ctx context.Context, expr repo.Ex) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Ex) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_DeleteBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBy'
+type MockAuditEventRepo_DeleteBy_Call struct {
+	*mock.Call
+}
+
+// DeleteBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockAuditEventRepo_Expecter) DeleteBy(ctx interface{}, expr interface{}) *MockAuditEventRepo_DeleteBy_Call {
+	return &MockAuditEventRepo_DeleteBy_Call{Call: _e.mock.On("DeleteBy", ctx, expr)}
+}
+
+func (_c *MockAuditEventRepo_DeleteBy_Call) Run(run func(ctx context.Context, expr repo.Ex)) *MockAuditEventRepo_DeleteBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Ex))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_DeleteBy_Call) Return(n int64, err error) *MockAuditEventRepo_DeleteBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_DeleteBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Ex) (int64, error)) *MockAuditEventRepo_DeleteBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) Exists(ctx context.Context, expr repo.Expression) (bool, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (bool, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) bool); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockAuditEventRepo_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockAuditEventRepo_Expecter) Exists(ctx interface{}, expr interface{}) *MockAuditEventRepo_Exists_Call {
+	return &MockAuditEventRepo_Exists_Call{Call: _e.mock.On("Exists", ctx, expr)}
+}
+
+func (_c *MockAuditEventRepo_Exists_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockAuditEventRepo_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Exists_Call) Return(b bool, err error) *MockAuditEventRepo_Exists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Exists_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (bool, error)) *MockAuditEventRepo_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) Find(ctx context.Context, dest *model.AuditEvent, id int64) error {
+	ret := _mock.Called(ctx, dest, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AuditEvent, int64) error); ok {
+		r0 = returnFunc(ctx, dest, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuditEventRepo_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockAuditEventRepo_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx
+//   - dest
+//   - id
+func (_e *MockAuditEventRepo_Expecter) Find(ctx interface{}, dest interface{}, id interface{}) *MockAuditEventRepo_Find_Call {
+	return &MockAuditEventRepo_Find_Call{Call: _e.mock.On("Find", ctx, dest, id)}
+}
+
+func (_c *MockAuditEventRepo_Find_Call) Run(run func(ctx context.Context, dest *model.AuditEvent, id int64)) *MockAuditEventRepo_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.AuditEvent), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Find_Call) Return(err error) *MockAuditEventRepo_Find_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Find_Call) RunAndReturn(run func(ctx context.Context, dest *model.AuditEvent, id int64) error) *MockAuditEventRepo_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// First provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) First(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.AuditEvent, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for First")
+	}
+
+	var r0 *model.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.AuditEvent, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.AuditEvent); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_First_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'First'
+type MockAuditEventRepo_First_Call struct {
+	*mock.Call
+}
+
+// First is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockAuditEventRepo_Expecter) First(ctx interface{}, expr interface{}, order ...interface{}) *MockAuditEventRepo_First_Call {
+	return &MockAuditEventRepo_First_Call{Call: _e.mock.On("First",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockAuditEventRepo_First_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockAuditEventRepo_First_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_First_Call) Return(auditEvent *model.AuditEvent, err error) *MockAuditEventRepo_First_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_First_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.AuditEvent, error)) *MockAuditEventRepo_First_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) Get(ctx context.Context, id int64) (*model.AuditEvent, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.AuditEvent, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.AuditEvent); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockAuditEventRepo_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockAuditEventRepo_Expecter) Get(ctx interface{}, id interface{}) *MockAuditEventRepo_Get_Call {
+	return &MockAuditEventRepo_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *MockAuditEventRepo_Get_Call) Run(run func(ctx context.Context, id int64)) *MockAuditEventRepo_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Get_Call) Return(auditEvent *model.AuditEvent, err error) *MockAuditEventRepo_Get_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Get_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.AuditEvent, error)) *MockAuditEventRepo_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBy provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) GetBy(ctx context.Context, expr repo.Expression) (*model.AuditEvent, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBy")
+	}
+
+	var r0 *model.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (*model.AuditEvent, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) *model.AuditEvent); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_GetBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBy'
+type MockAuditEventRepo_GetBy_Call struct {
+	*mock.Call
+}
+
+// GetBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockAuditEventRepo_Expecter) GetBy(ctx interface{}, expr interface{}) *MockAuditEventRepo_GetBy_Call {
+	return &MockAuditEventRepo_GetBy_Call{Call: _e.mock.On("GetBy", ctx, expr)}
+}
+
+func (_c *MockAuditEventRepo_GetBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockAuditEventRepo_GetBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_GetBy_Call) Return(auditEvent *model.AuditEvent, err error) *MockAuditEventRepo_GetBy_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_GetBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (*model.AuditEvent, error)) *MockAuditEventRepo_GetBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForUpdate provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) GetForUpdate(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.AuditEvent, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUpdate")
+	}
+
+	var r0 *model.AuditEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.AuditEvent, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.AuditEvent); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuditEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_GetForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUpdate'
+type MockAuditEventRepo_GetForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetForUpdate is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockAuditEventRepo_Expecter) GetForUpdate(ctx interface{}, expr interface{}, order ...interface{}) *MockAuditEventRepo_GetForUpdate_Call {
+	return &MockAuditEventRepo_GetForUpdate_Call{Call: _e.mock.On("GetForUpdate",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockAuditEventRepo_GetForUpdate_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockAuditEventRepo_GetForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_GetForUpdate_Call) Return(auditEvent *model.AuditEvent, err error) *MockAuditEventRepo_GetForUpdate_Call {
+	_c.Call.Return(auditEvent, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_GetForUpdate_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.AuditEvent, error)) *MockAuditEventRepo_GetForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) Insert(ctx context.Context, req *model.AuditEvent) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AuditEvent) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuditEventRepo_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockAuditEventRepo_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockAuditEventRepo_Expecter) Insert(ctx interface{}, req interface{}) *MockAuditEventRepo_Insert_Call {
+	return &MockAuditEventRepo_Insert_Call{Call: _e.mock.On("Insert", ctx, req)}
+}
+
+func (_c *MockAuditEventRepo_Insert_Call) Run(run func(ctx context.Context, req *model.AuditEvent)) *MockAuditEventRepo_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.AuditEvent))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Insert_Call) Return(err error) *MockAuditEventRepo_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Insert_Call) RunAndReturn(run func(ctx context.Context, req *model.AuditEvent) error) *MockAuditEventRepo_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) List(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.AuditEvent], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, opts)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *response.ListResponse[*model.AuditEvent]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) (*response.ListResponse[*model.AuditEvent], error)); ok {
+		return returnFunc(ctx, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) *response.ListResponse[*model.AuditEvent]); ok {
+		r0 = returnFunc(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.AuditEvent])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockAuditEventRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - opts
+func (_e *MockAuditEventRepo_Expecter) List(ctx interface{}, opts ...interface{}) *MockAuditEventRepo_List_Call {
+	return &MockAuditEventRepo_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx}, opts...)...)}
+}
+
+func (_c *MockAuditEventRepo_List_Call) Run(run func(ctx context.Context, opts ...clause.FilterOption)) *MockAuditEventRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[1].([]clause.FilterOption)
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_List_Call) Return(listResponse *response.ListResponse[*model.AuditEvent], err error) *MockAuditEventRepo_List_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_List_Call) RunAndReturn(run func(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.AuditEvent], error)) *MockAuditEventRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBy provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) ListBy(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.AuditEvent], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBy")
+	}
+
+	var r0 *response.ListResponse[*model.AuditEvent]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) (*response.ListResponse[*model.AuditEvent], error)); ok {
+		return returnFunc(ctx, expr, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) *response.ListResponse[*model.AuditEvent]); ok {
+		r0 = returnFunc(ctx, expr, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.AuditEvent])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, expr, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_ListBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBy'
+type MockAuditEventRepo_ListBy_Call struct {
+	*mock.Call
+}
+
+// ListBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - opts
+func (_e *MockAuditEventRepo_Expecter) ListBy(ctx interface{}, expr interface{}, opts ...interface{}) *MockAuditEventRepo_ListBy_Call {
+	return &MockAuditEventRepo_ListBy_Call{Call: _e.mock.On("ListBy",
+		append([]interface{}{ctx, expr}, opts...)...)}
+}
+
+func (_c *MockAuditEventRepo_ListBy_Call) Run(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption)) *MockAuditEventRepo_ListBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_ListBy_Call) Return(listResponse *response.ListResponse[*model.AuditEvent], err error) *MockAuditEventRepo_ListBy_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_ListBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.AuditEvent], error)) *MockAuditEventRepo_ListBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByEach provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) ListByEach(ctx context.Context, expr repo.Expression, fn func(item *model.AuditEvent) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, func(item *model.AuditEvent) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, expr, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuditEventRepo_ListByEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEach'
+type MockAuditEventRepo_ListByEach_Call struct {
+	*mock.Call
+}
+
+// ListByEach is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - fn
+//   - opts
+func (_e *MockAuditEventRepo_Expecter) ListByEach(ctx interface{}, expr interface{}, fn interface{}, opts ...interface{}) *MockAuditEventRepo_ListByEach_Call {
+	return &MockAuditEventRepo_ListByEach_Call{Call: _e.mock.On("ListByEach",
+		append([]interface{}{ctx, expr, fn}, opts...)...)}
+}
+
+func (_c *MockAuditEventRepo_ListByEach_Call) Run(run func(ctx context.Context, expr repo.Expression, fn func(item *model.AuditEvent) error, opts ...clause.FilterOption)) *MockAuditEventRepo_ListByEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[3].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), args[2].(func(item *model.AuditEvent) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_ListByEach_Call) Return(err error) *MockAuditEventRepo_ListByEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_ListByEach_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, fn func(item *model.AuditEvent) error, opts ...clause.FilterOption) error) *MockAuditEventRepo_ListByEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIDs provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) ListByIDs(ctx context.Context, ids []int64) (*response.ListResponse[*model.AuditEvent], error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIDs")
+	}
+
+	var r0 *response.ListResponse[*model.AuditEvent]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) (*response.ListResponse[*model.AuditEvent], error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) *response.ListResponse[*model.AuditEvent]); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.AuditEvent])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_ListByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIDs'
+type MockAuditEventRepo_ListByIDs_Call struct {
+	*mock.Call
+}
+
+// ListByIDs is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *MockAuditEventRepo_Expecter) ListByIDs(ctx interface{}, ids interface{}) *MockAuditEventRepo_ListByIDs_Call {
+	return &MockAuditEventRepo_ListByIDs_Call{Call: _e.mock.On("ListByIDs", ctx, ids)}
+}
+
+func (_c *MockAuditEventRepo_ListByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockAuditEventRepo_ListByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_ListByIDs_Call) Return(listResponse *response.ListResponse[*model.AuditEvent], err error) *MockAuditEventRepo_ListByIDs_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_ListByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []int64) (*response.ListResponse[*model.AuditEvent], error)) *MockAuditEventRepo_ListByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEach provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) ListEach(ctx context.Context, fn func(item *model.AuditEvent) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(item *model.AuditEvent) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuditEventRepo_ListEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEach'
+type MockAuditEventRepo_ListEach_Call struct {
+	*mock.Call
+}
+
+// ListEach is a helper method to define mock.On call
+//   - ctx
+//   - fn
+//   - opts
+func (_e *MockAuditEventRepo_Expecter) ListEach(ctx interface{}, fn interface{}, opts ...interface{}) *MockAuditEventRepo_ListEach_Call {
+	return &MockAuditEventRepo_ListEach_Call{Call: _e.mock.On("ListEach",
+		append([]interface{}{ctx, fn}, opts...)...)}
+}
+
+func (_c *MockAuditEventRepo_ListEach_Call) Run(run func(ctx context.Context, fn func(item *model.AuditEvent) error, opts ...clause.FilterOption)) *MockAuditEventRepo_ListEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(func(item *model.AuditEvent) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_ListEach_Call) Return(err error) *MockAuditEventRepo_ListEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_ListEach_Call) RunAndReturn(run func(ctx context.Context, fn func(item *model.AuditEvent) error, opts ...clause.FilterOption) error) *MockAuditEventRepo_ListEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) Update(ctx context.Context, req *model.AuditEvent) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AuditEvent) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuditEventRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockAuditEventRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockAuditEventRepo_Expecter) Update(ctx interface{}, req interface{}) *MockAuditEventRepo_Update_Call {
+	return &MockAuditEventRepo_Update_Call{Call: _e.mock.On("Update", ctx, req)}
+}
+
+func (_c *MockAuditEventRepo_Update_Call) Run(run func(ctx context.Context, req *model.AuditEvent)) *MockAuditEventRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.AuditEvent))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Update_Call) Return(err error) *MockAuditEventRepo_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Update_Call) RunAndReturn(run func(ctx context.Context, req *model.AuditEvent) error) *MockAuditEventRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMap provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) UpdateMap(ctx context.Context, id int64, req map[string]any) error {
+	ret := _mock.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMap")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, map[string]any) error); ok {
+		r0 = returnFunc(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuditEventRepo_UpdateMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMap'
+type MockAuditEventRepo_UpdateMap_Call struct {
+	*mock.Call
+}
+
+// UpdateMap is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - req
+func (_e *MockAuditEventRepo_Expecter) UpdateMap(ctx interface{}, id interface{}, req interface{}) *MockAuditEventRepo_UpdateMap_Call {
+	return &MockAuditEventRepo_UpdateMap_Call{Call: _e.mock.On("UpdateMap", ctx, id, req)}
+}
+
+func (_c *MockAuditEventRepo_UpdateMap_Call) Run(run func(ctx context.Context, id int64, req map[string]any)) *MockAuditEventRepo_UpdateMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(map[string]any))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_UpdateMap_Call) Return(err error) *MockAuditEventRepo_UpdateMap_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_UpdateMap_Call) RunAndReturn(run func(ctx context.Context, id int64, req map[string]any) error) *MockAuditEventRepo_UpdateMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMapBy provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) UpdateMapBy(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, req, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMapBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, req, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, req, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, map[string]any, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, req, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_UpdateMapBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMapBy'
+type MockAuditEventRepo_UpdateMapBy_Call struct {
+	*mock.Call
+}
+
+// UpdateMapBy is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - expr
+func (_e *MockAuditEventRepo_Expecter) UpdateMapBy(ctx interface{}, req interface{}, expr interface{}) *MockAuditEventRepo_UpdateMapBy_Call {
+	return &MockAuditEventRepo_UpdateMapBy_Call{Call: _e.mock.On("UpdateMapBy", ctx, req, expr)}
+}
+
+func (_c *MockAuditEventRepo_UpdateMapBy_Call) Run(run func(ctx context.Context, req map[string]any, expr repo.Expression)) *MockAuditEventRepo_UpdateMapBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]any), args[2].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_UpdateMapBy_Call) Return(n int64, err error) *MockAuditEventRepo_UpdateMapBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_UpdateMapBy_Call) RunAndReturn(run func(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error)) *MockAuditEventRepo_UpdateMapBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockAuditEventRepo
+func (_mock *MockAuditEventRepo) Upsert(ctx context.Context, req *model.AuditEvent, target string) (bool, error) {
+	ret := _mock.Called(ctx, req, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AuditEvent, string) (bool, error)); ok {
+		return returnFunc(ctx, req, target)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.AuditEvent, string) bool); ok {
+		r0 = returnFunc(ctx, req, target)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.AuditEvent, string) error); ok {
+		r1 = returnFunc(ctx, req, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuditEventRepo_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockAuditEventRepo_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - target
+func (_e *MockAuditEventRepo_Expecter) Upsert(ctx interface{}, req interface{}, target interface{}) *MockAuditEventRepo_Upsert_Call {
+	return &MockAuditEventRepo_Upsert_Call{Call: _e.mock.On("Upsert", ctx, req, target)}
+}
+
+func (_c *MockAuditEventRepo_Upsert_Call) Run(run func(ctx context.Context, req *model.AuditEvent, target string)) *MockAuditEventRepo_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.AuditEvent), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Upsert_Call) Return(b bool, err error) *MockAuditEventRepo_Upsert_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAuditEventRepo_Upsert_Call) RunAndReturn(run func(ctx context.Context, req *model.AuditEvent, target string) (bool, error)) *MockAuditEventRepo_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRefreshTokenRepo creates a new instance of MockRefreshTokenRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRefreshTokenRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRefreshTokenRepo {
+	mock := &MockRefreshTokenRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRefreshTokenRepo is an autogenerated mock type for the RefreshTokenRepo type
+type MockRefreshTokenRepo struct {
+	mock.Mock
+}
+
+type MockRefreshTokenRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRefreshTokenRepo) EXPECT() *MockRefreshTokenRepo_Expecter {
+	return &MockRefreshTokenRepo_Expecter{mock: &_m.Mock}
+}
+
+// CountBy provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) CountBy(ctx context.Context, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_CountBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBy'
+type MockRefreshTokenRepo_CountBy_Call struct {
+	*mock.Call
+}
+
+// CountBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockRefreshTokenRepo_Expecter) CountBy(ctx interface{}, expr interface{}) *MockRefreshTokenRepo_CountBy_Call {
+	return &MockRefreshTokenRepo_CountBy_Call{Call: _e.mock.On("CountBy", ctx, expr)}
+}
+
+func (_c *MockRefreshTokenRepo_CountBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockRefreshTokenRepo_CountBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_CountBy_Call) Return(n int64, err error) *MockRefreshTokenRepo_CountBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_CountBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (int64, error)) *MockRefreshTokenRepo_CountBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRefreshTokenRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockRefreshTokenRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockRefreshTokenRepo_Delete_Call {
+	return &MockRefreshTokenRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockRefreshTokenRepo_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockRefreshTokenRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Delete_Call) Return(err error) *MockRefreshTokenRepo_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockRefreshTokenRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBy provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) DeleteBy(ctx context.Context, expr repo.Ex) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Ex) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_DeleteBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBy'
+type MockRefreshTokenRepo_DeleteBy_Call struct {
+	*mock.Call
+}
+
+// DeleteBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockRefreshTokenRepo_Expecter) DeleteBy(ctx interface{}, expr interface{}) *MockRefreshTokenRepo_DeleteBy_Call {
+	return &MockRefreshTokenRepo_DeleteBy_Call{Call: _e.mock.On("DeleteBy", ctx, expr)}
+}
+
+func (_c *MockRefreshTokenRepo_DeleteBy_Call) Run(run func(ctx context.Context, expr repo.Ex)) *MockRefreshTokenRepo_DeleteBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Ex))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_DeleteBy_Call) Return(n int64, err error) *MockRefreshTokenRepo_DeleteBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_DeleteBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Ex) (int64, error)) *MockRefreshTokenRepo_DeleteBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) Exists(ctx context.Context, expr repo.Expression) (bool, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (bool, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) bool); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockRefreshTokenRepo_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockRefreshTokenRepo_Expecter) Exists(ctx interface{}, expr interface{}) *MockRefreshTokenRepo_Exists_Call {
+	return &MockRefreshTokenRepo_Exists_Call{Call: _e.mock.On("Exists", ctx, expr)}
+}
+
+func (_c *MockRefreshTokenRepo_Exists_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockRefreshTokenRepo_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Exists_Call) Return(b bool, err error) *MockRefreshTokenRepo_Exists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Exists_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (bool, error)) *MockRefreshTokenRepo_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) Find(ctx context.Context, dest *model.RefreshToken, id int64) error {
+	ret := _mock.Called(ctx, dest, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.RefreshToken, int64) error); ok {
+		r0 = returnFunc(ctx, dest, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepo_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockRefreshTokenRepo_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx
+//   - dest
+//   - id
+func (_e *MockRefreshTokenRepo_Expecter) Find(ctx interface{}, dest interface{}, id interface{}) *MockRefreshTokenRepo_Find_Call {
+	return &MockRefreshTokenRepo_Find_Call{Call: _e.mock.On("Find", ctx, dest, id)}
+}
+
+func (_c *MockRefreshTokenRepo_Find_Call) Run(run func(ctx context.Context, dest *model.RefreshToken, id int64)) *MockRefreshTokenRepo_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.RefreshToken), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Find_Call) Return(err error) *MockRefreshTokenRepo_Find_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Find_Call) RunAndReturn(run func(ctx context.Context, dest *model.RefreshToken, id int64) error) *MockRefreshTokenRepo_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// First provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) First(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.RefreshToken, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for First")
+	}
+
+	var r0 *model.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.RefreshToken, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.RefreshToken); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_First_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'First'
+type MockRefreshTokenRepo_First_Call struct {
+	*mock.Call
+}
+
+// First is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockRefreshTokenRepo_Expecter) First(ctx interface{}, expr interface{}, order ...interface{}) *MockRefreshTokenRepo_First_Call {
+	return &MockRefreshTokenRepo_First_Call{Call: _e.mock.On("First",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockRefreshTokenRepo_First_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockRefreshTokenRepo_First_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_First_Call) Return(refreshToken *model.RefreshToken, err error) *MockRefreshTokenRepo_First_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_First_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.RefreshToken, error)) *MockRefreshTokenRepo_First_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) Get(ctx context.Context, id int64) (*model.RefreshToken, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.RefreshToken, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.RefreshToken); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRefreshTokenRepo_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockRefreshTokenRepo_Expecter) Get(ctx interface{}, id interface{}) *MockRefreshTokenRepo_Get_Call {
+	return &MockRefreshTokenRepo_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *MockRefreshTokenRepo_Get_Call) Run(run func(ctx context.Context, id int64)) *MockRefreshTokenRepo_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Get_Call) Return(refreshToken *model.RefreshToken, err error) *MockRefreshTokenRepo_Get_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Get_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.RefreshToken, error)) *MockRefreshTokenRepo_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBy provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) GetBy(ctx context.Context, expr repo.Expression) (*model.RefreshToken, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBy")
+	}
+
+	var r0 *model.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (*model.RefreshToken, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) *model.RefreshToken); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_GetBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBy'
+type MockRefreshTokenRepo_GetBy_Call struct {
+	*mock.Call
+}
+
+// GetBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockRefreshTokenRepo_Expecter) GetBy(ctx interface{}, expr interface{}) *MockRefreshTokenRepo_GetBy_Call {
+	return &MockRefreshTokenRepo_GetBy_Call{Call: _e.mock.On("GetBy", ctx, expr)}
+}
+
+func (_c *MockRefreshTokenRepo_GetBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockRefreshTokenRepo_GetBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_GetBy_Call) Return(refreshToken *model.RefreshToken, err error) *MockRefreshTokenRepo_GetBy_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_GetBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (*model.RefreshToken, error)) *MockRefreshTokenRepo_GetBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByHashForUpdate provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) GetByHashForUpdate(ctx context.Context, hash string) (*model.RefreshToken, error) {
+	ret := _mock.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByHashForUpdate")
+	}
+
+	var r0 *model.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.RefreshToken, error)); ok {
+		return returnFunc(ctx, hash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.RefreshToken); ok {
+		r0 = returnFunc(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_GetByHashForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByHashForUpdate'
+type MockRefreshTokenRepo_GetByHashForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetByHashForUpdate is a helper method to define mock.On call
+//   - ctx
+//   - hash
+func (_e *MockRefreshTokenRepo_Expecter) GetByHashForUpdate(ctx interface{}, hash interface{}) *MockRefreshTokenRepo_GetByHashForUpdate_Call {
+	return &MockRefreshTokenRepo_GetByHashForUpdate_Call{Call: _e.mock.On("GetByHashForUpdate", ctx, hash)}
+}
+
+func (_c *MockRefreshTokenRepo_GetByHashForUpdate_Call) Run(run func(ctx context.Context, hash string)) *MockRefreshTokenRepo_GetByHashForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_GetByHashForUpdate_Call) Return(refreshToken *model.RefreshToken, err error) *MockRefreshTokenRepo_GetByHashForUpdate_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_GetByHashForUpdate_Call) RunAndReturn(run func(ctx context.Context, hash string) (*model.RefreshToken, error)) *MockRefreshTokenRepo_GetByHashForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForUpdate provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) GetForUpdate(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.RefreshToken, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUpdate")
+	}
+
+	var r0 *model.RefreshToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.RefreshToken, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.RefreshToken); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RefreshToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_GetForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUpdate'
+type MockRefreshTokenRepo_GetForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetForUpdate is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockRefreshTokenRepo_Expecter) GetForUpdate(ctx interface{}, expr interface{}, order ...interface{}) *MockRefreshTokenRepo_GetForUpdate_Call {
+	return &MockRefreshTokenRepo_GetForUpdate_Call{Call: _e.mock.On("GetForUpdate",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockRefreshTokenRepo_GetForUpdate_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockRefreshTokenRepo_GetForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_GetForUpdate_Call) Return(refreshToken *model.RefreshToken, err error) *MockRefreshTokenRepo_GetForUpdate_Call {
+	_c.Call.Return(refreshToken, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_GetForUpdate_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.RefreshToken, error)) *MockRefreshTokenRepo_GetForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) Insert(ctx context.Context, req *model.RefreshToken) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.RefreshToken) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepo_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockRefreshTokenRepo_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockRefreshTokenRepo_Expecter) Insert(ctx interface{}, req interface{}) *MockRefreshTokenRepo_Insert_Call {
+	return &MockRefreshTokenRepo_Insert_Call{Call: _e.mock.On("Insert", ctx, req)}
+}
+
+func (_c *MockRefreshTokenRepo_Insert_Call) Run(run func(ctx context.Context, req *model.RefreshToken)) *MockRefreshTokenRepo_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.RefreshToken))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Insert_Call) Return(err error) *MockRefreshTokenRepo_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Insert_Call) RunAndReturn(run func(ctx context.Context, req *model.RefreshToken) error) *MockRefreshTokenRepo_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) List(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.RefreshToken], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, opts)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *response.ListResponse[*model.RefreshToken]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) (*response.ListResponse[*model.RefreshToken], error)); ok {
+		return returnFunc(ctx, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) *response.ListResponse[*model.RefreshToken]); ok {
+		r0 = returnFunc(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.RefreshToken])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockRefreshTokenRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - opts
+func (_e *MockRefreshTokenRepo_Expecter) List(ctx interface{}, opts ...interface{}) *MockRefreshTokenRepo_List_Call {
+	return &MockRefreshTokenRepo_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx}, opts...)...)}
+}
+
+func (_c *MockRefreshTokenRepo_List_Call) Run(run func(ctx context.Context, opts ...clause.FilterOption)) *MockRefreshTokenRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[1].([]clause.FilterOption)
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_List_Call) Return(listResponse *response.ListResponse[*model.RefreshToken], err error) *MockRefreshTokenRepo_List_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_List_Call) RunAndReturn(run func(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.RefreshToken], error)) *MockRefreshTokenRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBy provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) ListBy(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.RefreshToken], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBy")
+	}
+
+	var r0 *response.ListResponse[*model.RefreshToken]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) (*response.ListResponse[*model.RefreshToken], error)); ok {
+		return returnFunc(ctx, expr, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) *response.ListResponse[*model.RefreshToken]); ok {
+		r0 = returnFunc(ctx, expr, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.RefreshToken])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, expr, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_ListBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBy'
+type MockRefreshTokenRepo_ListBy_Call struct {
+	*mock.Call
+}
+
+// ListBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - opts
+func (_e *MockRefreshTokenRepo_Expecter) ListBy(ctx interface{}, expr interface{}, opts ...interface{}) *MockRefreshTokenRepo_ListBy_Call {
+	return &MockRefreshTokenRepo_ListBy_Call{Call: _e.mock.On("ListBy",
+		append([]interface{}{ctx, expr}, opts...)...)}
+}
+
+func (_c *MockRefreshTokenRepo_ListBy_Call) Run(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption)) *MockRefreshTokenRepo_ListBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_ListBy_Call) Return(listResponse *response.ListResponse[*model.RefreshToken], err error) *MockRefreshTokenRepo_ListBy_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_ListBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.RefreshToken], error)) *MockRefreshTokenRepo_ListBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByEach provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) ListByEach(ctx context.Context, expr repo.Expression, fn func(item *model.RefreshToken) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, func(item *model.RefreshToken) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, expr, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepo_ListByEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEach'
+type MockRefreshTokenRepo_ListByEach_Call struct {
+	*mock.Call
+}
+
+// ListByEach is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - fn
+//   - opts
+func (_e *MockRefreshTokenRepo_Expecter) ListByEach(ctx interface{}, expr interface{}, fn interface{}, opts ...interface{}) *MockRefreshTokenRepo_ListByEach_Call {
+	return &MockRefreshTokenRepo_ListByEach_Call{Call: _e.mock.On("ListByEach",
+		append([]interface{}{ctx, expr, fn}, opts...)...)}
+}
+
+func (_c *MockRefreshTokenRepo_ListByEach_Call) Run(run func(ctx context.Context, expr repo.Expression, fn func(item *model.RefreshToken) error, opts ...clause.FilterOption)) *MockRefreshTokenRepo_ListByEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[3].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), args[2].(func(item *model.RefreshToken) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_ListByEach_Call) Return(err error) *MockRefreshTokenRepo_ListByEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_ListByEach_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, fn func(item *model.RefreshToken) error, opts ...clause.FilterOption) error) *MockRefreshTokenRepo_ListByEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIDs provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) ListByIDs(ctx context.Context, ids []int64) (*response.ListResponse[*model.RefreshToken], error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIDs")
+	}
+
+	var r0 *response.ListResponse[*model.RefreshToken]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) (*response.ListResponse[*model.RefreshToken], error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) *response.ListResponse[*model.RefreshToken]); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.RefreshToken])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_ListByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIDs'
+type MockRefreshTokenRepo_ListByIDs_Call struct {
+	*mock.Call
+}
+
+// ListByIDs is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *MockRefreshTokenRepo_Expecter) ListByIDs(ctx interface{}, ids interface{}) *MockRefreshTokenRepo_ListByIDs_Call {
+	return &MockRefreshTokenRepo_ListByIDs_Call{Call: _e.mock.On("ListByIDs", ctx, ids)}
+}
+
+func (_c *MockRefreshTokenRepo_ListByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockRefreshTokenRepo_ListByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_ListByIDs_Call) Return(listResponse *response.ListResponse[*model.RefreshToken], err error) *MockRefreshTokenRepo_ListByIDs_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_ListByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []int64) (*response.ListResponse[*model.RefreshToken], error)) *MockRefreshTokenRepo_ListByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEach provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) ListEach(ctx context.Context, fn func(item *model.RefreshToken) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(item *model.RefreshToken) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepo_ListEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEach'
+type MockRefreshTokenRepo_ListEach_Call struct {
+	*mock.Call
+}
+
+// ListEach is a helper method to define mock.On call
+//   - ctx
+//   - fn
+//   - opts
+func (_e *MockRefreshTokenRepo_Expecter) ListEach(ctx interface{}, fn interface{}, opts ...interface{}) *MockRefreshTokenRepo_ListEach_Call {
+	return &MockRefreshTokenRepo_ListEach_Call{Call: _e.mock.On("ListEach",
+		append([]interface{}{ctx, fn}, opts...)...)}
+}
+
+func (_c *MockRefreshTokenRepo_ListEach_Call) Run(run func(ctx context.Context, fn func(item *model.RefreshToken) error, opts ...clause.FilterOption)) *MockRefreshTokenRepo_ListEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(func(item *model.RefreshToken) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_ListEach_Call) Return(err error) *MockRefreshTokenRepo_ListEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_ListEach_Call) RunAndReturn(run func(ctx context.Context, fn func(item *model.RefreshToken) error, opts ...clause.FilterOption) error) *MockRefreshTokenRepo_ListEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) Update(ctx context.Context, req *model.RefreshToken) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.RefreshToken) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRefreshTokenRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockRefreshTokenRepo_Expecter) Update(ctx interface{}, req interface{}) *MockRefreshTokenRepo_Update_Call {
+	return &MockRefreshTokenRepo_Update_Call{Call: _e.mock.On("Update", ctx, req)}
+}
+
+func (_c *MockRefreshTokenRepo_Update_Call) Run(run func(ctx context.Context, req *model.RefreshToken)) *MockRefreshTokenRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.RefreshToken))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Update_Call) Return(err error) *MockRefreshTokenRepo_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Update_Call) RunAndReturn(run func(ctx context.Context, req *model.RefreshToken) error) *MockRefreshTokenRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMap provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) UpdateMap(ctx context.Context, id int64, req map[string]any) error {
+	ret := _mock.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMap")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, map[string]any) error); ok {
+		r0 = returnFunc(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRefreshTokenRepo_UpdateMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMap'
+type MockRefreshTokenRepo_UpdateMap_Call struct {
+	*mock.Call
+}
+
+// UpdateMap is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - req
+func (_e *MockRefreshTokenRepo_Expecter) UpdateMap(ctx interface{}, id interface{}, req interface{}) *MockRefreshTokenRepo_UpdateMap_Call {
+	return &MockRefreshTokenRepo_UpdateMap_Call{Call: _e.mock.On("UpdateMap", ctx, id, req)}
+}
+
+func (_c *MockRefreshTokenRepo_UpdateMap_Call) Run(run func(ctx context.Context, id int64, req map[string]any)) *MockRefreshTokenRepo_UpdateMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(map[string]any))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_UpdateMap_Call) Return(err error) *MockRefreshTokenRepo_UpdateMap_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_UpdateMap_Call) RunAndReturn(run func(ctx context.Context, id int64, req map[string]any) error) *MockRefreshTokenRepo_UpdateMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMapBy provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) UpdateMapBy(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, req, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMapBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, req, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, req, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, map[string]any, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, req, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_UpdateMapBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMapBy'
+type MockRefreshTokenRepo_UpdateMapBy_Call struct {
+	*mock.Call
+}
+
+// UpdateMapBy is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - expr
+func (_e *MockRefreshTokenRepo_Expecter) UpdateMapBy(ctx interface{}, req interface{}, expr interface{}) *MockRefreshTokenRepo_UpdateMapBy_Call {
+	return &MockRefreshTokenRepo_UpdateMapBy_Call{Call: _e.mock.On("UpdateMapBy", ctx, req, expr)}
+}
+
+func (_c *MockRefreshTokenRepo_UpdateMapBy_Call) Run(run func(ctx context.Context, req map[string]any, expr repo.Expression)) *MockRefreshTokenRepo_UpdateMapBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]any), args[2].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_UpdateMapBy_Call) Return(n int64, err error) *MockRefreshTokenRepo_UpdateMapBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_UpdateMapBy_Call) RunAndReturn(run func(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error)) *MockRefreshTokenRepo_UpdateMapBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockRefreshTokenRepo
+func (_mock *MockRefreshTokenRepo) Upsert(ctx context.Context, req *model.RefreshToken, target string) (bool, error) {
+	ret := _mock.Called(ctx, req, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.RefreshToken, string) (bool, error)); ok {
+		return returnFunc(ctx, req, target)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.RefreshToken, string) bool); ok {
+		r0 = returnFunc(ctx, req, target)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.RefreshToken, string) error); ok {
+		r1 = returnFunc(ctx, req, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRefreshTokenRepo_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockRefreshTokenRepo_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - target
+func (_e *MockRefreshTokenRepo_Expecter) Upsert(ctx interface{}, req interface{}, target interface{}) *MockRefreshTokenRepo_Upsert_Call {
+	return &MockRefreshTokenRepo_Upsert_Call{Call: _e.mock.On("Upsert", ctx, req, target)}
+}
+
+func (_c *MockRefreshTokenRepo_Upsert_Call) Run(run func(ctx context.Context, req *model.RefreshToken, target string)) *MockRefreshTokenRepo_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.RefreshToken), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Upsert_Call) Return(b bool, err error) *MockRefreshTokenRepo_Upsert_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockRefreshTokenRepo_Upsert_Call) RunAndReturn(run func(ctx context.Context, req *model.RefreshToken, target string) (bool, error)) *MockRefreshTokenRepo_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRevokedTokenRepo creates a new instance of MockRevokedTokenRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRevokedTokenRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRevokedTokenRepo {
+	mock := &MockRevokedTokenRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRevokedTokenRepo is an autogenerated mock type for the RevokedTokenRepo type
+type MockRevokedTokenRepo struct {
+	mock.Mock
+}
+
+type MockRevokedTokenRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRevokedTokenRepo) EXPECT() *MockRevokedTokenRepo_Expecter {
+	return &MockRevokedTokenRepo_Expecter{mock: &_m.Mock}
+}
+
+// CountBy provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) CountBy(ctx context.Context, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_CountBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBy'
+type MockRevokedTokenRepo_CountBy_Call struct {
+	*mock.Call
+}
+
+// CountBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockRevokedTokenRepo_Expecter) CountBy(ctx interface{}, expr interface{}) *MockRevokedTokenRepo_CountBy_Call {
+	return &MockRevokedTokenRepo_CountBy_Call{Call: _e.mock.On("CountBy", ctx, expr)}
+}
+
+func (_c *MockRevokedTokenRepo_CountBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockRevokedTokenRepo_CountBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_CountBy_Call) Return(n int64, err error) *MockRevokedTokenRepo_CountBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_CountBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (int64, error)) *MockRevokedTokenRepo_CountBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRevokedTokenRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRevokedTokenRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockRevokedTokenRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockRevokedTokenRepo_Delete_Call {
+	return &MockRevokedTokenRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockRevokedTokenRepo_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockRevokedTokenRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Delete_Call) Return(err error) *MockRevokedTokenRepo_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockRevokedTokenRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBy provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) DeleteBy(ctx context.Context, expr repo.Ex) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Ex) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_DeleteBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBy'
+type MockRevokedTokenRepo_DeleteBy_Call struct {
+	*mock.Call
+}
+
+// DeleteBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockRevokedTokenRepo_Expecter) DeleteBy(ctx interface{}, expr interface{}) *MockRevokedTokenRepo_DeleteBy_Call {
+	return &MockRevokedTokenRepo_DeleteBy_Call{Call: _e.mock.On("DeleteBy", ctx, expr)}
+}
+
+func (_c *MockRevokedTokenRepo_DeleteBy_Call) Run(run func(ctx context.Context, expr repo.Ex)) *MockRevokedTokenRepo_DeleteBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Ex))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_DeleteBy_Call) Return(n int64, err error) *MockRevokedTokenRepo_DeleteBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_DeleteBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Ex) (int64, error)) *MockRevokedTokenRepo_DeleteBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) Exists(ctx context.Context, expr repo.Expression) (bool, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (bool, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) bool); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockRevokedTokenRepo_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockRevokedTokenRepo_Expecter) Exists(ctx interface{}, expr interface{}) *MockRevokedTokenRepo_Exists_Call {
+	return &MockRevokedTokenRepo_Exists_Call{Call: _e.mock.On("Exists", ctx, expr)}
+}
+
+func (_c *MockRevokedTokenRepo_Exists_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockRevokedTokenRepo_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Exists_Call) Return(b bool, err error) *MockRevokedTokenRepo_Exists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Exists_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (bool, error)) *MockRevokedTokenRepo_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) Find(ctx context.Context, dest *model.RevokedToken, id int64) error {
+	ret := _mock.Called(ctx, dest, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.RevokedToken, int64) error); ok {
+		r0 = returnFunc(ctx, dest, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRevokedTokenRepo_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockRevokedTokenRepo_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx
+//   - dest
+//   - id
+func (_e *MockRevokedTokenRepo_Expecter) Find(ctx interface{}, dest interface{}, id interface{}) *MockRevokedTokenRepo_Find_Call {
+	return &MockRevokedTokenRepo_Find_Call{Call: _e.mock.On("Find", ctx, dest, id)}
+}
+
+func (_c *MockRevokedTokenRepo_Find_Call) Run(run func(ctx context.Context, dest *model.RevokedToken, id int64)) *MockRevokedTokenRepo_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.RevokedToken), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Find_Call) Return(err error) *MockRevokedTokenRepo_Find_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Find_Call) RunAndReturn(run func(ctx context.Context, dest *model.RevokedToken, id int64) error) *MockRevokedTokenRepo_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// First provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) First(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.RevokedToken, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for First")
+	}
+
+	var r0 *model.RevokedToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.RevokedToken, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.RevokedToken); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RevokedToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_First_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'First'
+type MockRevokedTokenRepo_First_Call struct {
+	*mock.Call
+}
+
+// First is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockRevokedTokenRepo_Expecter) First(ctx interface{}, expr interface{}, order ...interface{}) *MockRevokedTokenRepo_First_Call {
+	return &MockRevokedTokenRepo_First_Call{Call: _e.mock.On("First",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockRevokedTokenRepo_First_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockRevokedTokenRepo_First_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_First_Call) Return(revokedToken *model.RevokedToken, err error) *MockRevokedTokenRepo_First_Call {
+	_c.Call.Return(revokedToken, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_First_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.RevokedToken, error)) *MockRevokedTokenRepo_First_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) Get(ctx context.Context, id int64) (*model.RevokedToken, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.RevokedToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.RevokedToken, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.RevokedToken); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RevokedToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRevokedTokenRepo_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockRevokedTokenRepo_Expecter) Get(ctx interface{}, id interface{}) *MockRevokedTokenRepo_Get_Call {
+	return &MockRevokedTokenRepo_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *MockRevokedTokenRepo_Get_Call) Run(run func(ctx context.Context, id int64)) *MockRevokedTokenRepo_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Get_Call) Return(revokedToken *model.RevokedToken, err error) *MockRevokedTokenRepo_Get_Call {
+	_c.Call.Return(revokedToken, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Get_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.RevokedToken, error)) *MockRevokedTokenRepo_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBy provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) GetBy(ctx context.Context, expr repo.Expression) (*model.RevokedToken, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBy")
+	}
+
+	var r0 *model.RevokedToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (*model.RevokedToken, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) *model.RevokedToken); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RevokedToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_GetBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBy'
+type MockRevokedTokenRepo_GetBy_Call struct {
+	*mock.Call
+}
+
+// GetBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockRevokedTokenRepo_Expecter) GetBy(ctx interface{}, expr interface{}) *MockRevokedTokenRepo_GetBy_Call {
+	return &MockRevokedTokenRepo_GetBy_Call{Call: _e.mock.On("GetBy", ctx, expr)}
+}
+
+func (_c *MockRevokedTokenRepo_GetBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockRevokedTokenRepo_GetBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_GetBy_Call) Return(revokedToken *model.RevokedToken, err error) *MockRevokedTokenRepo_GetBy_Call {
+	_c.Call.Return(revokedToken, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_GetBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (*model.RevokedToken, error)) *MockRevokedTokenRepo_GetBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForUpdate provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) GetForUpdate(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.RevokedToken, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUpdate")
+	}
+
+	var r0 *model.RevokedToken
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.RevokedToken, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.RevokedToken); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RevokedToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_GetForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUpdate'
+type MockRevokedTokenRepo_GetForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetForUpdate is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockRevokedTokenRepo_Expecter) GetForUpdate(ctx interface{}, expr interface{}, order ...interface{}) *MockRevokedTokenRepo_GetForUpdate_Call {
+	return &MockRevokedTokenRepo_GetForUpdate_Call{Call: _e.mock.On("GetForUpdate",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockRevokedTokenRepo_GetForUpdate_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockRevokedTokenRepo_GetForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_GetForUpdate_Call) Return(revokedToken *model.RevokedToken, err error) *MockRevokedTokenRepo_GetForUpdate_Call {
+	_c.Call.Return(revokedToken, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_GetForUpdate_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.RevokedToken, error)) *MockRevokedTokenRepo_GetForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) Insert(ctx context.Context, req *model.RevokedToken) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.RevokedToken) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRevokedTokenRepo_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockRevokedTokenRepo_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockRevokedTokenRepo_Expecter) Insert(ctx interface{}, req interface{}) *MockRevokedTokenRepo_Insert_Call {
+	return &MockRevokedTokenRepo_Insert_Call{Call: _e.mock.On("Insert", ctx, req)}
+}
+
+func (_c *MockRevokedTokenRepo_Insert_Call) Run(run func(ctx context.Context, req *model.RevokedToken)) *MockRevokedTokenRepo_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.RevokedToken))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Insert_Call) Return(err error) *MockRevokedTokenRepo_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Insert_Call) RunAndReturn(run func(ctx context.Context, req *model.RevokedToken) error) *MockRevokedTokenRepo_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsRevoked provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	ret := _mock.Called(ctx, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsRevoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, tokenID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, tokenID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_IsRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsRevoked'
+type MockRevokedTokenRepo_IsRevoked_Call struct {
+	*mock.Call
+}
+
+// IsRevoked is a helper method to define mock.On call
+//   - ctx
+//   - tokenID
+func (_e *MockRevokedTokenRepo_Expecter) IsRevoked(ctx interface{}, tokenID interface{}) *MockRevokedTokenRepo_IsRevoked_Call {
+	return &MockRevokedTokenRepo_IsRevoked_Call{Call: _e.mock.On("IsRevoked", ctx, tokenID)}
+}
+
+func (_c *MockRevokedTokenRepo_IsRevoked_Call) Run(run func(ctx context.Context, tokenID string)) *MockRevokedTokenRepo_IsRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_IsRevoked_Call) Return(b bool, err error) *MockRevokedTokenRepo_IsRevoked_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_IsRevoked_Call) RunAndReturn(run func(ctx context.Context, tokenID string) (bool, error)) *MockRevokedTokenRepo_IsRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) List(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.RevokedToken], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, opts)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *response.ListResponse[*model.RevokedToken]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) (*response.ListResponse[*model.RevokedToken], error)); ok {
+		return returnFunc(ctx, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) *response.ListResponse[*model.RevokedToken]); ok {
+		r0 = returnFunc(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.RevokedToken])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockRevokedTokenRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - opts
+func (_e *MockRevokedTokenRepo_Expecter) List(ctx interface{}, opts ...interface{}) *MockRevokedTokenRepo_List_Call {
+	return &MockRevokedTokenRepo_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx}, opts...)...)}
+}
+
+func (_c *MockRevokedTokenRepo_List_Call) Run(run func(ctx context.Context, opts ...clause.FilterOption)) *MockRevokedTokenRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[1].([]clause.FilterOption)
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_List_Call) Return(listResponse *response.ListResponse[*model.RevokedToken], err error) *MockRevokedTokenRepo_List_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_List_Call) RunAndReturn(run func(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.RevokedToken], error)) *MockRevokedTokenRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBy provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) ListBy(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.RevokedToken], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBy")
+	}
+
+	var r0 *response.ListResponse[*model.RevokedToken]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) (*response.ListResponse[*model.RevokedToken], error)); ok {
+		return returnFunc(ctx, expr, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) *response.ListResponse[*model.RevokedToken]); ok {
+		r0 = returnFunc(ctx, expr, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.RevokedToken])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, expr, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_ListBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBy'
+type MockRevokedTokenRepo_ListBy_Call struct {
+	*mock.Call
+}
+
+// ListBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - opts
+func (_e *MockRevokedTokenRepo_Expecter) ListBy(ctx interface{}, expr interface{}, opts ...interface{}) *MockRevokedTokenRepo_ListBy_Call {
+	return &MockRevokedTokenRepo_ListBy_Call{Call: _e.mock.On("ListBy",
+		append([]interface{}{ctx, expr}, opts...)...)}
+}
+
+func (_c *MockRevokedTokenRepo_ListBy_Call) Run(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption)) *MockRevokedTokenRepo_ListBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_ListBy_Call) Return(listResponse *response.ListResponse[*model.RevokedToken], err error) *MockRevokedTokenRepo_ListBy_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_ListBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.RevokedToken], error)) *MockRevokedTokenRepo_ListBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByEach provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) ListByEach(ctx context.Context, expr repo.Expression, fn func(item *model.RevokedToken) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, func(item *model.RevokedToken) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, expr, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRevokedTokenRepo_ListByEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEach'
+type MockRevokedTokenRepo_ListByEach_Call struct {
+	*mock.Call
+}
+
+// ListByEach is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - fn
+//   - opts
+func (_e *MockRevokedTokenRepo_Expecter) ListByEach(ctx interface{}, expr interface{}, fn interface{}, opts ...interface{}) *MockRevokedTokenRepo_ListByEach_Call {
+	return &MockRevokedTokenRepo_ListByEach_Call{Call: _e.mock.On("ListByEach",
+		append([]interface{}{ctx, expr, fn}, opts...)...)}
+}
+
+func (_c *MockRevokedTokenRepo_ListByEach_Call) Run(run func(ctx context.Context, expr repo.Expression, fn func(item *model.RevokedToken) error, opts ...clause.FilterOption)) *MockRevokedTokenRepo_ListByEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[3].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), args[2].(func(item *model.RevokedToken) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_ListByEach_Call) Return(err error) *MockRevokedTokenRepo_ListByEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_ListByEach_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, fn func(item *model.RevokedToken) error, opts ...clause.FilterOption) error) *MockRevokedTokenRepo_ListByEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIDs provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) ListByIDs(ctx context.Context, ids []int64) (*response.ListResponse[*model.RevokedToken], error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIDs")
+	}
+
+	var r0 *response.ListResponse[*model.RevokedToken]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) (*response.ListResponse[*model.RevokedToken], error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) *response.ListResponse[*model.RevokedToken]); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.RevokedToken])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_ListByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIDs'
+type MockRevokedTokenRepo_ListByIDs_Call struct {
+	*mock.Call
+}
+
+// ListByIDs is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *MockRevokedTokenRepo_Expecter) ListByIDs(ctx interface{}, ids interface{}) *MockRevokedTokenRepo_ListByIDs_Call {
+	return &MockRevokedTokenRepo_ListByIDs_Call{Call: _e.mock.On("ListByIDs", ctx, ids)}
+}
+
+func (_c *MockRevokedTokenRepo_ListByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockRevokedTokenRepo_ListByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_ListByIDs_Call) Return(listResponse *response.ListResponse[*model.RevokedToken], err error) *MockRevokedTokenRepo_ListByIDs_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_ListByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []int64) (*response.ListResponse[*model.RevokedToken], error)) *MockRevokedTokenRepo_ListByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEach provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) ListEach(ctx context.Context, fn func(item *model.RevokedToken) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(item *model.RevokedToken) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRevokedTokenRepo_ListEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEach'
+type MockRevokedTokenRepo_ListEach_Call struct {
+	*mock.Call
+}
+
+// ListEach is a helper method to define mock.On call
+//   - ctx
+//   - fn
+//   - opts
+func (_e *MockRevokedTokenRepo_Expecter) ListEach(ctx interface{}, fn interface{}, opts ...interface{}) *MockRevokedTokenRepo_ListEach_Call {
+	return &MockRevokedTokenRepo_ListEach_Call{Call: _e.mock.On("ListEach",
+		append([]interface{}{ctx, fn}, opts...)...)}
+}
+
+func (_c *MockRevokedTokenRepo_ListEach_Call) Run(run func(ctx context.Context, fn func(item *model.RevokedToken) error, opts ...clause.FilterOption)) *MockRevokedTokenRepo_ListEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(func(item *model.RevokedToken) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_ListEach_Call) Return(err error) *MockRevokedTokenRepo_ListEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_ListEach_Call) RunAndReturn(run func(ctx context.Context, fn func(item *model.RevokedToken) error, opts ...clause.FilterOption) error) *MockRevokedTokenRepo_ListEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) Update(ctx context.Context, req *model.RevokedToken) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.RevokedToken) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRevokedTokenRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockRevokedTokenRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockRevokedTokenRepo_Expecter) Update(ctx interface{}, req interface{}) *MockRevokedTokenRepo_Update_Call {
+	return &MockRevokedTokenRepo_Update_Call{Call: _e.mock.On("Update", ctx, req)}
+}
+
+func (_c *MockRevokedTokenRepo_Update_Call) Run(run func(ctx context.Context, req *model.RevokedToken)) *MockRevokedTokenRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.RevokedToken))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Update_Call) Return(err error) *MockRevokedTokenRepo_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Update_Call) RunAndReturn(run func(ctx context.Context, req *model.RevokedToken) error) *MockRevokedTokenRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMap provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) UpdateMap(ctx context.Context, id int64, req map[string]any) error {
+	ret := _mock.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMap")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, map[string]any) error); ok {
+		r0 = returnFunc(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRevokedTokenRepo_UpdateMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMap'
+type MockRevokedTokenRepo_UpdateMap_Call struct {
+	*mock.Call
+}
+
+// UpdateMap is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - req
+func (_e *MockRevokedTokenRepo_Expecter) UpdateMap(ctx interface{}, id interface{}, req interface{}) *MockRevokedTokenRepo_UpdateMap_Call {
+	return &MockRevokedTokenRepo_UpdateMap_Call{Call: _e.mock.On("UpdateMap", ctx, id, req)}
+}
+
+func (_c *MockRevokedTokenRepo_UpdateMap_Call) Run(run func(ctx context.Context, id int64, req map[string]any)) *MockRevokedTokenRepo_UpdateMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(map[string]any))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_UpdateMap_Call) Return(err error) *MockRevokedTokenRepo_UpdateMap_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_UpdateMap_Call) RunAndReturn(run func(ctx context.Context, id int64, req map[string]any) error) *MockRevokedTokenRepo_UpdateMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMapBy provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) UpdateMapBy(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, req, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMapBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, req, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, req, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, map[string]any, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, req, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_UpdateMapBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMapBy'
+type MockRevokedTokenRepo_UpdateMapBy_Call struct {
+	*mock.Call
+}
+
+// UpdateMapBy is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - expr
+func (_e *MockRevokedTokenRepo_Expecter) UpdateMapBy(ctx interface{}, req interface{}, expr interface{}) *MockRevokedTokenRepo_UpdateMapBy_Call {
+	return &MockRevokedTokenRepo_UpdateMapBy_Call{Call: _e.mock.On("UpdateMapBy", ctx, req, expr)}
+}
+
+func (_c *MockRevokedTokenRepo_UpdateMapBy_Call) Run(run func(ctx context.Context, req map[string]any, expr repo.Expression)) *MockRevokedTokenRepo_UpdateMapBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]any), args[2].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_UpdateMapBy_Call) Return(n int64, err error) *MockRevokedTokenRepo_UpdateMapBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_UpdateMapBy_Call) RunAndReturn(run func(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error)) *MockRevokedTokenRepo_UpdateMapBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockRevokedTokenRepo
+func (_mock *MockRevokedTokenRepo) Upsert(ctx context.Context, req *model.RevokedToken, target string) (bool, error) {
+	ret := _mock.Called(ctx, req, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.RevokedToken, string) (bool, error)); ok {
+		return returnFunc(ctx, req, target)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.RevokedToken, string) bool); ok {
+		r0 = returnFunc(ctx, req, target)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.RevokedToken, string) error); ok {
+		r1 = returnFunc(ctx, req, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevokedTokenRepo_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockRevokedTokenRepo_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - target
+func (_e *MockRevokedTokenRepo_Expecter) Upsert(ctx interface{}, req interface{}, target interface{}) *MockRevokedTokenRepo_Upsert_Call {
+	return &MockRevokedTokenRepo_Upsert_Call{Call: _e.mock.On("Upsert", ctx, req, target)}
+}
+
+func (_c *MockRevokedTokenRepo_Upsert_Call) Run(run func(ctx context.Context, req *model.RevokedToken, target string)) *MockRevokedTokenRepo_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.RevokedToken), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Upsert_Call) Return(b bool, err error) *MockRevokedTokenRepo_Upsert_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockRevokedTokenRepo_Upsert_Call) RunAndReturn(run func(ctx context.Context, req *model.RevokedToken, target string) (bool, error)) *MockRevokedTokenRepo_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSigningKeyRepo creates a new instance of MockSigningKeyRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSigningKeyRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSigningKeyRepo {
+	mock := &MockSigningKeyRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSigningKeyRepo is an autogenerated mock type for the SigningKeyRepo type
+type MockSigningKeyRepo struct {
+	mock.Mock
+}
+
+type MockSigningKeyRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSigningKeyRepo) EXPECT() *MockSigningKeyRepo_Expecter {
+	return &MockSigningKeyRepo_Expecter{mock: &_m.Mock}
+}
+
+// CountBy provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) CountBy(ctx context.Context, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_CountBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBy'
+type MockSigningKeyRepo_CountBy_Call struct {
+	*mock.Call
+}
+
+// CountBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockSigningKeyRepo_Expecter) CountBy(ctx interface{}, expr interface{}) *MockSigningKeyRepo_CountBy_Call {
+	return &MockSigningKeyRepo_CountBy_Call{Call: _e.mock.On("CountBy", ctx, expr)}
+}
+
+func (_c *MockSigningKeyRepo_CountBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockSigningKeyRepo_CountBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_CountBy_Call) Return(n int64, err error) *MockSigningKeyRepo_CountBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_CountBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (int64, error)) *MockSigningKeyRepo_CountBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSigningKeyRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockSigningKeyRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockSigningKeyRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockSigningKeyRepo_Delete_Call {
+	return &MockSigningKeyRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockSigningKeyRepo_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockSigningKeyRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Delete_Call) Return(err error) *MockSigningKeyRepo_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockSigningKeyRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBy provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) DeleteBy(ctx context.Context, expr repo.Ex) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Ex) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_DeleteBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBy'
+type MockSigningKeyRepo_DeleteBy_Call struct {
+	*mock.Call
+}
+
+// DeleteBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockSigningKeyRepo_Expecter) DeleteBy(ctx interface{}, expr interface{}) *MockSigningKeyRepo_DeleteBy_Call {
+	return &MockSigningKeyRepo_DeleteBy_Call{Call: _e.mock.On("DeleteBy", ctx, expr)}
+}
+
+func (_c *MockSigningKeyRepo_DeleteBy_Call) Run(run func(ctx context.Context, expr repo.Ex)) *MockSigningKeyRepo_DeleteBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Ex))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_DeleteBy_Call) Return(n int64, err error) *MockSigningKeyRepo_DeleteBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_DeleteBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Ex) (int64, error)) *MockSigningKeyRepo_DeleteBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) Exists(ctx context.Context, expr repo.Expression) (bool, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (bool, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) bool); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockSigningKeyRepo_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockSigningKeyRepo_Expecter) Exists(ctx interface{}, expr interface{}) *MockSigningKeyRepo_Exists_Call {
+	return &MockSigningKeyRepo_Exists_Call{Call: _e.mock.On("Exists", ctx, expr)}
+}
+
+func (_c *MockSigningKeyRepo_Exists_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockSigningKeyRepo_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Exists_Call) Return(b bool, err error) *MockSigningKeyRepo_Exists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Exists_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (bool, error)) *MockSigningKeyRepo_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) Find(ctx context.Context, dest *model.SigningKey, id int64) error {
+	ret := _mock.Called(ctx, dest, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.SigningKey, int64) error); ok {
+		r0 = returnFunc(ctx, dest, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSigningKeyRepo_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockSigningKeyRepo_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx
+//   - dest
+//   - id
+func (_e *MockSigningKeyRepo_Expecter) Find(ctx interface{}, dest interface{}, id interface{}) *MockSigningKeyRepo_Find_Call {
+	return &MockSigningKeyRepo_Find_Call{Call: _e.mock.On("Find", ctx, dest, id)}
+}
+
+func (_c *MockSigningKeyRepo_Find_Call) Run(run func(ctx context.Context, dest *model.SigningKey, id int64)) *MockSigningKeyRepo_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SigningKey), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Find_Call) Return(err error) *MockSigningKeyRepo_Find_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Find_Call) RunAndReturn(run func(ctx context.Context, dest *model.SigningKey, id int64) error) *MockSigningKeyRepo_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// First provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) First(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.SigningKey, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for First")
+	}
+
+	var r0 *model.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.SigningKey, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.SigningKey); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_First_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'First'
+type MockSigningKeyRepo_First_Call struct {
+	*mock.Call
+}
+
+// First is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockSigningKeyRepo_Expecter) First(ctx interface{}, expr interface{}, order ...interface{}) *MockSigningKeyRepo_First_Call {
+	return &MockSigningKeyRepo_First_Call{Call: _e.mock.On("First",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockSigningKeyRepo_First_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockSigningKeyRepo_First_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_First_Call) Return(signingKey *model.SigningKey, err error) *MockSigningKeyRepo_First_Call {
+	_c.Call.Return(signingKey, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_First_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.SigningKey, error)) *MockSigningKeyRepo_First_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) Get(ctx context.Context, id int64) (*model.SigningKey, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.SigningKey, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.SigningKey); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockSigningKeyRepo_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockSigningKeyRepo_Expecter) Get(ctx interface{}, id interface{}) *MockSigningKeyRepo_Get_Call {
+	return &MockSigningKeyRepo_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *MockSigningKeyRepo_Get_Call) Run(run func(ctx context.Context, id int64)) *MockSigningKeyRepo_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Get_Call) Return(signingKey *model.SigningKey, err error) *MockSigningKeyRepo_Get_Call {
+	_c.Call.Return(signingKey, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Get_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.SigningKey, error)) *MockSigningKeyRepo_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveForUpdate provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) GetActiveForUpdate(ctx context.Context) (*model.SigningKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveForUpdate")
+	}
+
+	var r0 *model.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*model.SigningKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *model.SigningKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_GetActiveForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveForUpdate'
+type MockSigningKeyRepo_GetActiveForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetActiveForUpdate is a helper method to define mock.On call
+//   - ctx
+func (_e *MockSigningKeyRepo_Expecter) GetActiveForUpdate(ctx interface{}) *MockSigningKeyRepo_GetActiveForUpdate_Call {
+	return &MockSigningKeyRepo_GetActiveForUpdate_Call{Call: _e.mock.On("GetActiveForUpdate", ctx)}
+}
+
+func (_c *MockSigningKeyRepo_GetActiveForUpdate_Call) Run(run func(ctx context.Context)) *MockSigningKeyRepo_GetActiveForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_GetActiveForUpdate_Call) Return(signingKey *model.SigningKey, err error) *MockSigningKeyRepo_GetActiveForUpdate_Call {
+	_c.Call.Return(signingKey, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_GetActiveForUpdate_Call) RunAndReturn(run func(ctx context.Context) (*model.SigningKey, error)) *MockSigningKeyRepo_GetActiveForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBy provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) GetBy(ctx context.Context, expr repo.Expression) (*model.SigningKey, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBy")
+	}
+
+	var r0 *model.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (*model.SigningKey, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) *model.SigningKey); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_GetBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBy'
+type MockSigningKeyRepo_GetBy_Call struct {
+	*mock.Call
+}
+
+// GetBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockSigningKeyRepo_Expecter) GetBy(ctx interface{}, expr interface{}) *MockSigningKeyRepo_GetBy_Call {
+	return &MockSigningKeyRepo_GetBy_Call{Call: _e.mock.On("GetBy", ctx, expr)}
+}
+
+func (_c *MockSigningKeyRepo_GetBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockSigningKeyRepo_GetBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_GetBy_Call) Return(signingKey *model.SigningKey, err error) *MockSigningKeyRepo_GetBy_Call {
+	_c.Call.Return(signingKey, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_GetBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (*model.SigningKey, error)) *MockSigningKeyRepo_GetBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForUpdate provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) GetForUpdate(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.SigningKey, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUpdate")
+	}
+
+	var r0 *model.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.SigningKey, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.SigningKey); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_GetForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUpdate'
+type MockSigningKeyRepo_GetForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetForUpdate is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockSigningKeyRepo_Expecter) GetForUpdate(ctx interface{}, expr interface{}, order ...interface{}) *MockSigningKeyRepo_GetForUpdate_Call {
+	return &MockSigningKeyRepo_GetForUpdate_Call{Call: _e.mock.On("GetForUpdate",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockSigningKeyRepo_GetForUpdate_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockSigningKeyRepo_GetForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_GetForUpdate_Call) Return(signingKey *model.SigningKey, err error) *MockSigningKeyRepo_GetForUpdate_Call {
+	_c.Call.Return(signingKey, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_GetForUpdate_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.SigningKey, error)) *MockSigningKeyRepo_GetForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) Insert(ctx context.Context, req *model.SigningKey) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.SigningKey) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSigningKeyRepo_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockSigningKeyRepo_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockSigningKeyRepo_Expecter) Insert(ctx interface{}, req interface{}) *MockSigningKeyRepo_Insert_Call {
+	return &MockSigningKeyRepo_Insert_Call{Call: _e.mock.On("Insert", ctx, req)}
+}
+
+func (_c *MockSigningKeyRepo_Insert_Call) Run(run func(ctx context.Context, req *model.SigningKey)) *MockSigningKeyRepo_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SigningKey))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Insert_Call) Return(err error) *MockSigningKeyRepo_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Insert_Call) RunAndReturn(run func(ctx context.Context, req *model.SigningKey) error) *MockSigningKeyRepo_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) List(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.SigningKey], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, opts)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *response.ListResponse[*model.SigningKey]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) (*response.ListResponse[*model.SigningKey], error)); ok {
+		return returnFunc(ctx, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) *response.ListResponse[*model.SigningKey]); ok {
+		r0 = returnFunc(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.SigningKey])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockSigningKeyRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - opts
+func (_e *MockSigningKeyRepo_Expecter) List(ctx interface{}, opts ...interface{}) *MockSigningKeyRepo_List_Call {
+	return &MockSigningKeyRepo_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx}, opts...)...)}
+}
+
+func (_c *MockSigningKeyRepo_List_Call) Run(run func(ctx context.Context, opts ...clause.FilterOption)) *MockSigningKeyRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[1].([]clause.FilterOption)
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_List_Call) Return(listResponse *response.ListResponse[*model.SigningKey], err error) *MockSigningKeyRepo_List_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_List_Call) RunAndReturn(run func(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.SigningKey], error)) *MockSigningKeyRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBy provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) ListBy(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.SigningKey], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBy")
+	}
+
+	var r0 *response.ListResponse[*model.SigningKey]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) (*response.ListResponse[*model.SigningKey], error)); ok {
+		return returnFunc(ctx, expr, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) *response.ListResponse[*model.SigningKey]); ok {
+		r0 = returnFunc(ctx, expr, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.SigningKey])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, expr, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_ListBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBy'
+type MockSigningKeyRepo_ListBy_Call struct {
+	*mock.Call
+}
+
+// ListBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - opts
+func (_e *MockSigningKeyRepo_Expecter) ListBy(ctx interface{}, expr interface{}, opts ...interface{}) *MockSigningKeyRepo_ListBy_Call {
+	return &MockSigningKeyRepo_ListBy_Call{Call: _e.mock.On("ListBy",
+		append([]interface{}{ctx, expr}, opts...)...)}
+}
+
+func (_c *MockSigningKeyRepo_ListBy_Call) Run(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption)) *MockSigningKeyRepo_ListBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_ListBy_Call) Return(listResponse *response.ListResponse[*model.SigningKey], err error) *MockSigningKeyRepo_ListBy_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_ListBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.SigningKey], error)) *MockSigningKeyRepo_ListBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByEach provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) ListByEach(ctx context.Context, expr repo.Expression, fn func(item *model.SigningKey) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, func(item *model.SigningKey) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, expr, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSigningKeyRepo_ListByEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEach'
+type MockSigningKeyRepo_ListByEach_Call struct {
+	*mock.Call
+}
+
+// ListByEach is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - fn
+//   - opts
+func (_e *MockSigningKeyRepo_Expecter) ListByEach(ctx interface{}, expr interface{}, fn interface{}, opts ...interface{}) *MockSigningKeyRepo_ListByEach_Call {
+	return &MockSigningKeyRepo_ListByEach_Call{Call: _e.mock.On("ListByEach",
+		append([]interface{}{ctx, expr, fn}, opts...)...)}
+}
+
+func (_c *MockSigningKeyRepo_ListByEach_Call) Run(run func(ctx context.Context, expr repo.Expression, fn func(item *model.SigningKey) error, opts ...clause.FilterOption)) *MockSigningKeyRepo_ListByEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[3].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), args[2].(func(item *model.SigningKey) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_ListByEach_Call) Return(err error) *MockSigningKeyRepo_ListByEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_ListByEach_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, fn func(item *model.SigningKey) error, opts ...clause.FilterOption) error) *MockSigningKeyRepo_ListByEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIDs provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) ListByIDs(ctx context.Context, ids []int64) (*response.ListResponse[*model.SigningKey], error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIDs")
+	}
+
+	var r0 *response.ListResponse[*model.SigningKey]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) (*response.ListResponse[*model.SigningKey], error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) *response.ListResponse[*model.SigningKey]); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.SigningKey])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_ListByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIDs'
+type MockSigningKeyRepo_ListByIDs_Call struct {
+	*mock.Call
+}
+
+// ListByIDs is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *MockSigningKeyRepo_Expecter) ListByIDs(ctx interface{}, ids interface{}) *MockSigningKeyRepo_ListByIDs_Call {
+	return &MockSigningKeyRepo_ListByIDs_Call{Call: _e.mock.On("ListByIDs", ctx, ids)}
+}
+
+func (_c *MockSigningKeyRepo_ListByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockSigningKeyRepo_ListByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_ListByIDs_Call) Return(listResponse *response.ListResponse[*model.SigningKey], err error) *MockSigningKeyRepo_ListByIDs_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_ListByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []int64) (*response.ListResponse[*model.SigningKey], error)) *MockSigningKeyRepo_ListByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEach provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) ListEach(ctx context.Context, fn func(item *model.SigningKey) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(item *model.SigningKey) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSigningKeyRepo_ListEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEach'
+type MockSigningKeyRepo_ListEach_Call struct {
+	*mock.Call
+}
+
+// ListEach is a helper method to define mock.On call
+//   - ctx
+//   - fn
+//   - opts
+func (_e *MockSigningKeyRepo_Expecter) ListEach(ctx interface{}, fn interface{}, opts ...interface{}) *MockSigningKeyRepo_ListEach_Call {
+	return &MockSigningKeyRepo_ListEach_Call{Call: _e.mock.On("ListEach",
+		append([]interface{}{ctx, fn}, opts...)...)}
+}
+
+func (_c *MockSigningKeyRepo_ListEach_Call) Run(run func(ctx context.Context, fn func(item *model.SigningKey) error, opts ...clause.FilterOption)) *MockSigningKeyRepo_ListEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(func(item *model.SigningKey) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_ListEach_Call) Return(err error) *MockSigningKeyRepo_ListEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_ListEach_Call) RunAndReturn(run func(ctx context.Context, fn func(item *model.SigningKey) error, opts ...clause.FilterOption) error) *MockSigningKeyRepo_ListEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListValid provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) ListValid(ctx context.Context, now time.Time) ([]*model.SigningKey, error) {
+	ret := _mock.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListValid")
+	}
+
+	var r0 []*model.SigningKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) ([]*model.SigningKey, error)); ok {
+		return returnFunc(ctx, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) []*model.SigningKey); ok {
+		r0 = returnFunc(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SigningKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = returnFunc(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_ListValid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListValid'
+type MockSigningKeyRepo_ListValid_Call struct {
+	*mock.Call
+}
+
+// ListValid is a helper method to define mock.On call
+//   - ctx
+//   - now
+func (_e *MockSigningKeyRepo_Expecter) ListValid(ctx interface{}, now interface{}) *MockSigningKeyRepo_ListValid_Call {
+	return &MockSigningKeyRepo_ListValid_Call{Call: _e.mock.On("ListValid", ctx, now)}
+}
+
+func (_c *MockSigningKeyRepo_ListValid_Call) Run(run func(ctx context.Context, now time.Time)) *MockSigningKeyRepo_ListValid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_ListValid_Call) Return(signingKeys []*model.SigningKey, err error) *MockSigningKeyRepo_ListValid_Call {
+	_c.Call.Return(signingKeys, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_ListValid_Call) RunAndReturn(run func(ctx context.Context, now time.Time) ([]*model.SigningKey, error)) *MockSigningKeyRepo_ListValid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) Update(ctx context.Context, req *model.SigningKey) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.SigningKey) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSigningKeyRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockSigningKeyRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockSigningKeyRepo_Expecter) Update(ctx interface{}, req interface{}) *MockSigningKeyRepo_Update_Call {
+	return &MockSigningKeyRepo_Update_Call{Call: _e.mock.On("Update", ctx, req)}
+}
+
+func (_c *MockSigningKeyRepo_Update_Call) Run(run func(ctx context.Context, req *model.SigningKey)) *MockSigningKeyRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SigningKey))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Update_Call) Return(err error) *MockSigningKeyRepo_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Update_Call) RunAndReturn(run func(ctx context.Context, req *model.SigningKey) error) *MockSigningKeyRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMap provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) UpdateMap(ctx context.Context, id int64, req map[string]any) error {
+	ret := _mock.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMap")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, map[string]any) error); ok {
+		r0 = returnFunc(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSigningKeyRepo_UpdateMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMap'
+type MockSigningKeyRepo_UpdateMap_Call struct {
+	*mock.Call
+}
+
+// UpdateMap is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - req
+func (_e *MockSigningKeyRepo_Expecter) UpdateMap(ctx interface{}, id interface{}, req interface{}) *MockSigningKeyRepo_UpdateMap_Call {
+	return &MockSigningKeyRepo_UpdateMap_Call{Call: _e.mock.On("UpdateMap", ctx, id, req)}
+}
+
+func (_c *MockSigningKeyRepo_UpdateMap_Call) Run(run func(ctx context.Context, id int64, req map[string]any)) *MockSigningKeyRepo_UpdateMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(map[string]any))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_UpdateMap_Call) Return(err error) *MockSigningKeyRepo_UpdateMap_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_UpdateMap_Call) RunAndReturn(run func(ctx context.Context, id int64, req map[string]any) error) *MockSigningKeyRepo_UpdateMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMapBy provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) UpdateMapBy(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, req, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMapBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, req, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, req, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, map[string]any, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, req, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_UpdateMapBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMapBy'
+type MockSigningKeyRepo_UpdateMapBy_Call struct {
+	*mock.Call
+}
+
+// UpdateMapBy is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - expr
+func (_e *MockSigningKeyRepo_Expecter) UpdateMapBy(ctx interface{}, req interface{}, expr interface{}) *MockSigningKeyRepo_UpdateMapBy_Call {
+	return &MockSigningKeyRepo_UpdateMapBy_Call{Call: _e.mock.On("UpdateMapBy", ctx, req, expr)}
+}
+
+func (_c *MockSigningKeyRepo_UpdateMapBy_Call) Run(run func(ctx context.Context, req map[string]any, expr repo.Expression)) *MockSigningKeyRepo_UpdateMapBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]any), args[2].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_UpdateMapBy_Call) Return(n int64, err error) *MockSigningKeyRepo_UpdateMapBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_UpdateMapBy_Call) RunAndReturn(run func(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error)) *MockSigningKeyRepo_UpdateMapBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockSigningKeyRepo
+func (_mock *MockSigningKeyRepo) Upsert(ctx context.Context, req *model.SigningKey, target string) (bool, error) {
+	ret := _mock.Called(ctx, req, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.SigningKey, string) (bool, error)); ok {
+		return returnFunc(ctx, req, target)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.SigningKey, string) bool); ok {
+		r0 = returnFunc(ctx, req, target)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.SigningKey, string) error); ok {
+		r1 = returnFunc(ctx, req, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKeyRepo_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockSigningKeyRepo_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - target
+func (_e *MockSigningKeyRepo_Expecter) Upsert(ctx interface{}, req interface{}, target interface{}) *MockSigningKeyRepo_Upsert_Call {
+	return &MockSigningKeyRepo_Upsert_Call{Call: _e.mock.On("Upsert", ctx, req, target)}
+}
+
+func (_c *MockSigningKeyRepo_Upsert_Call) Run(run func(ctx context.Context, req *model.SigningKey, target string)) *MockSigningKeyRepo_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SigningKey), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Upsert_Call) Return(b bool, err error) *MockSigningKeyRepo_Upsert_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockSigningKeyRepo_Upsert_Call) RunAndReturn(run func(ctx context.Context, req *model.SigningKey, target string) (bool, error)) *MockSigningKeyRepo_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIdempotencyRepo creates a new instance of MockIdempotencyRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIdempotencyRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIdempotencyRepo {
+	mock := &MockIdempotencyRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIdempotencyRepo is an autogenerated mock type for the IdempotencyRepo type
+type MockIdempotencyRepo struct {
+	mock.Mock
+}
+
+type MockIdempotencyRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIdempotencyRepo) EXPECT() *MockIdempotencyRepo_Expecter {
+	return &MockIdempotencyRepo_Expecter{mock: &_m.Mock}
+}
+
+// Acquire provides a mock function for the type MockIdempotencyRepo
+func (_mock *MockIdempotencyRepo) Acquire(ctx context.Context, key string, fingerprint string, lease time.Duration, ttl time.Duration) (*idempotency.Record, bool, error) {
+	ret := _mock.Called(ctx, key, fingerprint, lease, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Acquire")
+	}
+
+	var r0 *idempotency.Record
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration, time.Duration) (*idempotency.Record, bool, error)); ok {
+		return returnFunc(ctx, key, fingerprint, lease, ttl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Duration, time.Duration) *idempotency.Record); ok {
+		r0 = returnFunc(ctx, key, fingerprint, lease, ttl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*idempotency.Record)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Duration, time.Duration) bool); ok {
+		r1 = returnFunc(ctx, key, fingerprint, lease, ttl)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, time.Duration, time.Duration) error); ok {
+		r2 = returnFunc(ctx, key, fingerprint, lease, ttl)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIdempotencyRepo_Acquire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Acquire'
+type MockIdempotencyRepo_Acquire_Call struct {
+	*mock.Call
+}
+
+// Acquire is a helper method to define mock.On call
+//   - ctx
+//   - key
+//   - fingerprint
+//   - lease
+//   - ttl
+func (_e *MockIdempotencyRepo_Expecter) Acquire(ctx interface{}, key interface{}, fingerprint interface{}, lease interface{}, ttl interface{}) *MockIdempotencyRepo_Acquire_Call {
+	return &MockIdempotencyRepo_Acquire_Call{Call: _e.mock.On("Acquire", ctx, key, fingerprint, lease, ttl)}
+}
+
+func (_c *MockIdempotencyRepo_Acquire_Call) Run(run func(ctx context.Context, key string, fingerprint string, lease time.Duration, ttl time.Duration)) *MockIdempotencyRepo_Acquire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration), args[4].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepo_Acquire_Call) Return(record *idempotency.Record, acquired bool, err error) *MockIdempotencyRepo_Acquire_Call {
+	_c.Call.Return(record, acquired, err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepo_Acquire_Call) RunAndReturn(run func(ctx context.Context, key string, fingerprint string, lease time.Duration, ttl time.Duration) (*idempotency.Record, bool, error)) *MockIdempotencyRepo_Acquire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Complete provides a mock function for the type MockIdempotencyRepo
+func (_mock *MockIdempotencyRepo) Complete(ctx context.Context, key string, record *idempotency.Record) error {
+	ret := _mock.Called(ctx, key, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *idempotency.Record) error); ok {
+		r0 = returnFunc(ctx, key, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyRepo_Complete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Complete'
+type MockIdempotencyRepo_Complete_Call struct {
+	*mock.Call
+}
+
+// Complete is a helper method to define mock.On call
+//   - ctx
+//   - key
+//   - record
+func (_e *MockIdempotencyRepo_Expecter) Complete(ctx interface{}, key interface{}, record interface{}) *MockIdempotencyRepo_Complete_Call {
+	return &MockIdempotencyRepo_Complete_Call{Call: _e.mock.On("Complete", ctx, key, record)}
+}
+
+func (_c *MockIdempotencyRepo_Complete_Call) Run(run func(ctx context.Context, key string, record *idempotency.Record)) *MockIdempotencyRepo_Complete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*idempotency.Record))
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepo_Complete_Call) Return(err error) *MockIdempotencyRepo_Complete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepo_Complete_Call) RunAndReturn(run func(ctx context.Context, key string, record *idempotency.Record) error) *MockIdempotencyRepo_Complete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpired provides a mock function for the type MockIdempotencyRepo
+func (_mock *MockIdempotencyRepo) DeleteExpired(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpired")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdempotencyRepo_DeleteExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpired'
+type MockIdempotencyRepo_DeleteExpired_Call struct {
+	*mock.Call
+}
+
+// DeleteExpired is a helper method to define mock.On call
+//   - ctx
+func (_e *MockIdempotencyRepo_Expecter) DeleteExpired(ctx interface{}) *MockIdempotencyRepo_DeleteExpired_Call {
+	return &MockIdempotencyRepo_DeleteExpired_Call{Call: _e.mock.On("DeleteExpired", ctx)}
+}
+
+func (_c *MockIdempotencyRepo_DeleteExpired_Call) Run(run func(ctx context.Context)) *MockIdempotencyRepo_DeleteExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepo_DeleteExpired_Call) Return(n int64, err error) *MockIdempotencyRepo_DeleteExpired_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepo_DeleteExpired_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *MockIdempotencyRepo_DeleteExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function for the type MockIdempotencyRepo
+func (_mock *MockIdempotencyRepo) Release(ctx context.Context, key string, record *idempotency.Record) error {
+	ret := _mock.Called(ctx, key, record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *idempotency.Record) error); ok {
+		r0 = returnFunc(ctx, key, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdempotencyRepo_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type MockIdempotencyRepo_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx
+//   - key
+//   - record
+func (_e *MockIdempotencyRepo_Expecter) Release(ctx interface{}, key interface{}, record interface{}) *MockIdempotencyRepo_Release_Call {
+	return &MockIdempotencyRepo_Release_Call{Call: _e.mock.On("Release", ctx, key, record)}
+}
+
+func (_c *MockIdempotencyRepo_Release_Call) Run(run func(ctx context.Context, key string, record *idempotency.Record)) *MockIdempotencyRepo_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*idempotency.Record))
+	})
+	return _c
+}
+
+func (_c *MockIdempotencyRepo_Release_Call) Return(err error) *MockIdempotencyRepo_Release_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdempotencyRepo_Release_Call) RunAndReturn(run func(ctx context.Context, key string, record *idempotency.Record) error) *MockIdempotencyRepo_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProfileRepo creates a new instance of MockProfileRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProfileRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProfileRepo {
+	mock := &MockProfileRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProfileRepo is an autogenerated mock type for the ProfileRepo type
+type MockProfileRepo struct {
+	mock.Mock
+}
+
+type MockProfileRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProfileRepo) EXPECT() *MockProfileRepo_Expecter {
+	return &MockProfileRepo_Expecter{mock: &_m.Mock}
+}
+
+// CountBy provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) CountBy(ctx context.Context, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_CountBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBy'
+type MockProfileRepo_CountBy_Call struct {
+	*mock.Call
+}
+
+// CountBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockProfileRepo_Expecter) CountBy(ctx interface{}, expr interface{}) *MockProfileRepo_CountBy_Call {
+	return &MockProfileRepo_CountBy_Call{Call: _e.mock.On("CountBy", ctx, expr)}
+}
+
+func (_c *MockProfileRepo_CountBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockProfileRepo_CountBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_CountBy_Call) Return(n int64, err error) *MockProfileRepo_CountBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_CountBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (int64, error)) *MockProfileRepo_CountBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockProfileRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockProfileRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockProfileRepo_Delete_Call {
+	return &MockProfileRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockProfileRepo_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockProfileRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_Delete_Call) Return(err error) *MockProfileRepo_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockProfileRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBy provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) DeleteBy(ctx context.Context, expr repo.Ex) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Ex) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_DeleteBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBy'
+type MockProfileRepo_DeleteBy_Call struct {
+	*mock.Call
+}
+
+// DeleteBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockProfileRepo_Expecter) DeleteBy(ctx interface{}, expr interface{}) *MockProfileRepo_DeleteBy_Call {
+	return &MockProfileRepo_DeleteBy_Call{Call: _e.mock.On("DeleteBy", ctx, expr)}
+}
+
+func (_c *MockProfileRepo_DeleteBy_Call) Run(run func(ctx context.Context, expr repo.Ex)) *MockProfileRepo_DeleteBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Ex))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_DeleteBy_Call) Return(n int64, err error) *MockProfileRepo_DeleteBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_DeleteBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Ex) (int64, error)) *MockProfileRepo_DeleteBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) Exists(ctx context.Context, expr repo.Expression) (bool, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (bool, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) bool); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockProfileRepo_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockProfileRepo_Expecter) Exists(ctx interface{}, expr interface{}) *MockProfileRepo_Exists_Call {
+	return &MockProfileRepo_Exists_Call{Call: _e.mock.On("Exists", ctx, expr)}
+}
+
+func (_c *MockProfileRepo_Exists_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockProfileRepo_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_Exists_Call) Return(b bool, err error) *MockProfileRepo_Exists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_Exists_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (bool, error)) *MockProfileRepo_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) Find(ctx context.Context, dest *model.Profile, id int64) error {
+	ret := _mock.Called(ctx, dest, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Profile, int64) error); ok {
+		r0 = returnFunc(ctx, dest, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileRepo_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockProfileRepo_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx
+//   - dest
+//   - id
+func (_e *MockProfileRepo_Expecter) Find(ctx interface{}, dest interface{}, id interface{}) *MockProfileRepo_Find_Call {
+	return &MockProfileRepo_Find_Call{Call: _e.mock.On("Find", ctx, dest, id)}
+}
+
+func (_c *MockProfileRepo_Find_Call) Run(run func(ctx context.Context, dest *model.Profile, id int64)) *MockProfileRepo_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Profile), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_Find_Call) Return(err error) *MockProfileRepo_Find_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileRepo_Find_Call) RunAndReturn(run func(ctx context.Context, dest *model.Profile, id int64) error) *MockProfileRepo_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// First provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) First(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.Profile, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for First")
+	}
+
+	var r0 *model.Profile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.Profile, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.Profile); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Profile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_First_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'First'
+type MockProfileRepo_First_Call struct {
+	*mock.Call
+}
+
+// First is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockProfileRepo_Expecter) First(ctx interface{}, expr interface{}, order ...interface{}) *MockProfileRepo_First_Call {
+	return &MockProfileRepo_First_Call{Call: _e.mock.On("First",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockProfileRepo_First_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockProfileRepo_First_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_First_Call) Return(profile *model.Profile, err error) *MockProfileRepo_First_Call {
+	_c.Call.Return(profile, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_First_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.Profile, error)) *MockProfileRepo_First_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) Get(ctx context.Context, id int64) (*model.Profile, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.Profile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.Profile, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.Profile); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Profile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockProfileRepo_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockProfileRepo_Expecter) Get(ctx interface{}, id interface{}) *MockProfileRepo_Get_Call {
+	return &MockProfileRepo_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *MockProfileRepo_Get_Call) Run(run func(ctx context.Context, id int64)) *MockProfileRepo_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_Get_Call) Return(profile *model.Profile, err error) *MockProfileRepo_Get_Call {
+	_c.Call.Return(profile, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_Get_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.Profile, error)) *MockProfileRepo_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBy provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) GetBy(ctx context.Context, expr repo.Expression) (*model.Profile, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBy")
+	}
+
+	var r0 *model.Profile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (*model.Profile, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) *model.Profile); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Profile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_GetBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBy'
+type MockProfileRepo_GetBy_Call struct {
+	*mock.Call
+}
+
+// GetBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockProfileRepo_Expecter) GetBy(ctx interface{}, expr interface{}) *MockProfileRepo_GetBy_Call {
+	return &MockProfileRepo_GetBy_Call{Call: _e.mock.On("GetBy", ctx, expr)}
+}
+
+func (_c *MockProfileRepo_GetBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockProfileRepo_GetBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_GetBy_Call) Return(profile *model.Profile, err error) *MockProfileRepo_GetBy_Call {
+	_c.Call.Return(profile, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_GetBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (*model.Profile, error)) *MockProfileRepo_GetBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByEmail provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) GetByEmail(ctx context.Context, email string) (*model.Profile, error) {
+	ret := _mock.Called(ctx, email)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByEmail")
+	}
+
+	var r0 *model.Profile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.Profile, error)); ok {
+		return returnFunc(ctx, email)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.Profile); ok {
+		r0 = returnFunc(ctx, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Profile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_GetByEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByEmail'
+type MockProfileRepo_GetByEmail_Call struct {
+	*mock.Call
+}
+
+// GetByEmail is a helper method to define mock.On call
+//   - ctx
+//   - email
+func (_e *MockProfileRepo_Expecter) GetByEmail(ctx interface{}, email interface{}) *MockProfileRepo_GetByEmail_Call {
+	return &MockProfileRepo_GetByEmail_Call{Call: _e.mock.On("GetByEmail", ctx, email)}
+}
+
+func (_c *MockProfileRepo_GetByEmail_Call) Run(run func(ctx context.Context, email string)) *MockProfileRepo_GetByEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_GetByEmail_Call) Return(profile *model.Profile, err error) *MockProfileRepo_GetByEmail_Call {
+	_c.Call.Return(profile, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_GetByEmail_Call) RunAndReturn(run func(ctx context.Context, email string) (*model.Profile, error)) *MockProfileRepo_GetByEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForUpdate provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) GetForUpdate(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.Profile, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUpdate")
+	}
+
+	var r0 *model.Profile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.Profile, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.Profile); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Profile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_GetForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUpdate'
+type MockProfileRepo_GetForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetForUpdate is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockProfileRepo_Expecter) GetForUpdate(ctx interface{}, expr interface{}, order ...interface{}) *MockProfileRepo_GetForUpdate_Call {
+	return &MockProfileRepo_GetForUpdate_Call{Call: _e.mock.On("GetForUpdate",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockProfileRepo_GetForUpdate_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockProfileRepo_GetForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_GetForUpdate_Call) Return(profile *model.Profile, err error) *MockProfileRepo_GetForUpdate_Call {
+	_c.Call.Return(profile, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_GetForUpdate_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.Profile, error)) *MockProfileRepo_GetForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) Insert(ctx context.Context, req *model.Profile) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Profile) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileRepo_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockProfileRepo_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockProfileRepo_Expecter) Insert(ctx interface{}, req interface{}) *MockProfileRepo_Insert_Call {
+	return &MockProfileRepo_Insert_Call{Call: _e.mock.On("Insert", ctx, req)}
+}
+
+func (_c *MockProfileRepo_Insert_Call) Run(run func(ctx context.Context, req *model.Profile)) *MockProfileRepo_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Profile))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_Insert_Call) Return(err error) *MockProfileRepo_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileRepo_Insert_Call) RunAndReturn(run func(ctx context.Context, req *model.Profile) error) *MockProfileRepo_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) List(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.Profile], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, opts)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *response.ListResponse[*model.Profile]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) (*response.ListResponse[*model.Profile], error)); ok {
+		return returnFunc(ctx, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) *response.ListResponse[*model.Profile]); ok {
+		r0 = returnFunc(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.Profile])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockProfileRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - opts
+func (_e *MockProfileRepo_Expecter) List(ctx interface{}, opts ...interface{}) *MockProfileRepo_List_Call {
+	return &MockProfileRepo_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx}, opts...)...)}
+}
+
+func (_c *MockProfileRepo_List_Call) Run(run func(ctx context.Context, opts ...clause.FilterOption)) *MockProfileRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[1].([]clause.FilterOption)
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_List_Call) Return(listResponse *response.ListResponse[*model.Profile], err error) *MockProfileRepo_List_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_List_Call) RunAndReturn(run func(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.Profile], error)) *MockProfileRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBy provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) ListBy(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.Profile], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBy")
+	}
+
+	var r0 *response.ListResponse[*model.Profile]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) (*response.ListResponse[*model.Profile], error)); ok {
+		return returnFunc(ctx, expr, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) *response.ListResponse[*model.Profile]); ok {
+		r0 = returnFunc(ctx, expr, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.Profile])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, expr, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_ListBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBy'
+type MockProfileRepo_ListBy_Call struct {
+	*mock.Call
+}
+
+// ListBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - opts
+func (_e *MockProfileRepo_Expecter) ListBy(ctx interface{}, expr interface{}, opts ...interface{}) *MockProfileRepo_ListBy_Call {
+	return &MockProfileRepo_ListBy_Call{Call: _e.mock.On("ListBy",
+		append([]interface{}{ctx, expr}, opts...)...)}
+}
+
+func (_c *MockProfileRepo_ListBy_Call) Run(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption)) *MockProfileRepo_ListBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_ListBy_Call) Return(listResponse *response.ListResponse[*model.Profile], err error) *MockProfileRepo_ListBy_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_ListBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.Profile], error)) *MockProfileRepo_ListBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByEach provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) ListByEach(ctx context.Context, expr repo.Expression, fn func(item *model.Profile) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, func(item *model.Profile) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, expr, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileRepo_ListByEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEach'
+type MockProfileRepo_ListByEach_Call struct {
+	*mock.Call
+}
+
+// ListByEach is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - fn
+//   - opts
+func (_e *MockProfileRepo_Expecter) ListByEach(ctx interface{}, expr interface{}, fn interface{}, opts ...interface{}) *MockProfileRepo_ListByEach_Call {
+	return &MockProfileRepo_ListByEach_Call{Call: _e.mock.On("ListByEach",
+		append([]interface{}{ctx, expr, fn}, opts...)...)}
+}
+
+func (_c *MockProfileRepo_ListByEach_Call) Run(run func(ctx context.Context, expr repo.Expression, fn func(item *model.Profile) error, opts ...clause.FilterOption)) *MockProfileRepo_ListByEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[3].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), args[2].(func(item *model.Profile) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_ListByEach_Call) Return(err error) *MockProfileRepo_ListByEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileRepo_ListByEach_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, fn func(item *model.Profile) error, opts ...clause.FilterOption) error) *MockProfileRepo_ListByEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIDs provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) ListByIDs(ctx context.Context, ids []int64) (*response.ListResponse[*model.Profile], error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIDs")
+	}
+
+	var r0 *response.ListResponse[*model.Profile]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) (*response.ListResponse[*model.Profile], error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) *response.ListResponse[*model.Profile]); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.Profile])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_ListByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIDs'
+type MockProfileRepo_ListByIDs_Call struct {
+	*mock.Call
+}
+
+// ListByIDs is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *MockProfileRepo_Expecter) ListByIDs(ctx interface{}, ids interface{}) *MockProfileRepo_ListByIDs_Call {
+	return &MockProfileRepo_ListByIDs_Call{Call: _e.mock.On("ListByIDs", ctx, ids)}
+}
+
+func (_c *MockProfileRepo_ListByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockProfileRepo_ListByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_ListByIDs_Call) Return(listResponse *response.ListResponse[*model.Profile], err error) *MockProfileRepo_ListByIDs_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_ListByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []int64) (*response.ListResponse[*model.Profile], error)) *MockProfileRepo_ListByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEach provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) ListEach(ctx context.Context, fn func(item *model.Profile) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(item *model.Profile) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileRepo_ListEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEach'
+type MockProfileRepo_ListEach_Call struct {
+	*mock.Call
+}
+
+// ListEach is a helper method to define mock.On call
+//   - ctx
+//   - fn
+//   - opts
+func (_e *MockProfileRepo_Expecter) ListEach(ctx interface{}, fn interface{}, opts ...interface{}) *MockProfileRepo_ListEach_Call {
+	return &MockProfileRepo_ListEach_Call{Call: _e.mock.On("ListEach",
+		append([]interface{}{ctx, fn}, opts...)...)}
+}
+
+func (_c *MockProfileRepo_ListEach_Call) Run(run func(ctx context.Context, fn func(item *model.Profile) error, opts ...clause.FilterOption)) *MockProfileRepo_ListEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(func(item *model.Profile) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_ListEach_Call) Return(err error) *MockProfileRepo_ListEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileRepo_ListEach_Call) RunAndReturn(run func(ctx context.Context, fn func(item *model.Profile) error, opts ...clause.FilterOption) error) *MockProfileRepo_ListEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) Update(ctx context.Context, req *model.Profile) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Profile) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProfileRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockProfileRepo_Expecter) Update(ctx interface{}, req interface{}) *MockProfileRepo_Update_Call {
+	return &MockProfileRepo_Update_Call{Call: _e.mock.On("Update", ctx, req)}
+}
+
+func (_c *MockProfileRepo_Update_Call) Run(run func(ctx context.Context, req *model.Profile)) *MockProfileRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Profile))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_Update_Call) Return(err error) *MockProfileRepo_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileRepo_Update_Call) RunAndReturn(run func(ctx context.Context, req *model.Profile) error) *MockProfileRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMap provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) UpdateMap(ctx context.Context, id int64, req map[string]any) error {
+	ret := _mock.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMap")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, map[string]any) error); ok {
+		r0 = returnFunc(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileRepo_UpdateMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMap'
+type MockProfileRepo_UpdateMap_Call struct {
+	*mock.Call
+}
+
+// UpdateMap is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - req
+func (_e *MockProfileRepo_Expecter) UpdateMap(ctx interface{}, id interface{}, req interface{}) *MockProfileRepo_UpdateMap_Call {
+	return &MockProfileRepo_UpdateMap_Call{Call: _e.mock.On("UpdateMap", ctx, id, req)}
+}
+
+func (_c *MockProfileRepo_UpdateMap_Call) Run(run func(ctx context.Context, id int64, req map[string]any)) *MockProfileRepo_UpdateMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(map[string]any))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_UpdateMap_Call) Return(err error) *MockProfileRepo_UpdateMap_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileRepo_UpdateMap_Call) RunAndReturn(run func(ctx context.Context, id int64, req map[string]any) error) *MockProfileRepo_UpdateMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMapBy provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) UpdateMapBy(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, req, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMapBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, req, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, req, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, map[string]any, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, req, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_UpdateMapBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMapBy'
+type MockProfileRepo_UpdateMapBy_Call struct {
+	*mock.Call
+}
+
+// UpdateMapBy is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - expr
+func (_e *MockProfileRepo_Expecter) UpdateMapBy(ctx interface{}, req interface{}, expr interface{}) *MockProfileRepo_UpdateMapBy_Call {
+	return &MockProfileRepo_UpdateMapBy_Call{Call: _e.mock.On("UpdateMapBy", ctx, req, expr)}
+}
+
+func (_c *MockProfileRepo_UpdateMapBy_Call) Run(run func(ctx context.Context, req map[string]any, expr repo.Expression)) *MockProfileRepo_UpdateMapBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]any), args[2].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_UpdateMapBy_Call) Return(n int64, err error) *MockProfileRepo_UpdateMapBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_UpdateMapBy_Call) RunAndReturn(run func(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error)) *MockProfileRepo_UpdateMapBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockProfileRepo
+func (_mock *MockProfileRepo) Upsert(ctx context.Context, req *model.Profile, target string) (bool, error) {
+	ret := _mock.Called(ctx, req, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Profile, string) (bool, error)); ok {
+		return returnFunc(ctx, req, target)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Profile, string) bool); ok {
+		r0 = returnFunc(ctx, req, target)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.Profile, string) error); ok {
+		r1 = returnFunc(ctx, req, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileRepo_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockProfileRepo_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - target
+func (_e *MockProfileRepo_Expecter) Upsert(ctx interface{}, req interface{}, target interface{}) *MockProfileRepo_Upsert_Call {
+	return &MockProfileRepo_Upsert_Call{Call: _e.mock.On("Upsert", ctx, req, target)}
+}
+
+func (_c *MockProfileRepo_Upsert_Call) Run(run func(ctx context.Context, req *model.Profile, target string)) *MockProfileRepo_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Profile), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProfileRepo_Upsert_Call) Return(b bool, err error) *MockProfileRepo_Upsert_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockProfileRepo_Upsert_Call) RunAndReturn(run func(ctx context.Context, req *model.Profile, target string) (bool, error)) *MockProfileRepo_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserRepo creates a new instance of MockUserRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserRepo {
+	mock := &MockUserRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUserRepo is an autogenerated mock type for the UserRepo type
+type MockUserRepo struct {
+	mock.Mock
+}
+
+type MockUserRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserRepo) EXPECT() *MockUserRepo_Expecter {
+	return &MockUserRepo_Expecter{mock: &_m.Mock}
+}
+
+// CountBy provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) CountBy(ctx context.Context, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_CountBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBy'
+type MockUserRepo_CountBy_Call struct {
+	*mock.Call
+}
+
+// CountBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockUserRepo_Expecter) CountBy(ctx interface{}, expr interface{}) *MockUserRepo_CountBy_Call {
+	return &MockUserRepo_CountBy_Call{Call: _e.mock.On("CountBy", ctx, expr)}
+}
+
+func (_c *MockUserRepo_CountBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockUserRepo_CountBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_CountBy_Call) Return(n int64, err error) *MockUserRepo_CountBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockUserRepo_CountBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (int64, error)) *MockUserRepo_CountBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockUserRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockUserRepo_Delete_Call {
+	return &MockUserRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockUserRepo_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockUserRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_Delete_Call) Return(err error) *MockUserRepo_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockUserRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBy provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) DeleteBy(ctx context.Context, expr repo.Ex) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Ex) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_DeleteBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBy'
+type MockUserRepo_DeleteBy_Call struct {
+	*mock.Call
+}
+
+// DeleteBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockUserRepo_Expecter) DeleteBy(ctx interface{}, expr interface{}) *MockUserRepo_DeleteBy_Call {
+	return &MockUserRepo_DeleteBy_Call{Call: _e.mock.On("DeleteBy", ctx, expr)}
+}
+
+func (_c *MockUserRepo_DeleteBy_Call) Run(run func(ctx context.Context, expr repo.Ex)) *MockUserRepo_DeleteBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Ex))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_DeleteBy_Call) Return(n int64, err error) *MockUserRepo_DeleteBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockUserRepo_DeleteBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Ex) (int64, error)) *MockUserRepo_DeleteBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) Exists(ctx context.Context, expr repo.Expression) (bool, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (bool, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) bool); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockUserRepo_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockUserRepo_Expecter) Exists(ctx interface{}, expr interface{}) *MockUserRepo_Exists_Call {
+	return &MockUserRepo_Exists_Call{Call: _e.mock.On("Exists", ctx, expr)}
+}
+
+func (_c *MockUserRepo_Exists_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockUserRepo_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_Exists_Call) Return(b bool, err error) *MockUserRepo_Exists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserRepo_Exists_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (bool, error)) *MockUserRepo_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) Find(ctx context.Context, dest *model.User, id int64) error {
+	ret := _mock.Called(ctx, dest, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User, int64) error); ok {
+		r0 = returnFunc(ctx, dest, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockUserRepo_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx
+//   - dest
+//   - id
+func (_e *MockUserRepo_Expecter) Find(ctx interface{}, dest interface{}, id interface{}) *MockUserRepo_Find_Call {
+	return &MockUserRepo_Find_Call{Call: _e.mock.On("Find", ctx, dest, id)}
+}
+
+func (_c *MockUserRepo_Find_Call) Run(run func(ctx context.Context, dest *model.User, id int64)) *MockUserRepo_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.User), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_Find_Call) Return(err error) *MockUserRepo_Find_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_Find_Call) RunAndReturn(run func(ctx context.Context, dest *model.User, id int64) error) *MockUserRepo_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// First provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) First(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.User, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for First")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.User, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.User); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_First_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'First'
+type MockUserRepo_First_Call struct {
+	*mock.Call
+}
+
+// First is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockUserRepo_Expecter) First(ctx interface{}, expr interface{}, order ...interface{}) *MockUserRepo_First_Call {
+	return &MockUserRepo_First_Call{Call: _e.mock.On("First",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockUserRepo_First_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockUserRepo_First_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_First_Call) Return(user *model.User, err error) *MockUserRepo_First_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepo_First_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.User, error)) *MockUserRepo_First_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) Get(ctx context.Context, id int64) (*model.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockUserRepo_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockUserRepo_Expecter) Get(ctx interface{}, id interface{}) *MockUserRepo_Get_Call {
+	return &MockUserRepo_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *MockUserRepo_Get_Call) Run(run func(ctx context.Context, id int64)) *MockUserRepo_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_Get_Call) Return(user *model.User, err error) *MockUserRepo_Get_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepo_Get_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.User, error)) *MockUserRepo_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBy provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) GetBy(ctx context.Context, expr repo.Expression) (*model.User, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBy")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (*model.User, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) *model.User); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_GetBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBy'
+type MockUserRepo_GetBy_Call struct {
+	*mock.Call
+}
+
+// GetBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockUserRepo_Expecter) GetBy(ctx interface{}, expr interface{}) *MockUserRepo_GetBy_Call {
+	return &MockUserRepo_GetBy_Call{Call: _e.mock.On("GetBy", ctx, expr)}
+}
+
+func (_c *MockUserRepo_GetBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockUserRepo_GetBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_GetBy_Call) Return(user *model.User, err error) *MockUserRepo_GetBy_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepo_GetBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (*model.User, error)) *MockUserRepo_GetBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUsername provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) GetByUsername(ctx context.Context, username string) (*model.User, error) {
+	ret := _mock.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUsername")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*model.User, error)); ok {
+		return returnFunc(ctx, username)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *model.User); ok {
+		r0 = returnFunc(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_GetByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUsername'
+type MockUserRepo_GetByUsername_Call struct {
+	*mock.Call
+}
+
+// GetByUsername is a helper method to define mock.On call
+//   - ctx
+//   - username
+func (_e *MockUserRepo_Expecter) GetByUsername(ctx interface{}, username interface{}) *MockUserRepo_GetByUsername_Call {
+	return &MockUserRepo_GetByUsername_Call{Call: _e.mock.On("GetByUsername", ctx, username)}
+}
+
+func (_c *MockUserRepo_GetByUsername_Call) Run(run func(ctx context.Context, username string)) *MockUserRepo_GetByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_GetByUsername_Call) Return(user *model.User, err error) *MockUserRepo_GetByUsername_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepo_GetByUsername_Call) RunAndReturn(run func(ctx context.Context, username string) (*model.User, error)) *MockUserRepo_GetByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForUpdate provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) GetForUpdate(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.User, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUpdate")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.User, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.User); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_GetForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUpdate'
+type MockUserRepo_GetForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetForUpdate is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockUserRepo_Expecter) GetForUpdate(ctx interface{}, expr interface{}, order ...interface{}) *MockUserRepo_GetForUpdate_Call {
+	return &MockUserRepo_GetForUpdate_Call{Call: _e.mock.On("GetForUpdate",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockUserRepo_GetForUpdate_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockUserRepo_GetForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_GetForUpdate_Call) Return(user *model.User, err error) *MockUserRepo_GetForUpdate_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUserRepo_GetForUpdate_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.User, error)) *MockUserRepo_GetForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) Insert(ctx context.Context, req *model.User) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockUserRepo_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockUserRepo_Expecter) Insert(ctx interface{}, req interface{}) *MockUserRepo_Insert_Call {
+	return &MockUserRepo_Insert_Call{Call: _e.mock.On("Insert", ctx, req)}
+}
+
+func (_c *MockUserRepo_Insert_Call) Run(run func(ctx context.Context, req *model.User)) *MockUserRepo_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.User))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_Insert_Call) Return(err error) *MockUserRepo_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_Insert_Call) RunAndReturn(run func(ctx context.Context, req *model.User) error) *MockUserRepo_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) List(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.User], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, opts)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *response.ListResponse[*model.User]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) (*response.ListResponse[*model.User], error)); ok {
+		return returnFunc(ctx, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) *response.ListResponse[*model.User]); ok {
+		r0 = returnFunc(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.User])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockUserRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - opts
+func (_e *MockUserRepo_Expecter) List(ctx interface{}, opts ...interface{}) *MockUserRepo_List_Call {
+	return &MockUserRepo_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx}, opts...)...)}
+}
+
+func (_c *MockUserRepo_List_Call) Run(run func(ctx context.Context, opts ...clause.FilterOption)) *MockUserRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[1].([]clause.FilterOption)
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_List_Call) Return(listResponse *response.ListResponse[*model.User], err error) *MockUserRepo_List_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockUserRepo_List_Call) RunAndReturn(run func(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.User], error)) *MockUserRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBy provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) ListBy(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.User], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBy")
+	}
+
+	var r0 *response.ListResponse[*model.User]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) (*response.ListResponse[*model.User], error)); ok {
+		return returnFunc(ctx, expr, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) *response.ListResponse[*model.User]); ok {
+		r0 = returnFunc(ctx, expr, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.User])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, expr, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_ListBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBy'
+type MockUserRepo_ListBy_Call struct {
+	*mock.Call
+}
+
+// ListBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - opts
+func (_e *MockUserRepo_Expecter) ListBy(ctx interface{}, expr interface{}, opts ...interface{}) *MockUserRepo_ListBy_Call {
+	return &MockUserRepo_ListBy_Call{Call: _e.mock.On("ListBy",
+		append([]interface{}{ctx, expr}, opts...)...)}
+}
+
+func (_c *MockUserRepo_ListBy_Call) Run(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption)) *MockUserRepo_ListBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_ListBy_Call) Return(listResponse *response.ListResponse[*model.User], err error) *MockUserRepo_ListBy_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockUserRepo_ListBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.User], error)) *MockUserRepo_ListBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByEach provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) ListByEach(ctx context.Context, expr repo.Expression, fn func(item *model.User) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, func(item *model.User) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, expr, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_ListByEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEach'
+type MockUserRepo_ListByEach_Call struct {
+	*mock.Call
+}
+
+// ListByEach is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - fn
+//   - opts
+func (_e *MockUserRepo_Expecter) ListByEach(ctx interface{}, expr interface{}, fn interface{}, opts ...interface{}) *MockUserRepo_ListByEach_Call {
+	return &MockUserRepo_ListByEach_Call{Call: _e.mock.On("ListByEach",
+		append([]interface{}{ctx, expr, fn}, opts...)...)}
+}
+
+func (_c *MockUserRepo_ListByEach_Call) Run(run func(ctx context.Context, expr repo.Expression, fn func(item *model.User) error, opts ...clause.FilterOption)) *MockUserRepo_ListByEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[3].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), args[2].(func(item *model.User) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_ListByEach_Call) Return(err error) *MockUserRepo_ListByEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_ListByEach_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, fn func(item *model.User) error, opts ...clause.FilterOption) error) *MockUserRepo_ListByEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIDs provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) ListByIDs(ctx context.Context, ids []int64) (*response.ListResponse[*model.User], error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIDs")
+	}
+
+	var r0 *response.ListResponse[*model.User]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) (*response.ListResponse[*model.User], error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) *response.ListResponse[*model.User]); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.User])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_ListByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIDs'
+type MockUserRepo_ListByIDs_Call struct {
+	*mock.Call
+}
+
+// ListByIDs is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *MockUserRepo_Expecter) ListByIDs(ctx interface{}, ids interface{}) *MockUserRepo_ListByIDs_Call {
+	return &MockUserRepo_ListByIDs_Call{Call: _e.mock.On("ListByIDs", ctx, ids)}
+}
+
+func (_c *MockUserRepo_ListByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockUserRepo_ListByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_ListByIDs_Call) Return(listResponse *response.ListResponse[*model.User], err error) *MockUserRepo_ListByIDs_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockUserRepo_ListByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []int64) (*response.ListResponse[*model.User], error)) *MockUserRepo_ListByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEach provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) ListEach(ctx context.Context, fn func(item *model.User) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(item *model.User) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_ListEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEach'
+type MockUserRepo_ListEach_Call struct {
+	*mock.Call
+}
+
+// ListEach is a helper method to define mock.On call
+//   - ctx
+//   - fn
+//   - opts
+func (_e *MockUserRepo_Expecter) ListEach(ctx interface{}, fn interface{}, opts ...interface{}) *MockUserRepo_ListEach_Call {
+	return &MockUserRepo_ListEach_Call{Call: _e.mock.On("ListEach",
+		append([]interface{}{ctx, fn}, opts...)...)}
+}
+
+func (_c *MockUserRepo_ListEach_Call) Run(run func(ctx context.Context, fn func(item *model.User) error, opts ...clause.FilterOption)) *MockUserRepo_ListEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(func(item *model.User) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_ListEach_Call) Return(err error) *MockUserRepo_ListEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_ListEach_Call) RunAndReturn(run func(ctx context.Context, fn func(item *model.User) error, opts ...clause.FilterOption) error) *MockUserRepo_ListEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) Update(ctx context.Context, req *model.User) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockUserRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockUserRepo_Expecter) Update(ctx interface{}, req interface{}) *MockUserRepo_Update_Call {
+	return &MockUserRepo_Update_Call{Call: _e.mock.On("Update", ctx, req)}
+}
+
+func (_c *MockUserRepo_Update_Call) Run(run func(ctx context.Context, req *model.User)) *MockUserRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.User))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_Update_Call) Return(err error) *MockUserRepo_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_Update_Call) RunAndReturn(run func(ctx context.Context, req *model.User) error) *MockUserRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMap provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) UpdateMap(ctx context.Context, id int64, req map[string]any) error {
+	ret := _mock.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMap")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, map[string]any) error); ok {
+		r0 = returnFunc(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUserRepo_UpdateMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMap'
+type MockUserRepo_UpdateMap_Call struct {
+	*mock.Call
+}
+
+// UpdateMap is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - req
+func (_e *MockUserRepo_Expecter) UpdateMap(ctx interface{}, id interface{}, req interface{}) *MockUserRepo_UpdateMap_Call {
+	return &MockUserRepo_UpdateMap_Call{Call: _e.mock.On("UpdateMap", ctx, id, req)}
+}
+
+func (_c *MockUserRepo_UpdateMap_Call) Run(run func(ctx context.Context, id int64, req map[string]any)) *MockUserRepo_UpdateMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(map[string]any))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_UpdateMap_Call) Return(err error) *MockUserRepo_UpdateMap_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUserRepo_UpdateMap_Call) RunAndReturn(run func(ctx context.Context, id int64, req map[string]any) error) *MockUserRepo_UpdateMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMapBy provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) UpdateMapBy(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, req, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMapBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, req, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, req, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, map[string]any, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, req, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_UpdateMapBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMapBy'
+type MockUserRepo_UpdateMapBy_Call struct {
+	*mock.Call
+}
+
+// UpdateMapBy is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - expr
+func (_e *MockUserRepo_Expecter) UpdateMapBy(ctx interface{}, req interface{}, expr interface{}) *MockUserRepo_UpdateMapBy_Call {
+	return &MockUserRepo_UpdateMapBy_Call{Call: _e.mock.On("UpdateMapBy", ctx, req, expr)}
+}
+
+func (_c *MockUserRepo_UpdateMapBy_Call) Run(run func(ctx context.Context, req map[string]any, expr repo.Expression)) *MockUserRepo_UpdateMapBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]any), args[2].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_UpdateMapBy_Call) Return(n int64, err error) *MockUserRepo_UpdateMapBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockUserRepo_UpdateMapBy_Call) RunAndReturn(run func(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error)) *MockUserRepo_UpdateMapBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockUserRepo
+func (_mock *MockUserRepo) Upsert(ctx context.Context, req *model.User, target string) (bool, error) {
+	ret := _mock.Called(ctx, req, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User, string) (bool, error)); ok {
+		return returnFunc(ctx, req, target)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.User, string) bool); ok {
+		r0 = returnFunc(ctx, req, target)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.User, string) error); ok {
+		r1 = returnFunc(ctx, req, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUserRepo_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockUserRepo_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - target
+func (_e *MockUserRepo_Expecter) Upsert(ctx interface{}, req interface{}, target interface{}) *MockUserRepo_Upsert_Call {
+	return &MockUserRepo_Upsert_Call{Call: _e.mock.On("Upsert", ctx, req, target)}
+}
+
+func (_c *MockUserRepo_Upsert_Call) Run(run func(ctx context.Context, req *model.User, target string)) *MockUserRepo_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.User), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserRepo_Upsert_Call) Return(b bool, err error) *MockUserRepo_Upsert_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockUserRepo_Upsert_Call) RunAndReturn(run func(ctx context.Context, req *model.User, target string) (bool, error)) *MockUserRepo_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockProfileImportRepo creates a new instance of MockProfileImportRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProfileImportRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProfileImportRepo {
+	mock := &MockProfileImportRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProfileImportRepo is an autogenerated mock type for the ProfileImportRepo type
+type MockProfileImportRepo struct {
+	mock.Mock
+}
+
+type MockProfileImportRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProfileImportRepo) EXPECT() *MockProfileImportRepo_Expecter {
+	return &MockProfileImportRepo_Expecter{mock: &_m.Mock}
+}
+
+// AddErrors provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) AddErrors(ctx context.Context, errs []*model.ProfileImportError) error {
+	ret := _mock.Called(ctx, errs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddErrors")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*model.ProfileImportError) error); ok {
+		r0 = returnFunc(ctx, errs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileImportRepo_AddErrors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddErrors'
+type MockProfileImportRepo_AddErrors_Call struct {
+	*mock.Call
+}
+
+// AddErrors is a helper method to define mock.On call
+//   - ctx
+//   - errs
+func (_e *MockProfileImportRepo_Expecter) AddErrors(ctx interface{}, errs interface{}) *MockProfileImportRepo_AddErrors_Call {
+	return &MockProfileImportRepo_AddErrors_Call{Call: _e.mock.On("AddErrors", ctx, errs)}
+}
+
+func (_c *MockProfileImportRepo_AddErrors_Call) Run(run func(ctx context.Context, errs []*model.ProfileImportError)) *MockProfileImportRepo_AddErrors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*model.ProfileImportError))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_AddErrors_Call) Return(err error) *MockProfileImportRepo_AddErrors_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_AddErrors_Call) RunAndReturn(run func(ctx context.Context, errs []*model.ProfileImportError) error) *MockProfileImportRepo_AddErrors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountBy provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) CountBy(ctx context.Context, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_CountBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBy'
+type MockProfileImportRepo_CountBy_Call struct {
+	*mock.Call
+}
+
+// CountBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockProfileImportRepo_Expecter) CountBy(ctx interface{}, expr interface{}) *MockProfileImportRepo_CountBy_Call {
+	return &MockProfileImportRepo_CountBy_Call{Call: _e.mock.On("CountBy", ctx, expr)}
+}
+
+func (_c *MockProfileImportRepo_CountBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockProfileImportRepo_CountBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_CountBy_Call) Return(n int64, err error) *MockProfileImportRepo_CountBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_CountBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (int64, error)) *MockProfileImportRepo_CountBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) Delete(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileImportRepo_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockProfileImportRepo_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockProfileImportRepo_Expecter) Delete(ctx interface{}, id interface{}) *MockProfileImportRepo_Delete_Call {
+	return &MockProfileImportRepo_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *MockProfileImportRepo_Delete_Call) Run(run func(ctx context.Context, id int64)) *MockProfileImportRepo_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Delete_Call) Return(err error) *MockProfileImportRepo_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Delete_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockProfileImportRepo_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBy provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) DeleteBy(ctx context.Context, expr repo.Ex) (int64, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) (int64, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Ex) int64); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Ex) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_DeleteBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBy'
+type MockProfileImportRepo_DeleteBy_Call struct {
+	*mock.Call
+}
+
+// DeleteBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockProfileImportRepo_Expecter) DeleteBy(ctx interface{}, expr interface{}) *MockProfileImportRepo_DeleteBy_Call {
+	return &MockProfileImportRepo_DeleteBy_Call{Call: _e.mock.On("DeleteBy", ctx, expr)}
+}
+
+func (_c *MockProfileImportRepo_DeleteBy_Call) Run(run func(ctx context.Context, expr repo.Ex)) *MockProfileImportRepo_DeleteBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Ex))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_DeleteBy_Call) Return(n int64, err error) *MockProfileImportRepo_DeleteBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_DeleteBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Ex) (int64, error)) *MockProfileImportRepo_DeleteBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteErrors provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) DeleteErrors(ctx context.Context, importID int64) error {
+	ret := _mock.Called(ctx, importID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteErrors")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, importID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileImportRepo_DeleteErrors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteErrors'
+type MockProfileImportRepo_DeleteErrors_Call struct {
+	*mock.Call
+}
+
+// DeleteErrors is a helper method to define mock.On call
+//   - ctx
+//   - importID
+func (_e *MockProfileImportRepo_Expecter) DeleteErrors(ctx interface{}, importID interface{}) *MockProfileImportRepo_DeleteErrors_Call {
+	return &MockProfileImportRepo_DeleteErrors_Call{Call: _e.mock.On("DeleteErrors", ctx, importID)}
+}
+
+func (_c *MockProfileImportRepo_DeleteErrors_Call) Run(run func(ctx context.Context, importID int64)) *MockProfileImportRepo_DeleteErrors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_DeleteErrors_Call) Return(err error) *MockProfileImportRepo_DeleteErrors_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_DeleteErrors_Call) RunAndReturn(run func(ctx context.Context, importID int64) error) *MockProfileImportRepo_DeleteErrors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) Exists(ctx context.Context, expr repo.Expression) (bool, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (bool, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) bool); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockProfileImportRepo_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockProfileImportRepo_Expecter) Exists(ctx interface{}, expr interface{}) *MockProfileImportRepo_Exists_Call {
+	return &MockProfileImportRepo_Exists_Call{Call: _e.mock.On("Exists", ctx, expr)}
+}
+
+func (_c *MockProfileImportRepo_Exists_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockProfileImportRepo_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Exists_Call) Return(b bool, err error) *MockProfileImportRepo_Exists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Exists_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (bool, error)) *MockProfileImportRepo_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) Find(ctx context.Context, dest *model.ProfileImport, id int64) error {
+	ret := _mock.Called(ctx, dest, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.ProfileImport, int64) error); ok {
+		r0 = returnFunc(ctx, dest, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileImportRepo_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type MockProfileImportRepo_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx
+//   - dest
+//   - id
+func (_e *MockProfileImportRepo_Expecter) Find(ctx interface{}, dest interface{}, id interface{}) *MockProfileImportRepo_Find_Call {
+	return &MockProfileImportRepo_Find_Call{Call: _e.mock.On("Find", ctx, dest, id)}
+}
+
+func (_c *MockProfileImportRepo_Find_Call) Run(run func(ctx context.Context, dest *model.ProfileImport, id int64)) *MockProfileImportRepo_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.ProfileImport), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Find_Call) Return(err error) *MockProfileImportRepo_Find_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Find_Call) RunAndReturn(run func(ctx context.Context, dest *model.ProfileImport, id int64) error) *MockProfileImportRepo_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// First provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) First(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.ProfileImport, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for First")
+	}
+
+	var r0 *model.ProfileImport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.ProfileImport, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.ProfileImport); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProfileImport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_First_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'First'
+type MockProfileImportRepo_First_Call struct {
+	*mock.Call
+}
+
+// First is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockProfileImportRepo_Expecter) First(ctx interface{}, expr interface{}, order ...interface{}) *MockProfileImportRepo_First_Call {
+	return &MockProfileImportRepo_First_Call{Call: _e.mock.On("First",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockProfileImportRepo_First_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockProfileImportRepo_First_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_First_Call) Return(profileImport *model.ProfileImport, err error) *MockProfileImportRepo_First_Call {
+	_c.Call.Return(profileImport, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_First_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.ProfileImport, error)) *MockProfileImportRepo_First_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) Get(ctx context.Context, id int64) (*model.ProfileImport, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.ProfileImport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.ProfileImport, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.ProfileImport); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProfileImport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockProfileImportRepo_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockProfileImportRepo_Expecter) Get(ctx interface{}, id interface{}) *MockProfileImportRepo_Get_Call {
+	return &MockProfileImportRepo_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *MockProfileImportRepo_Get_Call) Run(run func(ctx context.Context, id int64)) *MockProfileImportRepo_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Get_Call) Return(profileImport *model.ProfileImport, err error) *MockProfileImportRepo_Get_Call {
+	_c.Call.Return(profileImport, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Get_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.ProfileImport, error)) *MockProfileImportRepo_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBy provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) GetBy(ctx context.Context, expr repo.Expression) (*model.ProfileImport, error) {
+	ret := _mock.Called(ctx, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBy")
+	}
+
+	var r0 *model.ProfileImport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) (*model.ProfileImport, error)); ok {
+		return returnFunc(ctx, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression) *model.ProfileImport); ok {
+		r0 = returnFunc(ctx, expr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProfileImport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_GetBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBy'
+type MockProfileImportRepo_GetBy_Call struct {
+	*mock.Call
+}
+
+// GetBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+func (_e *MockProfileImportRepo_Expecter) GetBy(ctx interface{}, expr interface{}) *MockProfileImportRepo_GetBy_Call {
+	return &MockProfileImportRepo_GetBy_Call{Call: _e.mock.On("GetBy", ctx, expr)}
+}
+
+func (_c *MockProfileImportRepo_GetBy_Call) Run(run func(ctx context.Context, expr repo.Expression)) *MockProfileImportRepo_GetBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_GetBy_Call) Return(profileImport *model.ProfileImport, err error) *MockProfileImportRepo_GetBy_Call {
+	_c.Call.Return(profileImport, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_GetBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression) (*model.ProfileImport, error)) *MockProfileImportRepo_GetBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForUpdate provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) GetForUpdate(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.ProfileImport, error) {
+	var tmpRet mock.Arguments
+	if len(order) > 0 {
+		tmpRet = _mock.Called(ctx, expr, order)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUpdate")
+	}
+
+	var r0 *model.ProfileImport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) (*model.ProfileImport, error)); ok {
+		return returnFunc(ctx, expr, order...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...repo.OrderedExpression) *model.ProfileImport); ok {
+		r0 = returnFunc(ctx, expr, order...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProfileImport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...repo.OrderedExpression) error); ok {
+		r1 = returnFunc(ctx, expr, order...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_GetForUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForUpdate'
+type MockProfileImportRepo_GetForUpdate_Call struct {
+	*mock.Call
+}
+
+// GetForUpdate is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - order
+func (_e *MockProfileImportRepo_Expecter) GetForUpdate(ctx interface{}, expr interface{}, order ...interface{}) *MockProfileImportRepo_GetForUpdate_Call {
+	return &MockProfileImportRepo_GetForUpdate_Call{Call: _e.mock.On("GetForUpdate",
+		append([]interface{}{ctx, expr}, order...)...)}
+}
+
+func (_c *MockProfileImportRepo_GetForUpdate_Call) Run(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression)) *MockProfileImportRepo_GetForUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]repo.OrderedExpression)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_GetForUpdate_Call) Return(profileImport *model.ProfileImport, err error) *MockProfileImportRepo_GetForUpdate_Call {
+	_c.Call.Return(profileImport, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_GetForUpdate_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, order ...repo.OrderedExpression) (*model.ProfileImport, error)) *MockProfileImportRepo_GetForUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Insert provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) Insert(ctx context.Context, req *model.ProfileImport) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.ProfileImport) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileImportRepo_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockProfileImportRepo_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockProfileImportRepo_Expecter) Insert(ctx interface{}, req interface{}) *MockProfileImportRepo_Insert_Call {
+	return &MockProfileImportRepo_Insert_Call{Call: _e.mock.On("Insert", ctx, req)}
+}
+
+func (_c *MockProfileImportRepo_Insert_Call) Run(run func(ctx context.Context, req *model.ProfileImport)) *MockProfileImportRepo_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.ProfileImport))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Insert_Call) Return(err error) *MockProfileImportRepo_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Insert_Call) RunAndReturn(run func(ctx context.Context, req *model.ProfileImport) error) *MockProfileImportRepo_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) List(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.ProfileImport], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, opts)
+	} else {
+		tmpRet = _mock.Called(ctx)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *response.ListResponse[*model.ProfileImport]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) (*response.ListResponse[*model.ProfileImport], error)); ok {
+		return returnFunc(ctx, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ...clause.FilterOption) *response.ListResponse[*model.ProfileImport]); ok {
+		r0 = returnFunc(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.ProfileImport])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type MockProfileImportRepo_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx
+//   - opts
+func (_e *MockProfileImportRepo_Expecter) List(ctx interface{}, opts ...interface{}) *MockProfileImportRepo_List_Call {
+	return &MockProfileImportRepo_List_Call{Call: _e.mock.On("List",
+		append([]interface{}{ctx}, opts...)...)}
+}
+
+func (_c *MockProfileImportRepo_List_Call) Run(run func(ctx context.Context, opts ...clause.FilterOption)) *MockProfileImportRepo_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[1].([]clause.FilterOption)
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_List_Call) Return(listResponse *response.ListResponse[*model.ProfileImport], err error) *MockProfileImportRepo_List_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_List_Call) RunAndReturn(run func(ctx context.Context, opts ...clause.FilterOption) (*response.ListResponse[*model.ProfileImport], error)) *MockProfileImportRepo_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBy provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) ListBy(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.ProfileImport], error) {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBy")
+	}
+
+	var r0 *response.ListResponse[*model.ProfileImport]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) (*response.ListResponse[*model.ProfileImport], error)); ok {
+		return returnFunc(ctx, expr, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, ...clause.FilterOption) *response.ListResponse[*model.ProfileImport]); ok {
+		r0 = returnFunc(ctx, expr, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.ProfileImport])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, repo.Expression, ...clause.FilterOption) error); ok {
+		r1 = returnFunc(ctx, expr, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_ListBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBy'
+type MockProfileImportRepo_ListBy_Call struct {
+	*mock.Call
+}
+
+// ListBy is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - opts
+func (_e *MockProfileImportRepo_Expecter) ListBy(ctx interface{}, expr interface{}, opts ...interface{}) *MockProfileImportRepo_ListBy_Call {
+	return &MockProfileImportRepo_ListBy_Call{Call: _e.mock.On("ListBy",
+		append([]interface{}{ctx, expr}, opts...)...)}
+}
+
+func (_c *MockProfileImportRepo_ListBy_Call) Run(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption)) *MockProfileImportRepo_ListBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_ListBy_Call) Return(listResponse *response.ListResponse[*model.ProfileImport], err error) *MockProfileImportRepo_ListBy_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_ListBy_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, opts ...clause.FilterOption) (*response.ListResponse[*model.ProfileImport], error)) *MockProfileImportRepo_ListBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByEach provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) ListByEach(ctx context.Context, expr repo.Expression, fn func(item *model.ProfileImport) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, expr, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, expr, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, repo.Expression, func(item *model.ProfileImport) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, expr, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileImportRepo_ListByEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByEach'
+type MockProfileImportRepo_ListByEach_Call struct {
+	*mock.Call
+}
+
+// ListByEach is a helper method to define mock.On call
+//   - ctx
+//   - expr
+//   - fn
+//   - opts
+func (_e *MockProfileImportRepo_Expecter) ListByEach(ctx interface{}, expr interface{}, fn interface{}, opts ...interface{}) *MockProfileImportRepo_ListByEach_Call {
+	return &MockProfileImportRepo_ListByEach_Call{Call: _e.mock.On("ListByEach",
+		append([]interface{}{ctx, expr, fn}, opts...)...)}
+}
+
+func (_c *MockProfileImportRepo_ListByEach_Call) Run(run func(ctx context.Context, expr repo.Expression, fn func(item *model.ProfileImport) error, opts ...clause.FilterOption)) *MockProfileImportRepo_ListByEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[3].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(repo.Expression), args[2].(func(item *model.ProfileImport) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_ListByEach_Call) Return(err error) *MockProfileImportRepo_ListByEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_ListByEach_Call) RunAndReturn(run func(ctx context.Context, expr repo.Expression, fn func(item *model.ProfileImport) error, opts ...clause.FilterOption) error) *MockProfileImportRepo_ListByEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByIDs provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) ListByIDs(ctx context.Context, ids []int64) (*response.ListResponse[*model.ProfileImport], error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByIDs")
+	}
+
+	var r0 *response.ListResponse[*model.ProfileImport]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) (*response.ListResponse[*model.ProfileImport], error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64) *response.ListResponse[*model.ProfileImport]); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.ProfileImport])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_ListByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByIDs'
+type MockProfileImportRepo_ListByIDs_Call struct {
+	*mock.Call
+}
+
+// ListByIDs is a helper method to define mock.On call
+//   - ctx
+//   - ids
+func (_e *MockProfileImportRepo_Expecter) ListByIDs(ctx interface{}, ids interface{}) *MockProfileImportRepo_ListByIDs_Call {
+	return &MockProfileImportRepo_ListByIDs_Call{Call: _e.mock.On("ListByIDs", ctx, ids)}
+}
+
+func (_c *MockProfileImportRepo_ListByIDs_Call) Run(run func(ctx context.Context, ids []int64)) *MockProfileImportRepo_ListByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_ListByIDs_Call) Return(listResponse *response.ListResponse[*model.ProfileImport], err error) *MockProfileImportRepo_ListByIDs_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_ListByIDs_Call) RunAndReturn(run func(ctx context.Context, ids []int64) (*response.ListResponse[*model.ProfileImport], error)) *MockProfileImportRepo_ListByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEach provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) ListEach(ctx context.Context, fn func(item *model.ProfileImport) error, opts ...clause.FilterOption) error {
+	var tmpRet mock.Arguments
+	if len(opts) > 0 {
+		tmpRet = _mock.Called(ctx, fn, opts)
+	} else {
+		tmpRet = _mock.Called(ctx, fn)
+	}
+	ret := tmpRet
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEach")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(item *model.ProfileImport) error, ...clause.FilterOption) error); ok {
+		r0 = returnFunc(ctx, fn, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileImportRepo_ListEach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEach'
+type MockProfileImportRepo_ListEach_Call struct {
+	*mock.Call
+}
+
+// ListEach is a helper method to define mock.On call
+//   - ctx
+//   - fn
+//   - opts
+func (_e *MockProfileImportRepo_Expecter) ListEach(ctx interface{}, fn interface{}, opts ...interface{}) *MockProfileImportRepo_ListEach_Call {
+	return &MockProfileImportRepo_ListEach_Call{Call: _e.mock.On("ListEach",
+		append([]interface{}{ctx, fn}, opts...)...)}
+}
+
+func (_c *MockProfileImportRepo_ListEach_Call) Run(run func(ctx context.Context, fn func(item *model.ProfileImport) error, opts ...clause.FilterOption)) *MockProfileImportRepo_ListEach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := args[2].([]clause.FilterOption)
+		run(args[0].(context.Context), args[1].(func(item *model.ProfileImport) error), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_ListEach_Call) Return(err error) *MockProfileImportRepo_ListEach_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_ListEach_Call) RunAndReturn(run func(ctx context.Context, fn func(item *model.ProfileImport) error, opts ...clause.FilterOption) error) *MockProfileImportRepo_ListEach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListErrors provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) ListErrors(ctx context.Context, importID int64, fn func(item *model.ProfileImportError) error) error {
+	ret := _mock.Called(ctx, importID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListErrors")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, func(item *model.ProfileImportError) error) error); ok {
+		r0 = returnFunc(ctx, importID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileImportRepo_ListErrors_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListErrors'
+type MockProfileImportRepo_ListErrors_Call struct {
+	*mock.Call
+}
+
+// ListErrors is a helper method to define mock.On call
+//   - ctx
+//   - importID
+//   - fn
+func (_e *MockProfileImportRepo_Expecter) ListErrors(ctx interface{}, importID interface{}, fn interface{}) *MockProfileImportRepo_ListErrors_Call {
+	return &MockProfileImportRepo_ListErrors_Call{Call: _e.mock.On("ListErrors", ctx, importID, fn)}
+}
+
+func (_c *MockProfileImportRepo_ListErrors_Call) Run(run func(ctx context.Context, importID int64, fn func(item *model.ProfileImportError) error)) *MockProfileImportRepo_ListErrors_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(func(item *model.ProfileImportError) error))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_ListErrors_Call) Return(err error) *MockProfileImportRepo_ListErrors_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_ListErrors_Call) RunAndReturn(run func(ctx context.Context, importID int64, fn func(item *model.ProfileImportError) error) error) *MockProfileImportRepo_ListErrors_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) Update(ctx context.Context, req *model.ProfileImport) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.ProfileImport) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileImportRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockProfileImportRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockProfileImportRepo_Expecter) Update(ctx interface{}, req interface{}) *MockProfileImportRepo_Update_Call {
+	return &MockProfileImportRepo_Update_Call{Call: _e.mock.On("Update", ctx, req)}
+}
+
+func (_c *MockProfileImportRepo_Update_Call) Run(run func(ctx context.Context, req *model.ProfileImport)) *MockProfileImportRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.ProfileImport))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Update_Call) Return(err error) *MockProfileImportRepo_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Update_Call) RunAndReturn(run func(ctx context.Context, req *model.ProfileImport) error) *MockProfileImportRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMap provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) UpdateMap(ctx context.Context, id int64, req map[string]any) error {
+	ret := _mock.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMap")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, map[string]any) error); ok {
+		r0 = returnFunc(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileImportRepo_UpdateMap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMap'
+type MockProfileImportRepo_UpdateMap_Call struct {
+	*mock.Call
+}
+
+// UpdateMap is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - req
+func (_e *MockProfileImportRepo_Expecter) UpdateMap(ctx interface{}, id interface{}, req interface{}) *MockProfileImportRepo_UpdateMap_Call {
+	return &MockProfileImportRepo_UpdateMap_Call{Call: _e.mock.On("UpdateMap", ctx, id, req)}
+}
+
+func (_c *MockProfileImportRepo_UpdateMap_Call) Run(run func(ctx context.Context, id int64, req map[string]any)) *MockProfileImportRepo_UpdateMap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(map[string]any))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_UpdateMap_Call) Return(err error) *MockProfileImportRepo_UpdateMap_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_UpdateMap_Call) RunAndReturn(run func(ctx context.Context, id int64, req map[string]any) error) *MockProfileImportRepo_UpdateMap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMapBy provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) UpdateMapBy(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error) {
+	ret := _mock.Called(ctx, req, expr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMapBy")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) (int64, error)); ok {
+		return returnFunc(ctx, req, expr)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, map[string]any, repo.Expression) int64); ok {
+		r0 = returnFunc(ctx, req, expr)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, map[string]any, repo.Expression) error); ok {
+		r1 = returnFunc(ctx, req, expr)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_UpdateMapBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMapBy'
+type MockProfileImportRepo_UpdateMapBy_Call struct {
+	*mock.Call
+}
+
+// UpdateMapBy is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - expr
+func (_e *MockProfileImportRepo_Expecter) UpdateMapBy(ctx interface{}, req interface{}, expr interface{}) *MockProfileImportRepo_UpdateMapBy_Call {
+	return &MockProfileImportRepo_UpdateMapBy_Call{Call: _e.mock.On("UpdateMapBy", ctx, req, expr)}
+}
+
+func (_c *MockProfileImportRepo_UpdateMapBy_Call) Run(run func(ctx context.Context, req map[string]any, expr repo.Expression)) *MockProfileImportRepo_UpdateMapBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[string]any), args[2].(repo.Expression))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_UpdateMapBy_Call) Return(n int64, err error) *MockProfileImportRepo_UpdateMapBy_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_UpdateMapBy_Call) RunAndReturn(run func(ctx context.Context, req map[string]any, expr repo.Expression) (int64, error)) *MockProfileImportRepo_UpdateMapBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockProfileImportRepo
+func (_mock *MockProfileImportRepo) Upsert(ctx context.Context, req *model.ProfileImport, target string) (bool, error) {
+	ret := _mock.Called(ctx, req, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.ProfileImport, string) (bool, error)); ok {
+		return returnFunc(ctx, req, target)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.ProfileImport, string) bool); ok {
+		r0 = returnFunc(ctx, req, target)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.ProfileImport, string) error); ok {
+		r1 = returnFunc(ctx, req, target)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfileImportRepo_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockProfileImportRepo_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - target
+func (_e *MockProfileImportRepo_Expecter) Upsert(ctx interface{}, req interface{}, target interface{}) *MockProfileImportRepo_Upsert_Call {
+	return &MockProfileImportRepo_Upsert_Call{Call: _e.mock.On("Upsert", ctx, req, target)}
+}
+
+func (_c *MockProfileImportRepo_Upsert_Call) Run(run func(ctx context.Context, req *model.ProfileImport, target string)) *MockProfileImportRepo_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.ProfileImport), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Upsert_Call) Return(b bool, err error) *MockProfileImportRepo_Upsert_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockProfileImportRepo_Upsert_Call) RunAndReturn(run func(ctx context.Context, req *model.ProfileImport, target string) (bool, error)) *MockProfileImportRepo_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEventRepo creates a new instance of MockEventRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventRepo(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventRepo {
+	mock := &MockEventRepo{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEventRepo is an autogenerated mock type for the EventRepo type
+type MockEventRepo struct {
+	mock.Mock
+}
+
+type MockEventRepo_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventRepo) EXPECT() *MockEventRepo_Expecter {
+	return &MockEventRepo_Expecter{mock: &_m.Mock}
+}
+
+// FirstID provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) FirstID(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FirstID")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_FirstID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FirstID'
+type MockEventRepo_FirstID_Call struct {
+	*mock.Call
+}
+
+// FirstID is a helper method to define mock.On call
+//   - ctx
+func (_e *MockEventRepo_Expecter) FirstID(ctx interface{}) *MockEventRepo_FirstID_Call {
+	return &MockEventRepo_FirstID_Call{Call: _e.mock.On("FirstID", ctx)}
+}
+
+func (_c *MockEventRepo_FirstID_Call) Run(run func(ctx context.Context)) *MockEventRepo_FirstID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_FirstID_Call) Return(n int64, err error) *MockEventRepo_FirstID_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockEventRepo_FirstID_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *MockEventRepo_FirstID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAfter provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) ListAfter(ctx context.Context, id int64, resources []string, limit uint) ([]*model.Event, error) {
+	ret := _mock.Called(ctx, id, resources, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAfter")
+	}
+
+	var r0 []*model.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, []string, uint) ([]*model.Event, error)); ok {
+		return returnFunc(ctx, id, resources, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, []string, uint) []*model.Event); ok {
+		r0 = returnFunc(ctx, id, resources, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, []string, uint) error); ok {
+		r1 = returnFunc(ctx, id, resources, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEventRepo_ListAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAfter'
+type MockEventRepo_ListAfter_Call struct {
+	*mock.Call
+}
+
+// ListAfter is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - resources
+//   - limit
+func (_e *MockEventRepo_Expecter) ListAfter(ctx interface{}, id interface{}, resources interface{}, limit interface{}) *MockEventRepo_ListAfter_Call {
+	return &MockEventRepo_ListAfter_Call{Call: _e.mock.On("ListAfter", ctx, id, resources, limit)}
+}
+
+func (_c *MockEventRepo_ListAfter_Call) Run(run func(ctx context.Context, id int64, resources []string, limit uint)) *MockEventRepo_ListAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]string), args[3].(uint))
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_ListAfter_Call) Return(events []*model.Event, err error) *MockEventRepo_ListAfter_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockEventRepo_ListAfter_Call) RunAndReturn(run func(ctx context.Context, id int64, resources []string, limit uint) ([]*model.Event, error)) *MockEventRepo_ListAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function for the type MockEventRepo
+func (_mock *MockEventRepo) Subscribe(ctx context.Context) <-chan *model.Event {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan *model.Event
+	if returnFunc, ok := ret.Get(0).(func(context.Context) <-chan *model.Event); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *model.Event)
+		}
+	}
+	return r0
+}
+
+// MockEventRepo_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockEventRepo_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - ctx
+func (_e *MockEventRepo_Expecter) Subscribe(ctx interface{}) *MockEventRepo_Subscribe_Call {
+	return &MockEventRepo_Subscribe_Call{Call: _e.mock.On("Subscribe", ctx)}
+}
+
+func (_c *MockEventRepo_Subscribe_Call) Run(run func(ctx context.Context)) *MockEventRepo_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockEventRepo_Subscribe_Call) Return(eventCh <-chan *model.Event) *MockEventRepo_Subscribe_Call {
+	_c.Call.Return(eventCh)
+	return _c
+}
+
+func (_c *MockEventRepo_Subscribe_Call) RunAndReturn(run func(ctx context.Context) <-chan *model.Event) *MockEventRepo_Subscribe_Call {
 	_c.Call.Return(run)
 	return _c
 }
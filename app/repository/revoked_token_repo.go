@@ -0,0 +1,28 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+type RevokedTokenRepoImpl struct {
+	*repo.GenericStoreImpl[*model.RevokedToken]
+}
+
+func NewRevokedToken(conn sql.Executor) *RevokedTokenRepoImpl {
+	s := &RevokedTokenRepoImpl{
+		GenericStoreImpl: repo.NewStore[*model.RevokedToken](conn),
+	}
+	return s
+}
+
+func (s *RevokedTokenRepoImpl) IsRevoked(ctx context.Context, tokenID string) (bool, error) {
+	return s.Exists(ctx, repo.Ex{"token_id": tokenID})
+}
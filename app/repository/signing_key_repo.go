@@ -0,0 +1,46 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+type SigningKeyRepoImpl struct {
+	*repo.GenericStoreImpl[*model.SigningKey]
+}
+
+func NewSigningKey(conn sql.Executor) *SigningKeyRepoImpl {
+	s := &SigningKeyRepoImpl{
+		GenericStoreImpl: repo.NewStore[*model.SigningKey](conn),
+	}
+	return s
+}
+
+// GetActiveForUpdate returns the key that signs the tokens, locking it until the end of the transaction.
+func (s *SigningKeyRepoImpl) GetActiveForUpdate(ctx context.Context) (*model.SigningKey, error) {
+	return s.GetForUpdate(ctx, repo.Ex{"retired_at": nil})
+}
+
+// ListValid returns the active key and the retired keys that didn't expire.
+func (s *SigningKeyRepoImpl) ListValid(ctx context.Context, now time.Time) ([]*model.SigningKey, error) {
+	var keys []*model.SigningKey
+	expr := repo.Or(repo.C("expires_at").IsNull(), repo.C("expires_at").Gt(now))
+
+	err := s.ListByEach(ctx, expr, func(item *model.SigningKey) error {
+		keys = append(keys, item)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
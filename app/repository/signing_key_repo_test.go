@@ -0,0 +1,66 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+)
+
+func TestSigningKeyRepo(t *testing.T) {
+	suite.Run(t, &signingKeySuite{})
+}
+
+type signingKeySuite struct {
+	suite.Suite
+	conn *repo.Connection
+}
+
+func (s *signingKeySuite) SetupTest() {
+	s.conn = repo.NewTestConnection(s.T(), true)
+}
+
+func (s *signingKeySuite) TearDownTest() {
+	if s.conn != nil {
+		s.conn.Close(s.T())
+	}
+}
+
+func (s *signingKeySuite) TestRotation() {
+	ctx := context.Background()
+	r := NewSigningKey(s.conn.Store)
+	now := time.Now().Truncate(time.Microsecond)
+
+	_, err := r.GetActiveForUpdate(ctx)
+	s.ErrorIs(err, repo.ErrNotFound)
+
+	first := &model.SigningKey{CreatedAt: now, KeyID: "first", Algorithm: "EdDSA", PrivateKey: []byte("key")}
+	s.Require().NoError(r.Insert(ctx, first))
+
+	// only one key can be active
+	second := &model.SigningKey{CreatedAt: now, KeyID: "second", Algorithm: "EdDSA", PrivateKey: []byte("key")}
+	s.ErrorIs(r.Insert(ctx, second), repo.ErrDuplicated)
+
+	active, err := r.GetActiveForUpdate(ctx)
+	s.Require().NoError(err)
+	s.Equal("first", active.KeyID)
+
+	s.Require().NoError(r.UpdateMap(ctx, first.ID, map[string]any{"retired_at": now, "expires_at": now.Add(time.Hour)}))
+	s.Require().NoError(r.Insert(ctx, second))
+
+	keys, err := r.ListValid(ctx, now)
+	s.Require().NoError(err)
+	s.Len(keys, 2)
+
+	keys, err = r.ListValid(ctx, now.Add(time.Hour))
+	s.Require().NoError(err)
+	s.Require().Len(keys, 1)
+	s.Equal("second", keys[0].KeyID)
+}
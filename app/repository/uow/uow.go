@@ -14,16 +14,37 @@ import (
 
 type UnitOfWorkStore interface {
 	Profiles() repository.ProfileRepo
+	ProfileImports() repository.ProfileImportRepo
+	APIKeys() repository.APIKeyRepo
+	Users() repository.UserRepo
+	AuditEvents() repository.AuditEventRepo
+	RefreshTokens() repository.RefreshTokenRepo
+	RevokedTokens() repository.RevokedTokenRepo
+	SigningKeys() repository.SigningKeyRepo
 }
 
 // uowStore has all the repositories of the application
 type uowStore struct {
-	profiles repository.ProfileRepo
+	profiles       repository.ProfileRepo
+	profileImports repository.ProfileImportRepo
+	apiKeys        repository.APIKeyRepo
+	users          repository.UserRepo
+	auditEvents    repository.AuditEventRepo
+	refreshTokens  repository.RefreshTokenRepo
+	revokedTokens  repository.RevokedTokenRepo
+	signingKeys    repository.SigningKeyRepo
 }
 
 func newUowStore(conn sql.Executor) *uowStore {
 	return &uowStore{
-		profiles: repository.NewProfile(conn),
+		profiles:       repository.NewProfile(conn),
+		profileImports: repository.NewProfileImport(conn),
+		apiKeys:        repository.NewAPIKey(conn),
+		users:          repository.NewUser(conn),
+		auditEvents:    repository.NewAuditEvent(conn),
+		refreshTokens:  repository.NewRefreshToken(conn),
+		revokedTokens:  repository.NewRevokedToken(conn),
+		signingKeys:    repository.NewSigningKey(conn),
 	}
 }
 
@@ -31,6 +52,34 @@ func (u uowStore) Profiles() repository.ProfileRepo {
 	return u.profiles
 }
 
+func (u uowStore) ProfileImports() repository.ProfileImportRepo {
+	return u.profileImports
+}
+
+func (u uowStore) APIKeys() repository.APIKeyRepo {
+	return u.apiKeys
+}
+
+func (u uowStore) Users() repository.UserRepo {
+	return u.users
+}
+
+func (u uowStore) AuditEvents() repository.AuditEventRepo {
+	return u.auditEvents
+}
+
+func (u uowStore) RefreshTokens() repository.RefreshTokenRepo {
+	return u.refreshTokens
+}
+
+func (u uowStore) RevokedTokens() repository.RevokedTokenRepo {
+	return u.revokedTokens
+}
+
+func (u uowStore) SigningKeys() repository.SigningKeyRepo {
+	return u.signingKeys
+}
+
 type UnitOfWorkBlock func(UnitOfWork) error
 
 type UnitOfWork interface {
@@ -38,6 +87,10 @@ type UnitOfWork interface {
 	Commit(ctx context.Context) error
 	Rollback(ctx context.Context) error
 	Begin(ctx context.Context) (UnitOfWork, error)
+	// Bind returns a context that runs the queries of the repositories created with a sql.ContextExecutor on this
+	// unit of work, so code that can't receive it (e.g. the handlers dispatched by a batch request) joins the
+	// transaction.
+	Bind(ctx context.Context) context.Context
 	Store() UnitOfWorkStore
 }
 
@@ -78,6 +131,14 @@ func (u *unitOfWork) Begin(ctx context.Context) (UnitOfWork, error) {
 	return New(tx), nil
 }
 
+func (u *unitOfWork) Bind(ctx context.Context) context.Context {
+	if tx, ok := u.conn.(sql.Tx); ok {
+		return sql.WithTx(ctx, tx)
+	}
+
+	return ctx
+}
+
 func (u *unitOfWork) Commit(ctx context.Context) error {
 	tx, ok := u.conn.(sql.Transactor)
 	if ok {
@@ -38,6 +38,144 @@ func (_m *MockUnitOfWorkStore) EXPECT() *MockUnitOfWorkStore_Expecter {
 	return &MockUnitOfWorkStore_Expecter{mock: &_m.Mock}
 }
 
+// APIKeys provides a mock function for the type MockUnitOfWorkStore
+func (_mock *MockUnitOfWorkStore) APIKeys() repository.APIKeyRepo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for APIKeys")
+	}
+
+	var r0 repository.APIKeyRepo
+	if returnFunc, ok := ret.Get(0).(func() repository.APIKeyRepo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.APIKeyRepo)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWorkStore_APIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APIKeys'
+type MockUnitOfWorkStore_APIKeys_Call struct {
+	*mock.Call
+}
+
+// APIKeys is a helper method to define mock.On call
+func (_e *MockUnitOfWorkStore_Expecter) APIKeys() *MockUnitOfWorkStore_APIKeys_Call {
+	return &MockUnitOfWorkStore_APIKeys_Call{Call: _e.mock.On("APIKeys")}
+}
+
+func (_c *MockUnitOfWorkStore_APIKeys_Call) Run(run func()) *MockUnitOfWorkStore_APIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_APIKeys_Call) Return(aPIKeyRepo repository.APIKeyRepo) *MockUnitOfWorkStore_APIKeys_Call {
+	_c.Call.Return(aPIKeyRepo)
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_APIKeys_Call) RunAndReturn(run func() repository.APIKeyRepo) *MockUnitOfWorkStore_APIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AuditEvents provides a mock function for the type MockUnitOfWorkStore
+func (_mock *MockUnitOfWorkStore) AuditEvents() repository.AuditEventRepo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditEvents")
+	}
+
+	var r0 repository.AuditEventRepo
+	if returnFunc, ok := ret.Get(0).(func() repository.AuditEventRepo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.AuditEventRepo)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWorkStore_AuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditEvents'
+type MockUnitOfWorkStore_AuditEvents_Call struct {
+	*mock.Call
+}
+
+// AuditEvents is a helper method to define mock.On call
+func (_e *MockUnitOfWorkStore_Expecter) AuditEvents() *MockUnitOfWorkStore_AuditEvents_Call {
+	return &MockUnitOfWorkStore_AuditEvents_Call{Call: _e.mock.On("AuditEvents")}
+}
+
+func (_c *MockUnitOfWorkStore_AuditEvents_Call) Run(run func()) *MockUnitOfWorkStore_AuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_AuditEvents_Call) Return(auditEventRepo repository.AuditEventRepo) *MockUnitOfWorkStore_AuditEvents_Call {
+	_c.Call.Return(auditEventRepo)
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_AuditEvents_Call) RunAndReturn(run func() repository.AuditEventRepo) *MockUnitOfWorkStore_AuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProfileImports provides a mock function for the type MockUnitOfWorkStore
+func (_mock *MockUnitOfWorkStore) ProfileImports() repository.ProfileImportRepo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProfileImports")
+	}
+
+	var r0 repository.ProfileImportRepo
+	if returnFunc, ok := ret.Get(0).(func() repository.ProfileImportRepo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.ProfileImportRepo)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWorkStore_ProfileImports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProfileImports'
+type MockUnitOfWorkStore_ProfileImports_Call struct {
+	*mock.Call
+}
+
+// ProfileImports is a helper method to define mock.On call
+func (_e *MockUnitOfWorkStore_Expecter) ProfileImports() *MockUnitOfWorkStore_ProfileImports_Call {
+	return &MockUnitOfWorkStore_ProfileImports_Call{Call: _e.mock.On("ProfileImports")}
+}
+
+func (_c *MockUnitOfWorkStore_ProfileImports_Call) Run(run func()) *MockUnitOfWorkStore_ProfileImports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_ProfileImports_Call) Return(profileImportRepo repository.ProfileImportRepo) *MockUnitOfWorkStore_ProfileImports_Call {
+	_c.Call.Return(profileImportRepo)
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_ProfileImports_Call) RunAndReturn(run func() repository.ProfileImportRepo) *MockUnitOfWorkStore_ProfileImports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Profiles provides a mock function for the type MockUnitOfWorkStore
 func (_mock *MockUnitOfWorkStore) Profiles() repository.ProfileRepo {
 	ret := _mock.Called()
@@ -84,6 +222,190 @@ func (_c *MockUnitOfWorkStore_Profiles_Call) RunAndReturn(run func() repository.
 	return _c
 }
 
+// RefreshTokens provides a mock function for the type MockUnitOfWorkStore
+func (_mock *MockUnitOfWorkStore) RefreshTokens() repository.RefreshTokenRepo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshTokens")
+	}
+
+	var r0 repository.RefreshTokenRepo
+	if returnFunc, ok := ret.Get(0).(func() repository.RefreshTokenRepo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.RefreshTokenRepo)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWorkStore_RefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshTokens'
+type MockUnitOfWorkStore_RefreshTokens_Call struct {
+	*mock.Call
+}
+
+// RefreshTokens is a helper method to define mock.On call
+func (_e *MockUnitOfWorkStore_Expecter) RefreshTokens() *MockUnitOfWorkStore_RefreshTokens_Call {
+	return &MockUnitOfWorkStore_RefreshTokens_Call{Call: _e.mock.On("RefreshTokens")}
+}
+
+func (_c *MockUnitOfWorkStore_RefreshTokens_Call) Run(run func()) *MockUnitOfWorkStore_RefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_RefreshTokens_Call) Return(refreshTokenRepo repository.RefreshTokenRepo) *MockUnitOfWorkStore_RefreshTokens_Call {
+	_c.Call.Return(refreshTokenRepo)
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_RefreshTokens_Call) RunAndReturn(run func() repository.RefreshTokenRepo) *MockUnitOfWorkStore_RefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokedTokens provides a mock function for the type MockUnitOfWorkStore
+func (_mock *MockUnitOfWorkStore) RevokedTokens() repository.RevokedTokenRepo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokedTokens")
+	}
+
+	var r0 repository.RevokedTokenRepo
+	if returnFunc, ok := ret.Get(0).(func() repository.RevokedTokenRepo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.RevokedTokenRepo)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWorkStore_RevokedTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokedTokens'
+type MockUnitOfWorkStore_RevokedTokens_Call struct {
+	*mock.Call
+}
+
+// RevokedTokens is a helper method to define mock.On call
+func (_e *MockUnitOfWorkStore_Expecter) RevokedTokens() *MockUnitOfWorkStore_RevokedTokens_Call {
+	return &MockUnitOfWorkStore_RevokedTokens_Call{Call: _e.mock.On("RevokedTokens")}
+}
+
+func (_c *MockUnitOfWorkStore_RevokedTokens_Call) Run(run func()) *MockUnitOfWorkStore_RevokedTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_RevokedTokens_Call) Return(revokedTokenRepo repository.RevokedTokenRepo) *MockUnitOfWorkStore_RevokedTokens_Call {
+	_c.Call.Return(revokedTokenRepo)
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_RevokedTokens_Call) RunAndReturn(run func() repository.RevokedTokenRepo) *MockUnitOfWorkStore_RevokedTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SigningKeys provides a mock function for the type MockUnitOfWorkStore
+func (_mock *MockUnitOfWorkStore) SigningKeys() repository.SigningKeyRepo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SigningKeys")
+	}
+
+	var r0 repository.SigningKeyRepo
+	if returnFunc, ok := ret.Get(0).(func() repository.SigningKeyRepo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.SigningKeyRepo)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWorkStore_SigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SigningKeys'
+type MockUnitOfWorkStore_SigningKeys_Call struct {
+	*mock.Call
+}
+
+// SigningKeys is a helper method to define mock.On call
+func (_e *MockUnitOfWorkStore_Expecter) SigningKeys() *MockUnitOfWorkStore_SigningKeys_Call {
+	return &MockUnitOfWorkStore_SigningKeys_Call{Call: _e.mock.On("SigningKeys")}
+}
+
+func (_c *MockUnitOfWorkStore_SigningKeys_Call) Run(run func()) *MockUnitOfWorkStore_SigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_SigningKeys_Call) Return(signingKeyRepo repository.SigningKeyRepo) *MockUnitOfWorkStore_SigningKeys_Call {
+	_c.Call.Return(signingKeyRepo)
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_SigningKeys_Call) RunAndReturn(run func() repository.SigningKeyRepo) *MockUnitOfWorkStore_SigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Users provides a mock function for the type MockUnitOfWorkStore
+func (_mock *MockUnitOfWorkStore) Users() repository.UserRepo {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Users")
+	}
+
+	var r0 repository.UserRepo
+	if returnFunc, ok := ret.Get(0).(func() repository.UserRepo); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.UserRepo)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWorkStore_Users_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Users'
+type MockUnitOfWorkStore_Users_Call struct {
+	*mock.Call
+}
+
+// Users is a helper method to define mock.On call
+func (_e *MockUnitOfWorkStore_Expecter) Users() *MockUnitOfWorkStore_Users_Call {
+	return &MockUnitOfWorkStore_Users_Call{Call: _e.mock.On("Users")}
+}
+
+func (_c *MockUnitOfWorkStore_Users_Call) Run(run func()) *MockUnitOfWorkStore_Users_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_Users_Call) Return(userRepo repository.UserRepo) *MockUnitOfWorkStore_Users_Call {
+	_c.Call.Return(userRepo)
+	return _c
+}
+
+func (_c *MockUnitOfWorkStore_Users_Call) RunAndReturn(run func() repository.UserRepo) *MockUnitOfWorkStore_Users_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUnitOfWork creates a new instance of MockUnitOfWork. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUnitOfWork(t interface {
@@ -167,6 +489,53 @@ func (_c *MockUnitOfWork_Begin_Call) RunAndReturn(run func(ctx context.Context)
 	return _c
 }
 
+// Bind provides a mock function for the type MockUnitOfWork
+func (_mock *MockUnitOfWork) Bind(ctx context.Context) context.Context {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Bind")
+	}
+
+	var r0 context.Context
+	if returnFunc, ok := ret.Get(0).(func(context.Context) context.Context); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+	return r0
+}
+
+// MockUnitOfWork_Bind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bind'
+type MockUnitOfWork_Bind_Call struct {
+	*mock.Call
+}
+
+// Bind is a helper method to define mock.On call
+//   - ctx
+func (_e *MockUnitOfWork_Expecter) Bind(ctx interface{}) *MockUnitOfWork_Bind_Call {
+	return &MockUnitOfWork_Bind_Call{Call: _e.mock.On("Bind", ctx)}
+}
+
+func (_c *MockUnitOfWork_Bind_Call) Run(run func(ctx context.Context)) *MockUnitOfWork_Bind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUnitOfWork_Bind_Call) Return(context1 context.Context) *MockUnitOfWork_Bind_Call {
+	_c.Call.Return(context1)
+	return _c
+}
+
+func (_c *MockUnitOfWork_Bind_Call) RunAndReturn(run func(ctx context.Context) context.Context) *MockUnitOfWork_Bind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Commit provides a mock function for the type MockUnitOfWork
 func (_mock *MockUnitOfWork) Commit(ctx context.Context) error {
 	ret := _mock.Called(ctx)
@@ -0,0 +1,37 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/repo/filter"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+type UserRepoImpl struct {
+	*repo.GenericStoreImpl[*model.User]
+}
+
+func NewUser(conn sql.Executor) *UserRepoImpl {
+	s := &UserRepoImpl{
+		GenericStoreImpl: repo.NewStore(conn, repo.WithFilters[*model.User](
+			filter.Rule{
+				Key:  "username",
+				Type: "string",
+			}, filter.Rule{
+				Key:  "created_at",
+				Type: "timestamp",
+			},
+		)),
+	}
+	return s
+}
+
+func (s *UserRepoImpl) GetByUsername(ctx context.Context, username string) (*model.User, error) {
+	return s.GetBy(ctx, repo.Ex{"username": model.NormalizeUsername(username)})
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+)
+
+func TestUserRepo(t *testing.T) {
+	suite.Run(t, &userSuite{})
+}
+
+type userSuite struct {
+	suite.Suite
+	conn *repo.Connection
+}
+
+func (s *userSuite) SetupTest() {
+	s.conn = repo.NewTestConnection(s.T(), true)
+}
+
+func (s *userSuite) TearDownTest() {
+	if s.conn != nil {
+		s.conn.Close(s.T())
+	}
+}
+
+func (s *userSuite) TestGetByUsername() {
+	ctx := context.Background()
+	r := NewUser(s.conn.Store)
+
+	user := (&model.UserRequest{Username: "John"}).User()
+	user.PasswordHash = "hash"
+	s.Require().NoError(r.Insert(ctx, user))
+
+	found, err := r.GetByUsername(ctx, "JOHN")
+	s.Require().NoError(err)
+	s.Equal(user.ID, found.ID)
+	s.Equal("john", found.Username)
+
+	duplicated := (&model.UserRequest{Username: "john"}).User()
+	duplicated.PasswordHash = "hash"
+	s.ErrorIs(r.Insert(ctx, duplicated), repo.ErrDuplicated)
+
+	// the logins of unknown users are audited without a user
+	events := NewAuditEvent(s.conn.Store)
+	s.Require().NoError(events.Insert(ctx, &model.AuditEvent{
+		CreatedAt: user.CreatedAt,
+		Action:    model.AuditActionLogin,
+		Username:  "jane",
+		Reason:    model.AuditReasonInvalidCredentials,
+	}))
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.megpoid.dev/go-skel/app/controller"
+	"go.megpoid.dev/go-skel/oapi"
+	"go.megpoid.dev/go-skel/pkg/jwks"
+	mwpkg "go.megpoid.dev/go-skel/pkg/middleware"
+	"go.megpoid.dev/go-skel/pkg/principal"
+)
+
+func TestRegisterRequiresAdmin(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+
+	spec, err := oapi.GetSwagger()
+	require.NoError(t, err)
+	spec.Servers = openapi3.Servers{&openapi3.Server{URL: controller.BaseURL()}}
+
+	e := echo.New()
+	e.Use(mwpkg.OapiValidator(spec, mwpkg.JWTAuthWithConfig(controller.NewJWTConfig(jwks.Secret(secret)), nil)))
+	e.POST(controller.BaseURL()+"/auth/register", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusCreated)
+	})
+
+	register := func(scopes ...string) int {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &controller.JwtCustomClaims{
+			UserID: "1",
+			User:   "john",
+			Scopes: scopes,
+		}).SignedString(secret)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, controller.BaseURL()+"/auth/register",
+			strings.NewReader(`{"username":"jane","password":"password123"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusForbidden, register())
+	assert.Equal(t, http.StatusCreated, register(principal.ScopeAdmin))
+}
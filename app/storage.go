@@ -0,0 +1,26 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package app
+
+import (
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/pkg/s3"
+)
+
+// NewStorage returns the storage of the uploaded files, shared between the server and the queue workers.
+func NewStorage(cfg config.StorageSettings) (s3.Client, error) {
+	if cfg.Driver == "s3" {
+		return s3.NewClient(s3.ClientOpts{
+			Endpoint:       cfg.S3Endpoint,
+			Region:         cfg.S3Region,
+			Bucket:         cfg.S3Bucket,
+			AccessKey:      cfg.S3AccessKey,
+			SecretKey:      cfg.S3SecretKey,
+			ForcePathStyle: cfg.S3ForcePathStyle,
+		})
+	}
+
+	return s3.NewFileClient(cfg.Path), nil
+}
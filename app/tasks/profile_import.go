@@ -0,0 +1,70 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/hibiken/asynq"
+	"go.megpoid.dev/go-skel/app/usecase"
+	"go.megpoid.dev/go-skel/pkg/task"
+)
+
+const (
+	TypeProfileImport = "profiles:import"
+)
+
+type ProfileImportPayload struct {
+	ImportID int64
+}
+
+func NewProfileImportTask(importID int64) (*asynq.Task, error) {
+	payload, err := json.Marshal(ProfileImportPayload{ImportID: importID})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeProfileImport, payload), nil
+}
+
+type ProfileImportTask struct {
+	importJob usecase.ProfileImportJob
+}
+
+func (process *ProfileImportTask) ProcessTask(ctx context.Context, t *asynq.Task) error {
+	var p ProfileImportPayload
+	if err := json.Unmarshal(t.Payload(), &p); err != nil {
+		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
+	}
+
+	err := process.importJob.Process(ctx, p.ImportID, func(current, total int64) error {
+		return task.ReportProgress(t, task.Progress{Current: current, Total: total, Message: "importing"})
+	})
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, usecase.ErrInvalidImport):
+		// the import is marked as failed, a new one has to be uploaded
+		return fmt.Errorf("failed to import profiles: %v: %w", err, asynq.SkipRetry)
+	}
+
+	// the transient errors are retried, the import only fails once the retries are exhausted
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+	if retried >= maxRetry {
+		if failErr := process.importJob.FailImport(context.WithoutCancel(ctx), p.ImportID, err); failErr != nil {
+			slog.ErrorContext(ctx, "Failed to mark the import as failed", slog.Int64("id", p.ImportID), slog.String("error", failErr.Error()))
+		}
+	}
+
+	return fmt.Errorf("failed to import profiles: %w", err)
+}
+
+func NewProfileImportProcessor(importJob usecase.ProfileImportJob) *ProfileImportTask {
+	return &ProfileImportTask{importJob: importJob}
+}
@@ -41,6 +41,10 @@ func (process *DelayTask) ProcessTask(ctx context.Context, t *asynq.Task) error
 		return fmt.Errorf("json.Unmarshal failed: %v: %w", err, asynq.SkipRetry)
 	}
 
+	if err := task.ReportProgress(t, task.Progress{Current: 0, Total: 1, Message: "waiting"}); err != nil {
+		return fmt.Errorf("failed to report progress: %w", err)
+	}
+
 	result, err := process.backgroundJob.Process(ctx, p.Delay)
 	if err != nil {
 		return fmt.Errorf("failed to run job: %w", err)
@@ -49,6 +53,7 @@ func (process *DelayTask) ProcessTask(ctx context.Context, t *asynq.Task) error
 	response := task.Response{
 		ContentType: "application/json",
 		Data:        result,
+		Progress:    &task.Progress{Current: 1, Total: 1},
 	}
 
 	encoder := json.NewEncoder(t.ResultWriter())
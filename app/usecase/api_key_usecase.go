@@ -0,0 +1,310 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository"
+	"go.megpoid.dev/go-skel/app/repository/uow"
+	"go.megpoid.dev/go-skel/pkg/apikey"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/clause"
+	pkgModel "go.megpoid.dev/go-skel/pkg/model"
+	"go.megpoid.dev/go-skel/pkg/principal"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/request"
+	"go.megpoid.dev/go-skel/pkg/response"
+)
+
+const (
+	// apiKeyCacheTTL is how long an authenticated key is trusted without checking the database again, a revoked key
+	// can still be used on the other servers during this time.
+	apiKeyCacheTTL = time.Minute
+	// apiKeyRejectTTL is how long a wrong secret of a known key is rejected without verifying it again
+	apiKeyRejectTTL = 10 * time.Second
+	// maxRejectedAPIKeys bounds the rejected lookups kept, so the clients can't grow the cache without limit
+	maxRejectedAPIKeys = 10000
+)
+
+// used to validate that the implementation matches the interface
+var _ APIKey = &APIKeyInteractor{}
+
+type APIKeyInteractor struct {
+	common
+	uow        uow.UnitOfWork
+	apiKeyRepo repository.APIKeyRepo
+	mu         sync.Mutex
+	cache      map[[sha256.Size]byte]*cachedAPIKey
+}
+
+// cachedAPIKey is an authenticated key, or a rejected one if the principal is nil. The cache is keyed by a fast
+// hash of the key so the expensive one only runs on a miss.
+type cachedAPIKey struct {
+	keyID     int64
+	principal *principal.Principal
+	expiresAt time.Time
+}
+
+// CreateKey saves a new key, the returned value is the only time the key is available. The key is owned by the
+// caller, only an admin can create keys for other owners.
+func (u *APIKeyInteractor) CreateKey(ctx context.Context, req *model.APIKeyRequest) (*model.APIKey, string, error) {
+	t := u.printer(ctx)
+
+	if caller, ok := principal.FromContext(ctx); ok && !caller.HasScope(principal.ScopeAdmin) {
+		if req.Owner == "" {
+			req.Owner = caller.Subject
+		}
+		if req.Owner != caller.Subject {
+			return nil, "", apperror.NewAuthzError(t.Sprintf("Cannot create API keys for another owner"), nil)
+		}
+		// the caller can't create a key with more permissions
+		if !caller.HasScopes(req.Scopes) {
+			return nil, "", apperror.NewAuthzError(t.Sprintf("Cannot grant scopes that the caller doesn't have"), nil)
+		}
+	}
+
+	if req.Owner == "" {
+		return nil, "", apperror.NewValidationError(t.Sprintf("The owner of the API key is required"), nil)
+	}
+
+	key, err := apikey.Generate()
+	if err != nil {
+		return nil, "", apperror.NewAppError(t.Sprintf("Failed to generate API key"), err)
+	}
+
+	hash, err := hashSecret(key.Value)
+	if err != nil {
+		return nil, "", apperror.NewAppError(t.Sprintf("Failed to generate API key"), err)
+	}
+
+	apiKey := req.APIKey(pkgModel.WithTime(u.currentTime()))
+	apiKey.Prefix = key.Prefix
+	apiKey.Hash = hash
+
+	if err := u.apiKeyRepo.Insert(ctx, apiKey); err != nil {
+		return nil, "", apperror.NewAppError(t.Sprintf("Failed to save API key"), err)
+	}
+
+	return apiKey, key.Value, nil
+}
+
+// ListKeys returns the keys of the caller, or the keys of every owner for an admin.
+func (u *APIKeyInteractor) ListKeys(ctx context.Context, query *request.QueryParams) (*response.ListResponse[*model.APIKey], error) {
+	t := u.printer(ctx)
+
+	result, err := u.apiKeyRepo.ListBy(ctx, ownedBy(ctx, repo.Ex{}), clause.WithFilter(query))
+	if err != nil {
+		return nil, apperror.NewAppError(t.Sprintf("Failed to list API keys"), err)
+	}
+
+	return result, nil
+}
+
+// RotateKey replaces the key, keeping its settings. The previous key stops working. The keys of other owners aren't
+// found unless the caller is an admin.
+func (u *APIKeyInteractor) RotateKey(ctx context.Context, id int64) (*model.APIKey, string, error) {
+	t := u.printer(ctx)
+
+	key, err := apikey.Generate()
+	if err != nil {
+		return nil, "", apperror.NewAppError(t.Sprintf("Failed to generate API key"), err)
+	}
+
+	hash, err := hashSecret(key.Value)
+	if err != nil {
+		return nil, "", apperror.NewAppError(t.Sprintf("Failed to generate API key"), err)
+	}
+
+	var apiKey *model.APIKey
+	err = u.uow.Do(ctx, func(uw uow.UnitOfWork) error {
+		apiKeyRepo := uw.Store().APIKeys()
+
+		current, err := apiKeyRepo.GetForUpdate(ctx, ownedBy(ctx, repo.Ex{"id": id}))
+		if err != nil {
+			return err
+		}
+		if current.RevokedAt != nil {
+			return errAPIKeyRevoked
+		}
+
+		now := u.currentTime()
+		err = apiKeyRepo.UpdateMap(ctx, id, map[string]any{
+			"prefix":     key.Prefix,
+			"hash":       hash,
+			"updated_at": now,
+		})
+		if err != nil {
+			return err
+		}
+
+		current.Prefix = key.Prefix
+		current.Hash = hash
+		current.UpdatedAt = now
+		apiKey = current
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errAPIKeyRevoked) {
+			return nil, "", apperror.NewUnprocessableError(t.Sprintf("API key was revoked"), err)
+		}
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, "", apperror.NewAppError(t.Sprintf("API key not found"), err)
+		}
+
+		return nil, "", apperror.NewAppError(t.Sprintf("Failed to rotate API key"), err)
+	}
+
+	u.invalidate(id)
+
+	return apiKey, key.Value, nil
+}
+
+// RevokeKey disables the key, it's kept so its usage can still be audited. The keys of other owners aren't found
+// unless the caller is an admin.
+func (u *APIKeyInteractor) RevokeKey(ctx context.Context, id int64) error {
+	t := u.printer(ctx)
+
+	apiKey, err := u.apiKeyRepo.GetBy(ctx, ownedBy(ctx, repo.Ex{"id": id}))
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return apperror.NewAppError(t.Sprintf("API key not found"), err)
+		}
+
+		return apperror.NewAppError(t.Sprintf("Failed to revoke API key"), err)
+	}
+
+	if apiKey.RevokedAt == nil {
+		now := u.currentTime()
+		err = u.apiKeyRepo.UpdateMap(ctx, id, map[string]any{
+			"revoked_at": now,
+			"updated_at": now,
+		})
+		if err != nil {
+			return apperror.NewAppError(t.Sprintf("Failed to revoke API key"), err)
+		}
+	}
+
+	u.invalidate(id)
+
+	return nil
+}
+
+// Authenticate returns the principal of an active key. The successful lookups are cached, and the last usage of
+// the key is only saved when the cache is refreshed. The wrong secrets of a known key are also cached for a while,
+// so repeating them doesn't run the expensive verification every time.
+func (u *APIKeyInteractor) Authenticate(ctx context.Context, key string) (*principal.Principal, error) {
+	t := u.printer(ctx)
+	now := u.currentTime()
+	sum := sha256.Sum256([]byte(key))
+
+	u.mu.Lock()
+	cached, ok := u.cache[sum]
+	u.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		if cached.principal == nil {
+			return nil, apperror.NewAuthnError(t.Sprintf("Invalid API key"), nil)
+		}
+		return cached.principal, nil
+	}
+
+	prefix, err := apikey.Prefix(key)
+	if err != nil {
+		return nil, apperror.NewAuthnError(t.Sprintf("Invalid API key"), err)
+	}
+
+	apiKey, err := u.apiKeyRepo.GetByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, apperror.NewAuthnError(t.Sprintf("Invalid API key"), err)
+		}
+
+		return nil, apperror.NewAppError(t.Sprintf("Failed to check API key"), err)
+	}
+
+	if !apiKey.Active(now) || !verifySecret(apiKey.Hash, key) {
+		u.mu.Lock()
+		u.sweep(now)
+		if len(u.cache) < maxRejectedAPIKeys {
+			u.cache[sum] = &cachedAPIKey{keyID: apiKey.ID, expiresAt: now.Add(apiKeyRejectTTL)}
+		}
+		u.mu.Unlock()
+
+		return nil, apperror.NewAuthnError(t.Sprintf("Invalid API key"), nil)
+	}
+
+	if err := u.apiKeyRepo.UpdateMap(ctx, apiKey.ID, map[string]any{"last_used_at": now}); err != nil {
+		slog.WarnContext(ctx, "Failed to save the API key usage", slog.Int64("id", apiKey.ID), slog.String("error", err.Error()))
+	}
+
+	p := &principal.Principal{
+		Type:    principal.TypeAPIKey,
+		ID:      strconv.FormatInt(apiKey.ID, 10),
+		Subject: apiKey.Owner,
+		Scopes:  apiKey.Scopes,
+	}
+
+	expiresAt := now.Add(apiKeyCacheTTL)
+	if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(expiresAt) {
+		expiresAt = *apiKey.ExpiresAt
+	}
+
+	u.mu.Lock()
+	u.sweep(now)
+	u.cache[sum] = &cachedAPIKey{keyID: apiKey.ID, principal: p, expiresAt: expiresAt}
+	u.mu.Unlock()
+
+	return p, nil
+}
+
+// invalidate removes the cached lookups of the key on this server.
+func (u *APIKeyInteractor) invalidate(id int64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for sum, cached := range u.cache {
+		if cached.keyID == id {
+			delete(u.cache, sum)
+		}
+	}
+}
+
+// sweep removes the expired lookups, must be called with the lock held.
+func (u *APIKeyInteractor) sweep(now time.Time) {
+	for sum, cached := range u.cache {
+		if !now.Before(cached.expiresAt) {
+			delete(u.cache, sum)
+		}
+	}
+}
+
+// ownedBy restricts the expression to the keys of the caller, unless it's an admin. The calls without a principal
+// come from the CLI and aren't restricted.
+func ownedBy(ctx context.Context, expr repo.Ex) repo.Ex {
+	if caller, ok := principal.FromContext(ctx); ok && !caller.HasScope(principal.ScopeAdmin) {
+		expr["owner"] = caller.Subject
+	}
+
+	return expr
+}
+
+var errAPIKeyRevoked = errors.New("api key revoked")
+
+func NewAPIKey(uow uow.UnitOfWork, opts ...Option) *APIKeyInteractor {
+	return &APIKeyInteractor{
+		common:     newCommon(opts...),
+		uow:        uow,
+		apiKeyRepo: uow.Store().APIKeys(),
+		cache:      make(map[[sha256.Size]byte]*cachedAPIKey),
+	}
+}
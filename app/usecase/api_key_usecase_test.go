@@ -0,0 +1,278 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	appmodel "go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository"
+	"go.megpoid.dev/go-skel/app/repository/uow"
+	"go.megpoid.dev/go-skel/pkg/apikey"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/model"
+	"go.megpoid.dev/go-skel/pkg/principal"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/response"
+)
+
+func newAPIKeyUsecase(t *testing.T, r *repository.MockAPIKeyRepo, opts ...Option) (*APIKeyInteractor, *uow.MockUnitOfWork) {
+	store := uow.NewMockUnitOfWorkStore(t)
+	store.EXPECT().APIKeys().Return(r)
+
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Store().Return(store)
+
+	return NewAPIKey(u, opts...), u
+}
+
+// storedKey returns a key and its stored record.
+func storedKey(t *testing.T) (string, *appmodel.APIKey) {
+	key, err := apikey.Generate()
+	require.NoError(t, err)
+	hash, err := hashSecret(key.Value)
+	require.NoError(t, err)
+
+	return key.Value, &appmodel.APIKey{
+		Model:  model.Model{ID: 1},
+		Owner:  "john",
+		Prefix: key.Prefix,
+		Hash:   hash,
+		Scopes: appmodel.Scopes{"read"},
+	}
+}
+
+func TestAPIKeyCreate(t *testing.T) {
+	r := repository.NewMockAPIKeyRepo(t)
+	r.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, key *appmodel.APIKey) error {
+		key.ID = 1
+		return nil
+	})
+	uc, _ := newAPIKeyUsecase(t, r)
+
+	apiKey, value, err := uc.CreateKey(context.Background(), &appmodel.APIKeyRequest{
+		Name:   "deploy",
+		Owner:  "john",
+		Scopes: []string{"read"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), apiKey.ID)
+	assert.Equal(t, appmodel.Scopes{"read"}, apiKey.Scopes)
+
+	prefix, err := apikey.Prefix(value)
+	require.NoError(t, err)
+	assert.Equal(t, prefix, apiKey.Prefix)
+	assert.NotContains(t, apiKey.Hash, value)
+	assert.True(t, verifySecret(apiKey.Hash, value))
+}
+
+func TestAPIKeyCreateMoreScopes(t *testing.T) {
+	uc, _ := newAPIKeyUsecase(t, repository.NewMockAPIKeyRepo(t))
+
+	ctx := principal.NewContext(context.Background(), &principal.Principal{Type: principal.TypeAPIKey, Subject: "john", Scopes: []string{"read"}})
+	_, _, err := uc.CreateKey(ctx, &appmodel.APIKeyRequest{Name: "admin", Owner: "john", Scopes: []string{"read", "admin"}})
+
+	var appErr *apperror.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+}
+
+func TestAPIKeyCreateOwner(t *testing.T) {
+	r := repository.NewMockAPIKeyRepo(t)
+	r.EXPECT().Insert(mock.Anything, mock.Anything).Return(nil)
+	uc, _ := newAPIKeyUsecase(t, r)
+
+	// the owner defaults to the caller
+	ctx := principal.NewContext(context.Background(), &principal.Principal{Type: principal.TypeUser, Subject: "john"})
+	apiKey, _, err := uc.CreateKey(ctx, &appmodel.APIKeyRequest{Name: "deploy"})
+	require.NoError(t, err)
+	assert.Equal(t, "john", apiKey.Owner)
+
+	_, _, err = uc.CreateKey(ctx, &appmodel.APIKeyRequest{Name: "deploy", Owner: "jane"})
+	var appErr *apperror.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusForbidden, appErr.StatusCode)
+
+	// an admin can create keys for other owners
+	admin := principal.NewContext(context.Background(), &principal.Principal{Type: principal.TypeUser, Subject: "root", Scopes: []string{principal.ScopeAdmin}})
+	apiKey, _, err = uc.CreateKey(admin, &appmodel.APIKeyRequest{Name: "deploy", Owner: "jane", Scopes: []string{"write"}})
+	require.NoError(t, err)
+	assert.Equal(t, "jane", apiKey.Owner)
+
+	// the CLI must set the owner
+	_, _, err = uc.CreateKey(context.Background(), &appmodel.APIKeyRequest{Name: "deploy"})
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+}
+
+func TestAPIKeyOwnership(t *testing.T) {
+	r := repository.NewMockAPIKeyRepo(t)
+	uc, u := newAPIKeyUsecase(t, r)
+	u.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn uow.UnitOfWorkBlock) error {
+		return fn(u)
+	})
+
+	ctx := principal.NewContext(context.Background(), &principal.Principal{Type: principal.TypeAPIKey, Subject: "jane"})
+	notFound := repo.NewRepoError(repo.ErrNotFound, nil)
+
+	r.EXPECT().ListBy(mock.Anything, repo.Ex{"owner": "jane"}, mock.Anything).Return(&response.ListResponse[*appmodel.APIKey]{}, nil)
+	_, err := uc.ListKeys(ctx, nil)
+	require.NoError(t, err)
+
+	// the keys of other owners aren't found
+	r.EXPECT().GetBy(mock.Anything, repo.Ex{"id": int64(1), "owner": "jane"}).Return(nil, notFound)
+	err = uc.RevokeKey(ctx, 1)
+	var appErr *apperror.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+
+	r.EXPECT().GetForUpdate(mock.Anything, repo.Ex{"id": int64(1), "owner": "jane"}).Return(nil, notFound)
+	_, _, err = uc.RotateKey(ctx, 1)
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+
+	// an admin sees the keys of every owner
+	admin := principal.NewContext(context.Background(), &principal.Principal{Type: principal.TypeUser, Subject: "root", Scopes: []string{principal.ScopeAdmin}})
+	r.EXPECT().ListBy(mock.Anything, repo.Ex{}, mock.Anything).Return(&response.ListResponse[*appmodel.APIKey]{}, nil)
+	_, err = uc.ListKeys(admin, nil)
+	require.NoError(t, err)
+}
+
+func TestAPIKeyAuthenticate(t *testing.T) {
+	value, stored := storedKey(t)
+
+	now := time.Now()
+	r := repository.NewMockAPIKeyRepo(t)
+	r.EXPECT().GetByPrefix(mock.Anything, stored.Prefix).Return(stored, nil).Once()
+	r.EXPECT().UpdateMap(mock.Anything, int64(1), map[string]any{"last_used_at": now}).Return(nil).Once()
+	uc, _ := newAPIKeyUsecase(t, r, WithTime(func() time.Time { return now }))
+
+	p, err := uc.Authenticate(context.Background(), value)
+	require.NoError(t, err)
+	assert.Equal(t, &principal.Principal{Type: principal.TypeAPIKey, ID: "1", Subject: "john", Scopes: []string{"read"}}, p)
+
+	// cached, the repository isn't called again
+	cached, err := uc.Authenticate(context.Background(), value)
+	require.NoError(t, err)
+	assert.Same(t, p, cached)
+}
+
+func TestAPIKeyAuthenticateInvalid(t *testing.T) {
+	value, stored := storedKey(t)
+	other, _ := storedKey(t)
+	other = stored.Prefix + other[len(stored.Prefix):]
+
+	now := time.Now()
+	revoked, expired := *stored, *stored
+	revoked.RevokedAt = &now
+	expired.ExpiresAt = &now
+
+	r := repository.NewMockAPIKeyRepo(t)
+	r.EXPECT().GetByPrefix(mock.Anything, stored.Prefix).Return(stored, nil).Once()
+	r.EXPECT().GetByPrefix(mock.Anything, stored.Prefix).Return(&revoked, nil).Once()
+	r.EXPECT().GetByPrefix(mock.Anything, stored.Prefix).Return(&expired, nil).Once()
+	r.EXPECT().GetByPrefix(mock.Anything, "sk_000000000000").Return(nil, repo.NewRepoError(repo.ErrNotFound, nil)).Once()
+	current := now
+	uc, _ := newAPIKeyUsecase(t, r, WithTime(func() time.Time { return current }))
+
+	for _, key := range []string{other, value, value, "sk_000000000000_secret", "secret"} {
+		// the rejected keys are cached for a while
+		current = current.Add(apiKeyRejectTTL)
+		_, err := uc.Authenticate(context.Background(), key)
+
+		var appErr *apperror.Error
+		require.ErrorAs(t, err, &appErr, key)
+		assert.Equal(t, http.StatusUnauthorized, appErr.StatusCode, key)
+	}
+}
+
+func TestAPIKeyAuthenticateRejectedCached(t *testing.T) {
+	_, stored := storedKey(t)
+	other, _ := storedKey(t)
+	other = stored.Prefix + other[len(stored.Prefix):]
+
+	now := time.Now()
+	r := repository.NewMockAPIKeyRepo(t)
+	r.EXPECT().GetByPrefix(mock.Anything, stored.Prefix).Return(stored, nil).Once()
+	uc, _ := newAPIKeyUsecase(t, r, WithTime(func() time.Time { return now }))
+
+	// the wrong secret is only verified once
+	for range 3 {
+		_, err := uc.Authenticate(context.Background(), other)
+
+		var appErr *apperror.Error
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, http.StatusUnauthorized, appErr.StatusCode)
+	}
+}
+
+func TestAPIKeyRevoke(t *testing.T) {
+	value, stored := storedKey(t)
+
+	now := time.Now()
+	r := repository.NewMockAPIKeyRepo(t)
+	r.EXPECT().GetByPrefix(mock.Anything, stored.Prefix).Return(stored, nil).Once()
+	r.EXPECT().UpdateMap(mock.Anything, int64(1), map[string]any{"last_used_at": now}).Return(nil).Once()
+	r.EXPECT().GetBy(mock.Anything, repo.Ex{"id": int64(1)}).Return(stored, nil)
+	r.EXPECT().UpdateMap(mock.Anything, int64(1), map[string]any{"revoked_at": now, "updated_at": now}).Return(nil).Once()
+	uc, _ := newAPIKeyUsecase(t, r, WithTime(func() time.Time { return now }))
+
+	_, err := uc.Authenticate(context.Background(), value)
+	require.NoError(t, err)
+
+	require.NoError(t, uc.RevokeKey(context.Background(), 1))
+
+	// the cached lookup is removed, the key is checked again
+	revoked := *stored
+	revoked.RevokedAt = &now
+	r.EXPECT().GetByPrefix(mock.Anything, stored.Prefix).Return(&revoked, nil).Once()
+	_, err = uc.Authenticate(context.Background(), value)
+	assert.Error(t, err)
+}
+
+func TestAPIKeyRotate(t *testing.T) {
+	_, stored := storedKey(t)
+
+	now := time.Now()
+	r := repository.NewMockAPIKeyRepo(t)
+	uc, u := newAPIKeyUsecase(t, r, WithTime(func() time.Time { return now }))
+	u.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn uow.UnitOfWorkBlock) error {
+		return fn(u)
+	})
+	r.EXPECT().GetForUpdate(mock.Anything, repo.Ex{"id": int64(1)}).Return(stored, nil)
+	r.EXPECT().UpdateMap(mock.Anything, int64(1), mock.Anything).Return(nil)
+
+	previous := stored.Prefix
+	apiKey, value, err := uc.RotateKey(context.Background(), 1)
+	require.NoError(t, err)
+	assert.NotEqual(t, previous, apiKey.Prefix)
+	assert.Equal(t, now, apiKey.UpdatedAt)
+	assert.True(t, verifySecret(apiKey.Hash, value))
+}
+
+func TestAPIKeyRotateRevoked(t *testing.T) {
+	_, stored := storedKey(t)
+	now := time.Now()
+	stored.RevokedAt = &now
+
+	r := repository.NewMockAPIKeyRepo(t)
+	uc, u := newAPIKeyUsecase(t, r)
+	u.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn uow.UnitOfWorkBlock) error {
+		return fn(u)
+	})
+	r.EXPECT().GetForUpdate(mock.Anything, repo.Ex{"id": int64(1)}).Return(stored, nil)
+
+	_, _, err := uc.RotateKey(context.Background(), 1)
+
+	var appErr *apperror.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusUnprocessableEntity, appErr.StatusCode)
+}
@@ -6,53 +6,466 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/golang-jwt/jwt/v5"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository/uow"
 	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/crypto"
+	"go.megpoid.dev/go-skel/pkg/jwks"
+	pkgModel "go.megpoid.dev/go-skel/pkg/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
 )
 
+// refreshTokenSize is the number of random bytes of the refresh tokens
+const refreshTokenSize = 32
+
 // used to validate that the implementation matches the interface
 var _ Auth = &AuthInteractor{}
 
 type JwtCustomClaims struct {
 	jwt.RegisteredClaims
-	User string `json:"user"`
+	UserID string   `json:"user_id"`
+	User   string   `json:"user"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// Lockout disables the login of an account after MaxAttempts consecutive failures, for the given Duration. Zero
+// attempts disable the lockout.
+type Lockout struct {
+	MaxAttempts int
+	Duration    time.Duration
+}
+
+// AuthSettings configure the tokens issued by the login and the lockout of the accounts.
+type AuthSettings struct {
+	Keys            jwks.Keys
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	Lockout         Lockout
 }
 
 type AuthInteractor struct {
 	common
-	jwtSecret []byte
+	uow      uow.UnitOfWork
+	settings AuthSettings
 }
 
-func NewAuth(jwtSecret []byte) *AuthInteractor {
-	return &AuthInteractor{
-		common:    newCommon(),
-		jwtSecret: jwtSecret,
+// dummyHash is verified when the user doesn't exist, so the login takes the same time and doesn't reveal which
+// usernames are registered.
+var dummyHash = sync.OnceValue(func() string {
+	hash, err := hashSecret(rand.Text())
+	if err != nil {
+		panic(err)
 	}
-}
+	return hash
+})
 
-func (uc *AuthInteractor) Login(ctx context.Context, username, password string) (string, error) {
+// Login verifies the password of the user and returns an access token with its ID and a refresh token that starts
+// a new family. Every attempt of a known or unknown user is audited.
+func (uc *AuthInteractor) Login(ctx context.Context, username, password, ipAddress string) (*model.AuthTokens, error) {
 	t := uc.printer(ctx)
 
-	// TODO: really verify username/password
 	if len(username) == 0 || len(password) == 0 {
-		return "", apperror.NewAuthnError(t.Sprintf("Invalid username or password"), nil)
+		return nil, apperror.NewAuthnError(t.Sprintf("Invalid username or password"), nil)
+	}
+
+	now := uc.currentTime()
+	event := &model.AuditEvent{
+		CreatedAt: now,
+		Action:    model.AuditActionLogin,
+		Username:  model.NormalizeUsername(username),
+		IPAddress: ipAddress,
+	}
+
+	var user *model.User
+	var refreshToken string
+	err := uc.uow.Do(ctx, func(uw uow.UnitOfWork) error {
+		userRepo := uw.Store().Users()
+
+		var err error
+		user, err = userRepo.GetForUpdate(ctx, repo.Ex{"username": event.Username})
+		if err != nil {
+			if !errors.Is(err, repo.ErrNotFound) {
+				return err
+			}
+
+			verifySecret(dummyHash(), password)
+			event.Reason = model.AuditReasonInvalidCredentials
+			return uw.Store().AuditEvents().Insert(ctx, event)
+		}
+
+		event.UserID = &user.ID
+
+		switch {
+		case user.Locked(now):
+			// checked like any other login, so the response doesn't tell the locked accounts apart
+			verifySecret(user.PasswordHash, password)
+			event.Reason = model.AuditReasonLocked
+		case !verifySecret(user.PasswordHash, password):
+			event.Reason = model.AuditReasonInvalidCredentials
+			err = userRepo.UpdateMap(ctx, user.ID, uc.failedLogin(user, now))
+		default:
+			event.Success = true
+			err = userRepo.UpdateMap(ctx, user.ID, map[string]any{
+				"failed_logins": 0,
+				"locked_until":  nil,
+				"last_login_at": now,
+			})
+			if err == nil {
+				refreshToken, err = uc.newRefreshToken(ctx, uw, user.ID, uuid.Must(uuid.NewV4()).String(), now)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		return uw.Store().AuditEvents().Insert(ctx, event)
+	})
+	if err != nil {
+		return nil, apperror.NewAppError(t.Sprintf("Failed to log in"), err)
+	}
+
+	slog.InfoContext(ctx, "Login attempt",
+		slog.String("username", event.Username),
+		slog.Bool("success", event.Success),
+		slog.String("reason", event.Reason),
+		slog.String("ip", ipAddress),
+	)
+
+	// the locked accounts get the same error, only the audit event has the reason
+	if !event.Success {
+		return nil, apperror.NewAuthnError(t.Sprintf("Invalid username or password"), nil)
+	}
+
+	return uc.issueTokens(ctx, user, refreshToken, now)
+}
+
+// Refresh exchanges a refresh token for a new pair of tokens, the used token can't be exchanged again. A token
+// presented after it was rotated may have been stolen, so every token of its family is revoked.
+func (uc *AuthInteractor) Refresh(ctx context.Context, refreshToken, ipAddress string) (*model.AuthTokens, error) {
+	t := uc.printer(ctx)
+
+	now := uc.currentTime()
+	var user *model.User
+	var reused bool
+	var rotated string
+	err := uc.uow.Do(ctx, func(uw uow.UnitOfWork) error {
+		tokenRepo := uw.Store().RefreshTokens()
+
+		current, err := tokenRepo.GetByHashForUpdate(ctx, hashToken(refreshToken))
+		if err != nil {
+			return err
+		}
+
+		if current.RevokedAt != nil || !now.Before(current.ExpiresAt) {
+			return errTokenInvalid
+		}
+
+		if current.UsedAt != nil {
+			reused = true
+			if _, err := uc.revokeFamilies(ctx, uw, repo.Ex{"family_id": current.FamilyID}, now); err != nil {
+				return err
+			}
+
+			return uw.Store().AuditEvents().Insert(ctx, &model.AuditEvent{
+				CreatedAt: now,
+				Action:    model.AuditActionRefresh,
+				UserID:    &current.UserID,
+				IPAddress: ipAddress,
+				Reason:    model.AuditReasonTokenReused,
+			})
+		}
+
+		if err := tokenRepo.UpdateMap(ctx, current.ID, map[string]any{"used_at": now}); err != nil {
+			return err
+		}
+
+		user, err = uw.Store().Users().Get(ctx, current.UserID)
+		if err != nil {
+			return err
+		}
+
+		rotated, err = uc.newRefreshToken(ctx, uw, user.ID, current.FamilyID, now)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) || errors.Is(err, errTokenInvalid) {
+			return nil, apperror.NewAuthnError(t.Sprintf("Invalid refresh token"), err)
+		}
+
+		return nil, apperror.NewAppError(t.Sprintf("Failed to refresh token"), err)
+	}
+
+	if reused {
+		slog.WarnContext(ctx, "Refresh token reused, revoked its family", slog.String("ip", ipAddress))
+		return nil, apperror.NewAuthnError(t.Sprintf("Invalid refresh token"), nil)
+	}
+
+	return uc.issueTokens(ctx, user, rotated, now)
+}
+
+// Logout revokes the access token of the session until it expires, and the family of the refresh token if it
+// belongs to the same user.
+func (uc *AuthInteractor) Logout(ctx context.Context, session *model.Session, refreshToken, ipAddress string) error {
+	t := uc.printer(ctx)
+
+	now := uc.currentTime()
+	err := uc.uow.Do(ctx, func(uw uow.UnitOfWork) error {
+		if session.TokenID != "" {
+			err := uw.Store().RevokedTokens().Insert(ctx, &model.RevokedToken{
+				TokenID:   session.TokenID,
+				ExpiresAt: session.ExpiresAt,
+			})
+			if err != nil && !errors.Is(err, repo.ErrDuplicated) {
+				return err
+			}
+		}
+
+		if refreshToken != "" {
+			current, err := uw.Store().RefreshTokens().GetByHashForUpdate(ctx, hashToken(refreshToken))
+			switch {
+			case errors.Is(err, repo.ErrNotFound):
+			case err != nil:
+				return err
+			case current.UserID == session.UserID:
+				if _, err := uc.revokeFamilies(ctx, uw, repo.Ex{"family_id": current.FamilyID}, now); err != nil {
+					return err
+				}
+			}
+		}
+
+		return uw.Store().AuditEvents().Insert(ctx, &model.AuditEvent{
+			CreatedAt: now,
+			Action:    model.AuditActionLogout,
+			Success:   true,
+			UserID:    &session.UserID,
+			IPAddress: ipAddress,
+		})
+	})
+	if err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to log out"), err)
+	}
+
+	return nil
+}
+
+// TokenRevoked returns true if the access token was revoked by a logout.
+func (uc *AuthInteractor) TokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	return uc.uow.Store().RevokedTokens().IsRevoked(ctx, tokenID)
+}
+
+// DeleteExpiredTokens removes the expired refresh tokens and the revoked access tokens that already expired.
+func (uc *AuthInteractor) DeleteExpiredTokens(ctx context.Context) (int64, error) {
+	expired := repo.Ex{"expires_at": repo.Op{"lte": uc.currentTime()}}
+
+	refreshTokens, err := uc.uow.Store().RefreshTokens().DeleteBy(ctx, expired)
+	if err != nil {
+		return 0, err
+	}
+
+	revokedTokens, err := uc.uow.Store().RevokedTokens().DeleteBy(ctx, expired)
+	if err != nil {
+		return refreshTokens, err
 	}
 
+	return refreshTokens + revokedTokens, nil
+}
+
+// newRefreshToken saves a new refresh token of the family and returns it.
+func (uc *AuthInteractor) newRefreshToken(ctx context.Context, uw uow.UnitOfWork, userID int64, familyID string, now time.Time) (string, error) {
+	key, err := crypto.GenerateRandomKey(refreshTokenSize)
+	if err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(key)
+
+	err = uw.Store().RefreshTokens().Insert(ctx, &model.RefreshToken{
+		CreatedAt: now,
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hashToken(token),
+		ExpiresAt: now.Add(uc.settings.RefreshTokenTTL),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// revokeFamilies revokes all the refresh tokens of the families that match the expression.
+func (uc *AuthInteractor) revokeFamilies(ctx context.Context, uw uow.UnitOfWork, expr repo.Ex, now time.Time) (int64, error) {
+	expr["revoked_at"] = nil
+	return uw.Store().RefreshTokens().UpdateMapBy(ctx, map[string]any{"revoked_at": now}, expr)
+}
+
+// issueTokens signs an access token for the user, identified by a random jti so it can be revoked.
+func (uc *AuthInteractor) issueTokens(ctx context.Context, user *model.User, refreshToken string, now time.Time) (*model.AuthTokens, error) {
+	t := uc.printer(ctx)
+
+	expiresAt := now.Add(uc.settings.AccessTokenTTL)
 	claims := JwtCustomClaims{
-		User: username,
+		UserID: strconv.FormatInt(user.ID, 10),
+		User:   user.Username,
+		Scopes: user.Scopes,
 		RegisteredClaims: jwt.RegisteredClaims{
-			// create a jwt token that expires in 24 hours
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * 24)),
+			ID:        uuid.Must(uuid.NewV4()).String(),
+			Subject:   strconv.FormatInt(user.ID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	s, err := token.SignedString(uc.jwtSecret)
+	s, err := uc.settings.Keys.Sign(claims)
 	if err != nil {
-		return "", apperror.NewAppError(t.Sprintf("Failed to sign token"), err)
+		return nil, apperror.NewAppError(t.Sprintf("Failed to sign token"), err)
 	}
 
-	return s, nil
+	return &model.AuthTokens{
+		AccessToken:  s,
+		RefreshToken: refreshToken,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
+
+// failedLogin returns the changes of the user after a wrong password, locking the account if it reached the
+// maximum number of attempts.
+func (uc *AuthInteractor) failedLogin(user *model.User, now time.Time) map[string]any {
+	attempts := user.FailedLogins + 1
+	if uc.settings.Lockout.MaxAttempts > 0 && attempts >= uc.settings.Lockout.MaxAttempts {
+		return map[string]any{
+			"failed_logins": 0,
+			"locked_until":  now.Add(uc.settings.Lockout.Duration),
+		}
+	}
+
+	return map[string]any{"failed_logins": attempts}
+}
+
+// Register creates a user with the hash of its password.
+func (uc *AuthInteractor) Register(ctx context.Context, req *model.UserRequest, ipAddress string) (*model.User, error) {
+	t := uc.printer(ctx)
+
+	hash, err := hashSecret(req.Password)
+	if err != nil {
+		return nil, apperror.NewAppError(t.Sprintf("Failed to register user"), err)
+	}
+
+	user := req.User(pkgModel.WithTime(uc.currentTime()))
+	user.PasswordHash = hash
+
+	err = uc.uow.Do(ctx, func(uw uow.UnitOfWork) error {
+		if err := uw.Store().Users().Insert(ctx, user); err != nil {
+			return err
+		}
+
+		return uw.Store().AuditEvents().Insert(ctx, &model.AuditEvent{
+			CreatedAt: user.CreatedAt,
+			Action:    model.AuditActionRegister,
+			Success:   true,
+			UserID:    &user.ID,
+			Username:  user.Username,
+			IPAddress: ipAddress,
+		})
+	})
+	if err != nil {
+		if errors.Is(err, repo.ErrDuplicated) {
+			return nil, apperror.NewAppError(t.Sprintf("Username is already registered"), err)
+		}
+
+		return nil, apperror.NewAppError(t.Sprintf("Failed to register user"), err)
+	}
+
+	return user, nil
+}
+
+// ChangePassword replaces the password of the user after verifying the current one. A successful change also
+// unlocks the account and revokes its refresh tokens.
+func (uc *AuthInteractor) ChangePassword(ctx context.Context, userID int64, req *model.PasswordChangeRequest, ipAddress string) error {
+	t := uc.printer(ctx)
+
+	hash, err := hashSecret(req.NewPassword)
+	if err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to change password"), err)
+	}
+
+	now := uc.currentTime()
+	event := &model.AuditEvent{
+		CreatedAt: now,
+		Action:    model.AuditActionPasswordChange,
+		UserID:    &userID,
+		IPAddress: ipAddress,
+	}
+
+	err = uc.uow.Do(ctx, func(uw uow.UnitOfWork) error {
+		userRepo := uw.Store().Users()
+
+		user, err := userRepo.GetForUpdate(ctx, repo.Ex{"id": userID})
+		if err != nil {
+			return err
+		}
+		event.Username = user.Username
+
+		if verifySecret(user.PasswordHash, req.CurrentPassword) {
+			event.Success = true
+			err = userRepo.UpdateMap(ctx, userID, map[string]any{
+				"password_hash": hash,
+				"failed_logins": 0,
+				"locked_until":  nil,
+				"updated_at":    now,
+			})
+			if err != nil {
+				return err
+			}
+
+			// the other sessions must log in again with the new password
+			if _, err := uc.revokeFamilies(ctx, uw, repo.Ex{"user_id": userID}, now); err != nil {
+				return err
+			}
+		} else {
+			event.Reason = model.AuditReasonInvalidCredentials
+		}
+
+		return uw.Store().AuditEvents().Insert(ctx, event)
+	})
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return apperror.NewAppError(t.Sprintf("User not found"), err)
+		}
+
+		return apperror.NewAppError(t.Sprintf("Failed to change password"), err)
+	}
+
+	if !event.Success {
+		return apperror.NewAuthnError(t.Sprintf("Invalid password"), nil)
+	}
+
+	return nil
+}
+
+var errTokenInvalid = errors.New("token revoked or expired")
+
+// hashToken returns the hash of a random token, it doesn't need a slow hash since it can't be guessed.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func NewAuth(uow uow.UnitOfWork, settings AuthSettings, opts ...Option) *AuthInteractor {
+	return &AuthInteractor{
+		common:   newCommon(opts...),
+		uow:      uow,
+		settings: settings,
+	}
 }
@@ -0,0 +1,320 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	appmodel "go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository"
+	"go.megpoid.dev/go-skel/app/repository/uow"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/jwks"
+	"go.megpoid.dev/go-skel/pkg/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+)
+
+var testJwtSecret = []byte("0123456789abcdef0123456789abcdef")
+
+type authRepos struct {
+	users         *repository.MockUserRepo
+	audit         *repository.MockAuditEventRepo
+	refreshTokens *repository.MockRefreshTokenRepo
+	revokedTokens *repository.MockRevokedTokenRepo
+}
+
+func newAuthUsecase(t *testing.T, now time.Time) (*AuthInteractor, *authRepos) {
+	r := &authRepos{
+		users:         repository.NewMockUserRepo(t),
+		audit:         repository.NewMockAuditEventRepo(t),
+		refreshTokens: repository.NewMockRefreshTokenRepo(t),
+		revokedTokens: repository.NewMockRevokedTokenRepo(t),
+	}
+
+	store := uow.NewMockUnitOfWorkStore(t)
+	store.EXPECT().Users().Return(r.users).Maybe()
+	store.EXPECT().AuditEvents().Return(r.audit).Maybe()
+	store.EXPECT().RefreshTokens().Return(r.refreshTokens).Maybe()
+	store.EXPECT().RevokedTokens().Return(r.revokedTokens).Maybe()
+
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Store().Return(store).Maybe()
+	u.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn uow.UnitOfWorkBlock) error {
+		return fn(u)
+	}).Maybe()
+
+	uc := NewAuth(u, AuthSettings{
+		Keys:            jwks.Secret(testJwtSecret),
+		AccessTokenTTL:  time.Minute,
+		RefreshTokenTTL: time.Hour,
+		Lockout:         Lockout{MaxAttempts: 3, Duration: time.Minute},
+	}, WithTime(func() time.Time { return now }))
+	return uc, r
+}
+
+func storedUser(t *testing.T, password string) *appmodel.User {
+	hash, err := hashSecret(password)
+	require.NoError(t, err)
+
+	return &appmodel.User{Model: model.Model{ID: 1}, Username: "john", PasswordHash: hash}
+}
+
+// expectAudit expects an audit event and returns a copy of it, filled when the event is saved.
+func expectAudit(audit *repository.MockAuditEventRepo) *appmodel.AuditEvent {
+	saved := &appmodel.AuditEvent{}
+	audit.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, event *appmodel.AuditEvent) error {
+		*saved = *event
+		return nil
+	}).Once()
+	return saved
+}
+
+func requireStatus(t *testing.T, err error, status int) {
+	t.Helper()
+
+	var appErr *apperror.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, status, appErr.StatusCode)
+}
+
+func TestAuthLogin(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	uc, r := newAuthUsecase(t, now)
+
+	user := storedUser(t, "password123")
+	user.FailedLogins = 2
+	r.users.EXPECT().GetForUpdate(mock.Anything, repo.Ex{"username": "john"}).Return(user, nil)
+	r.users.EXPECT().UpdateMap(mock.Anything, int64(1), map[string]any{
+		"failed_logins": 0,
+		"locked_until":  nil,
+		"last_login_at": now,
+	}).Return(nil)
+	var saved *appmodel.RefreshToken
+	r.refreshTokens.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, token *appmodel.RefreshToken) error {
+		saved = token
+		return nil
+	})
+	event := expectAudit(r.audit)
+
+	tokens, err := uc.Login(context.Background(), "John", "password123", "192.0.2.1")
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(time.Minute), tokens.ExpiresAt)
+
+	// only the hash of the refresh token is stored
+	assert.Equal(t, hashToken(tokens.RefreshToken), saved.TokenHash)
+	assert.Equal(t, now.Add(time.Hour), saved.ExpiresAt)
+	assert.NotEmpty(t, saved.FamilyID)
+
+	claims := &JwtCustomClaims{}
+	_, err = jwt.ParseWithClaims(tokens.AccessToken, claims, func(*jwt.Token) (any, error) { return testJwtSecret, nil })
+	require.NoError(t, err)
+	assert.NotEmpty(t, claims.ID)
+	assert.Equal(t, "1", claims.UserID)
+	assert.Equal(t, "1", claims.Subject)
+	assert.Equal(t, "john", claims.User)
+
+	assert.True(t, event.Success)
+	assert.Equal(t, appmodel.AuditActionLogin, event.Action)
+	assert.Equal(t, int64(1), *event.UserID)
+	assert.Equal(t, "192.0.2.1", event.IPAddress)
+}
+
+func TestAuthLoginWrongPassword(t *testing.T) {
+	now := time.Now()
+	uc, r := newAuthUsecase(t, now)
+
+	user := storedUser(t, "password123")
+	r.users.EXPECT().GetForUpdate(mock.Anything, repo.Ex{"username": "john"}).Return(user, nil)
+	r.users.EXPECT().UpdateMap(mock.Anything, int64(1), map[string]any{"failed_logins": 1}).Return(nil)
+	event := expectAudit(r.audit)
+
+	_, err := uc.Login(context.Background(), "john", "wrong", "")
+	requireStatus(t, err, http.StatusUnauthorized)
+	assert.False(t, event.Success)
+	assert.Equal(t, appmodel.AuditReasonInvalidCredentials, event.Reason)
+}
+
+func TestAuthLoginLockout(t *testing.T) {
+	now := time.Now()
+	uc, r := newAuthUsecase(t, now)
+
+	// the last allowed failure locks the account
+	user := storedUser(t, "password123")
+	user.FailedLogins = 2
+	r.users.EXPECT().GetForUpdate(mock.Anything, repo.Ex{"username": "john"}).Return(user, nil).Once()
+	r.users.EXPECT().UpdateMap(mock.Anything, int64(1), map[string]any{
+		"failed_logins": 0,
+		"locked_until":  now.Add(time.Minute),
+	}).Return(nil)
+	expectAudit(r.audit)
+
+	_, err := uc.Login(context.Background(), "john", "wrong", "")
+	requireStatus(t, err, http.StatusUnauthorized)
+
+	// a locked account can't log in even with the right password
+	lockedUntil := now.Add(time.Minute)
+	locked := storedUser(t, "password123")
+	locked.LockedUntil = &lockedUntil
+	r.users.EXPECT().GetForUpdate(mock.Anything, repo.Ex{"username": "john"}).Return(locked, nil).Once()
+	event := expectAudit(r.audit)
+
+	_, err = uc.Login(context.Background(), "john", "password123", "")
+	requireStatus(t, err, http.StatusUnauthorized)
+	assert.Equal(t, appmodel.AuditReasonLocked, event.Reason)
+
+	// the response doesn't reveal the lockout
+	var appErr *apperror.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, "Invalid username or password", appErr.Message)
+}
+
+func TestAuthLoginUnknownUser(t *testing.T) {
+	uc, r := newAuthUsecase(t, time.Now())
+
+	r.users.EXPECT().GetForUpdate(mock.Anything, repo.Ex{"username": "jane"}).Return(nil, repo.NewRepoError(repo.ErrNotFound, nil))
+	event := expectAudit(r.audit)
+
+	_, err := uc.Login(context.Background(), "jane", "password123", "")
+	requireStatus(t, err, http.StatusUnauthorized)
+	assert.Nil(t, event.UserID)
+	assert.Equal(t, "jane", event.Username)
+	assert.Equal(t, appmodel.AuditReasonInvalidCredentials, event.Reason)
+
+	// empty credentials aren't looked up
+	_, err = uc.Login(context.Background(), "", "", "")
+	requireStatus(t, err, http.StatusUnauthorized)
+}
+
+func TestAuthRegister(t *testing.T) {
+	uc, r := newAuthUsecase(t, time.Now())
+
+	r.users.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, user *appmodel.User) error {
+		user.ID = 1
+		return nil
+	})
+	event := expectAudit(r.audit)
+
+	user, err := uc.Register(context.Background(), &appmodel.UserRequest{Username: "John", Password: "password123"}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "john", user.Username)
+	assert.True(t, verifySecret(user.PasswordHash, "password123"))
+	assert.Equal(t, appmodel.AuditActionRegister, event.Action)
+	assert.Equal(t, int64(1), *event.UserID)
+}
+
+func TestAuthChangePassword(t *testing.T) {
+	now := time.Now()
+	uc, r := newAuthUsecase(t, now)
+
+	r.users.EXPECT().GetForUpdate(mock.Anything, repo.Ex{"id": int64(1)}).Return(storedUser(t, "password123"), nil)
+	r.users.EXPECT().UpdateMap(mock.Anything, int64(1), mock.Anything).RunAndReturn(func(ctx context.Context, id int64, changes map[string]any) error {
+		assert.True(t, verifySecret(changes["password_hash"].(string), "password456"))
+		assert.Nil(t, changes["locked_until"])
+		return nil
+	}).Once()
+	r.refreshTokens.EXPECT().UpdateMapBy(mock.Anything, map[string]any{"revoked_at": now}, repo.Ex{"user_id": int64(1), "revoked_at": nil}).
+		Return(2, nil).Once()
+	expectAudit(r.audit)
+
+	err := uc.ChangePassword(context.Background(), 1, &appmodel.PasswordChangeRequest{
+		CurrentPassword: "password123",
+		NewPassword:     "password456",
+	}, "")
+	require.NoError(t, err)
+
+	// a wrong current password is audited and nothing changes
+	event := expectAudit(r.audit)
+	err = uc.ChangePassword(context.Background(), 1, &appmodel.PasswordChangeRequest{
+		CurrentPassword: "wrong",
+		NewPassword:     "password456",
+	}, "")
+	requireStatus(t, err, http.StatusUnauthorized)
+	assert.False(t, event.Success)
+}
+
+func TestAuthRefresh(t *testing.T) {
+	now := time.Now()
+	uc, r := newAuthUsecase(t, now)
+
+	current := &appmodel.RefreshToken{ID: 1, UserID: 1, FamilyID: "family", ExpiresAt: now.Add(time.Hour)}
+	r.refreshTokens.EXPECT().GetByHashForUpdate(mock.Anything, hashToken("token")).Return(current, nil)
+	r.refreshTokens.EXPECT().UpdateMap(mock.Anything, int64(1), map[string]any{"used_at": now}).Return(nil)
+	r.users.EXPECT().Get(mock.Anything, int64(1)).Return(&appmodel.User{Model: model.Model{ID: 1}, Username: "john"}, nil)
+
+	var rotated *appmodel.RefreshToken
+	r.refreshTokens.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, token *appmodel.RefreshToken) error {
+		rotated = token
+		return nil
+	})
+
+	tokens, err := uc.Refresh(context.Background(), "token", "")
+	require.NoError(t, err)
+	assert.NotEqual(t, "token", tokens.RefreshToken)
+	assert.Equal(t, hashToken(tokens.RefreshToken), rotated.TokenHash)
+	assert.Equal(t, "family", rotated.FamilyID)
+	assert.NotEmpty(t, tokens.AccessToken)
+}
+
+func TestAuthRefreshReused(t *testing.T) {
+	now := time.Now()
+	uc, r := newAuthUsecase(t, now)
+
+	usedAt := now.Add(-time.Minute)
+	used := &appmodel.RefreshToken{ID: 1, UserID: 1, FamilyID: "family", ExpiresAt: now.Add(time.Hour), UsedAt: &usedAt}
+	r.refreshTokens.EXPECT().GetByHashForUpdate(mock.Anything, hashToken("token")).Return(used, nil)
+	r.refreshTokens.EXPECT().UpdateMapBy(mock.Anything, map[string]any{"revoked_at": now}, repo.Ex{"family_id": "family", "revoked_at": nil}).
+		Return(3, nil).Once()
+	event := expectAudit(r.audit)
+
+	_, err := uc.Refresh(context.Background(), "token", "")
+	requireStatus(t, err, http.StatusUnauthorized)
+	assert.Equal(t, appmodel.AuditReasonTokenReused, event.Reason)
+}
+
+func TestAuthRefreshInvalid(t *testing.T) {
+	now := time.Now()
+	uc, r := newAuthUsecase(t, now)
+
+	revoked := &appmodel.RefreshToken{ID: 1, UserID: 1, ExpiresAt: now.Add(time.Hour), RevokedAt: &now}
+	expired := &appmodel.RefreshToken{ID: 2, UserID: 1, ExpiresAt: now}
+	r.refreshTokens.EXPECT().GetByHashForUpdate(mock.Anything, hashToken("revoked")).Return(revoked, nil)
+	r.refreshTokens.EXPECT().GetByHashForUpdate(mock.Anything, hashToken("expired")).Return(expired, nil)
+	r.refreshTokens.EXPECT().GetByHashForUpdate(mock.Anything, hashToken("unknown")).Return(nil, repo.NewRepoError(repo.ErrNotFound, nil))
+
+	for _, token := range []string{"revoked", "expired", "unknown"} {
+		_, err := uc.Refresh(context.Background(), token, "")
+		requireStatus(t, err, http.StatusUnauthorized)
+	}
+}
+
+func TestAuthLogout(t *testing.T) {
+	now := time.Now()
+	uc, r := newAuthUsecase(t, now)
+
+	session := &appmodel.Session{UserID: 1, TokenID: "jti", ExpiresAt: now.Add(time.Minute)}
+	r.revokedTokens.EXPECT().Insert(mock.Anything, &appmodel.RevokedToken{TokenID: "jti", ExpiresAt: session.ExpiresAt}).Return(nil)
+	r.refreshTokens.EXPECT().GetByHashForUpdate(mock.Anything, hashToken("token")).
+		Return(&appmodel.RefreshToken{ID: 1, UserID: 1, FamilyID: "family"}, nil)
+	r.refreshTokens.EXPECT().UpdateMapBy(mock.Anything, map[string]any{"revoked_at": now}, repo.Ex{"family_id": "family", "revoked_at": nil}).
+		Return(1, nil).Once()
+	expectAudit(r.audit)
+
+	require.NoError(t, uc.Logout(context.Background(), session, "token", ""))
+
+	// the refresh tokens of other users aren't revoked
+	r.revokedTokens.EXPECT().Insert(mock.Anything, mock.Anything).Return(repo.NewRepoError(repo.ErrDuplicated, nil))
+	r.refreshTokens.EXPECT().GetByHashForUpdate(mock.Anything, hashToken("other")).
+		Return(&appmodel.RefreshToken{ID: 2, UserID: 2, FamilyID: "other"}, nil)
+	expectAudit(r.audit)
+
+	require.NoError(t, uc.Logout(context.Background(), session, "other", ""))
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"go.megpoid.dev/go-skel/app/repository/uow"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+)
+
+// used to validate that the implementation matches the interface
+var _ Batch = &BatchInteractor{}
+
+// errBatchAborted rolls back the transaction of the batch after a failed operation.
+var errBatchAborted = errors.New("batch aborted")
+
+type BatchInteractor struct {
+	common
+	uow uow.UnitOfWork
+}
+
+// Run calls fn for every operation in order, in a single transaction. Every operation runs on its own savepoint,
+// with a context bound to it. A failed operation rolls back the whole batch, unless continueOnError is set, then
+// only its own changes are discarded. Returns true if the transaction was committed.
+func (u *BatchInteractor) Run(ctx context.Context, operations int, continueOnError bool, fn BatchOperation) (bool, error) {
+	t := u.printer(ctx)
+
+	err := u.uow.Do(ctx, func(uw uow.UnitOfWork) error {
+		for i := range operations {
+			err := uw.Do(ctx, func(op uow.UnitOfWork) error {
+				return fn(op.Bind(ctx), i)
+			})
+			if err != nil && !continueOnError {
+				return errBatchAborted
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errBatchAborted) {
+			return false, nil
+		}
+
+		return false, apperror.NewAppError(t.Sprintf("Failed to run batch"), err)
+	}
+
+	return true, nil
+}
+
+func NewBatch(uow uow.UnitOfWork) *BatchInteractor {
+	return &BatchInteractor{
+		common: newCommon(),
+		uow:    uow,
+	}
+}
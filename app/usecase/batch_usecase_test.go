@@ -0,0 +1,88 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.megpoid.dev/go-skel/app/repository/uow"
+)
+
+type batchCtxKey struct{}
+
+func newBatchUow(t *testing.T) *uow.MockUnitOfWork {
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn uow.UnitOfWorkBlock) error {
+		return fn(u)
+	})
+	u.EXPECT().Bind(mock.Anything).RunAndReturn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, batchCtxKey{}, true)
+	}).Maybe()
+
+	return u
+}
+
+func TestBatchRun(t *testing.T) {
+	uc := NewBatch(newBatchUow(t))
+
+	var ran []int
+	committed, err := uc.Run(context.Background(), 3, false, func(ctx context.Context, i int) error {
+		assert.Equal(t, true, ctx.Value(batchCtxKey{}))
+		ran = append(ran, i)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, committed)
+	assert.Equal(t, []int{0, 1, 2}, ran)
+}
+
+func TestBatchRunAbort(t *testing.T) {
+	uc := NewBatch(newBatchUow(t))
+
+	var ran []int
+	committed, err := uc.Run(context.Background(), 3, false, func(ctx context.Context, i int) error {
+		ran = append(ran, i)
+		if i == 1 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.False(t, committed)
+	assert.Equal(t, []int{0, 1}, ran)
+}
+
+func TestBatchRunContinueOnError(t *testing.T) {
+	uc := NewBatch(newBatchUow(t))
+
+	var ran []int
+	committed, err := uc.Run(context.Background(), 3, true, func(ctx context.Context, i int) error {
+		ran = append(ran, i)
+		if i == 1 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, committed)
+	assert.Equal(t, []int{0, 1, 2}, ran)
+}
+
+func TestBatchRunError(t *testing.T) {
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Do(mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+	uc := NewBatch(u)
+
+	committed, err := uc.Run(context.Background(), 1, false, func(ctx context.Context, i int) error {
+		return nil
+	})
+	assert.Error(t, err)
+	assert.False(t, committed)
+}
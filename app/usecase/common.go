@@ -8,6 +8,7 @@ import (
 	"context"
 	"time"
 
+	"go.megpoid.dev/go-skel/pkg/hasher"
 	"go.megpoid.dev/go-skel/pkg/i18n"
 	"golang.org/x/text/message"
 )
@@ -46,3 +47,28 @@ func newCommon(opts ...Option) common {
 
 	return c
 }
+
+// hashSecret returns the encoded argon2 hash of a password or key.
+func hashSecret(secret string) (string, error) {
+	h, err := hasher.NewHasher(secret)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := h.Hash()
+	if err != nil {
+		return "", err
+	}
+
+	return hash.String(), nil
+}
+
+// verifySecret returns true if the secret matches the encoded hash.
+func verifySecret(encoded, secret string) bool {
+	hash, err := hasher.NewFromHash(encoded)
+	if err != nil {
+		return false
+	}
+
+	return hash.Verify(secret)
+}
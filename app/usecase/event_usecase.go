@@ -0,0 +1,179 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/principal"
+)
+
+const (
+	eventReplayBatchSize = 100
+)
+
+// EventResources are the tables that publish their changes with the notify_change trigger.
+var EventResources = []string{"profiles"}
+
+// used to validate that the implementation matches the interface
+var _ Event = &EventInteractor{}
+
+// EventAuthorizer returns true if the caller on the context is allowed to receive the changes of the resource.
+type EventAuthorizer func(ctx context.Context, resource string) bool
+
+// ScopeEventAuthorizer allows the principals with the admin scope or a scope named after the resource, like
+// profiles. The callers without a principal can't receive any change.
+func ScopeEventAuthorizer(ctx context.Context, resource string) bool {
+	p, ok := principal.FromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	return p.HasScope(principal.ScopeAdmin) || p.HasScope(resource)
+}
+
+type EventInteractor struct {
+	common
+	eventRepo  repository.EventRepo
+	authorizer EventAuthorizer
+}
+
+// Stream sends the changes of the requested resources. If lastEventID is set then the events logged after that ID
+// are sent first. Resources that the caller isn't allowed to see are ignored.
+func (u *EventInteractor) Stream(ctx context.Context, resources []string, lastEventID *int64) (<-chan *model.Event, error) {
+	t := u.printer(ctx)
+
+	allowed := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		if slices.Contains(EventResources, resource) && u.authorizer(ctx, resource) && !slices.Contains(allowed, resource) {
+			allowed = append(allowed, resource)
+		}
+	}
+
+	if len(allowed) == 0 {
+		return nil, apperror.NewAuthzError(t.Sprintf("Not allowed to access the requested resources"), nil)
+	}
+
+	// subscribe before reading the log, so no event is lost between the replay and the live events
+	live := u.eventRepo.Subscribe(ctx)
+	events := make(chan *model.Event)
+
+	go func() {
+		defer close(events)
+
+		// the live events received during the replay are queued, they may be sent by the replay too
+		var pending []*model.Event
+		queue := live
+		send := func(event *model.Event) bool {
+			for {
+				select {
+				case events <- event:
+					return true
+				case e, ok := <-queue:
+					if !ok {
+						// the subscription ended, finish the replay
+						queue = nil
+						continue
+					}
+					pending = append(pending, e)
+				case <-ctx.Done():
+					return false
+				}
+			}
+		}
+
+		replayed := make(map[int64]struct{})
+		if lastEventID != nil {
+			if !u.replay(ctx, allowed, *lastEventID, send, replayed) {
+				return
+			}
+		}
+
+		forward := func(event *model.Event) bool {
+			if _, ok := replayed[event.ID]; ok || !slices.Contains(allowed, event.Resource) {
+				return true
+			}
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		// the queue can grow while it's sent, so it's consumed with the live events
+		for len(pending) > 0 {
+			event := pending[0]
+			pending = pending[1:]
+			if !forward(event) {
+				return
+			}
+		}
+
+		for event := range live {
+			if !forward(event) {
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// replay sends the logged events after the given ID, recording the sent IDs so the live events aren't sent twice.
+// Returns false if the stream must end.
+func (u *EventInteractor) replay(ctx context.Context, resources []string, lastEventID int64, send func(*model.Event) bool, replayed map[int64]struct{}) bool {
+	firstID, err := u.eventRepo.FirstID(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to read the event log", slog.String("error", err.Error()))
+		return false
+	}
+
+	// the requested events were removed from the log
+	if firstID > lastEventID+1 {
+		if !send(&model.Event{ID: firstID - 1, Operation: model.EventOperationReset, CreatedAt: u.currentTime()}) {
+			return false
+		}
+		lastEventID = firstID - 1
+	}
+
+	for {
+		results, err := u.eventRepo.ListAfter(ctx, lastEventID, resources, eventReplayBatchSize)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to read the event log", slog.String("error", err.Error()))
+			return false
+		}
+
+		for _, event := range results {
+			if !send(event) {
+				return false
+			}
+			replayed[event.ID] = struct{}{}
+			lastEventID = event.ID
+		}
+
+		if len(results) < eventReplayBatchSize {
+			return true
+		}
+	}
+}
+
+// NewEvent creates the event usecase. If authorizer is nil then every caller can receive all the resources.
+func NewEvent(eventRepo repository.EventRepo, authorizer EventAuthorizer) *EventInteractor {
+	if authorizer == nil {
+		authorizer = func(context.Context, string) bool { return true }
+	}
+
+	return &EventInteractor{
+		common:     newCommon(),
+		eventRepo:  eventRepo,
+		authorizer: authorizer,
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+	"go.megpoid.dev/go-skel/pkg/principal"
+)
+
+func collectEvents(events <-chan *model.Event) []int64 {
+	ids := make([]int64, 0)
+	for event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
+
+func TestEventStreamReplay(t *testing.T) {
+	live := make(chan *model.Event, 3)
+	live <- &model.Event{ID: 3, Resource: "profiles"}
+	live <- &model.Event{ID: 4, Resource: "other"}
+	live <- &model.Event{ID: 5, Resource: "profiles"}
+	close(live)
+
+	r := repository.NewMockEventRepo(t)
+	r.EXPECT().Subscribe(mock.Anything).Return(live)
+	r.EXPECT().FirstID(mock.Anything).Return(1, nil)
+	r.EXPECT().ListAfter(mock.Anything, int64(1), []string{"profiles"}, uint(eventReplayBatchSize)).
+		Return([]*model.Event{{ID: 2, Resource: "profiles"}, {ID: 3, Resource: "profiles"}}, nil)
+
+	uc := NewEvent(r, nil)
+	lastEventID := int64(1)
+	events, err := uc.Stream(context.Background(), []string{"profiles", "unknown"}, &lastEventID)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3, 5}, collectEvents(events))
+}
+
+func TestEventStreamReset(t *testing.T) {
+	live := make(chan *model.Event)
+	close(live)
+
+	r := repository.NewMockEventRepo(t)
+	r.EXPECT().Subscribe(mock.Anything).Return(live)
+	r.EXPECT().FirstID(mock.Anything).Return(10, nil)
+	r.EXPECT().ListAfter(mock.Anything, int64(9), []string{"profiles"}, uint(eventReplayBatchSize)).
+		Return([]*model.Event{{ID: 10, Resource: "profiles"}}, nil)
+
+	uc := NewEvent(r, nil)
+	lastEventID := int64(2)
+	events, err := uc.Stream(context.Background(), []string{"profiles"}, &lastEventID)
+	assert.NoError(t, err)
+
+	reset := <-events
+	assert.Equal(t, model.EventOperationReset, reset.Operation)
+	assert.Equal(t, []int64{10}, collectEvents(events))
+}
+
+func TestEventStreamForbidden(t *testing.T) {
+	r := repository.NewMockEventRepo(t)
+
+	uc := NewEvent(r, func(ctx context.Context, resource string) bool {
+		return false
+	})
+
+	_, err := uc.Stream(context.Background(), []string{"profiles"}, nil)
+	var appErr *apperror.Error
+	assert.ErrorAs(t, err, &appErr)
+}
+
+func TestScopeEventAuthorizer(t *testing.T) {
+	assert.False(t, ScopeEventAuthorizer(context.Background(), "profiles"))
+
+	reader := principal.NewContext(context.Background(), &principal.Principal{Type: principal.TypeUser, Scopes: []string{"profiles"}})
+	assert.True(t, ScopeEventAuthorizer(reader, "profiles"))
+	assert.False(t, ScopeEventAuthorizer(reader, "users"))
+
+	admin := principal.NewContext(context.Background(), &principal.Principal{Type: principal.TypeAPIKey, Scopes: []string{principal.ScopeAdmin}})
+	assert.True(t, ScopeEventAuthorizer(admin, "users"))
+}
+
+func TestEventStreamReplayConcurrent(t *testing.T) {
+	live := make(chan *model.Event, 1)
+
+	r := repository.NewMockEventRepo(t)
+	r.EXPECT().Subscribe(mock.Anything).Return(live)
+	r.EXPECT().FirstID(mock.Anything).Return(1, nil)
+	// event 4 committed before event 3 and was notified during the replay
+	r.EXPECT().ListAfter(mock.Anything, int64(1), []string{"profiles"}, uint(eventReplayBatchSize)).
+		RunAndReturn(func(ctx context.Context, id int64, resources []string, limit uint) ([]*model.Event, error) {
+			live <- &model.Event{ID: 4, Resource: "profiles"}
+			return []*model.Event{{ID: 2, Resource: "profiles"}, {ID: 4, Resource: "profiles"}}, nil
+		})
+
+	uc := NewEvent(r, nil)
+	lastEventID := int64(1)
+	events, err := uc.Stream(context.Background(), []string{"profiles"}, &lastEventID)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(2), (<-events).ID)
+	assert.Equal(t, int64(4), (<-events).ID)
+
+	// the lower ID is still sent after the replay
+	live <- &model.Event{ID: 3, Resource: "profiles"}
+	live <- &model.Event{ID: 5, Resource: "profiles"}
+	close(live)
+	assert.Equal(t, []int64{3, 5}, collectEvents(events))
+}
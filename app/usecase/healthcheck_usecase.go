@@ -8,7 +8,7 @@ import (
 	"context"
 	"log/slog"
 
-	"go.megpoid.dev/go-skel/app/repository"
+	"go.megpoid.dev/go-skel/pkg/health"
 )
 
 // used to validate that the implementation matches the interface
@@ -16,17 +16,30 @@ var _ Healthcheck = &HealthcheckInteractor{}
 
 type HealthcheckInteractor struct {
 	common
-	healthcheckRepo repository.HealthcheckRepo
+	registry *health.Registry
 }
 
-func (u *HealthcheckInteractor) Execute(ctx context.Context) error {
+func (u *HealthcheckInteractor) Execute(ctx context.Context) *health.Report {
 	slog.InfoContext(ctx, "Executing healthcheck")
-	return u.healthcheckRepo.Execute(ctx)
+
+	report := u.registry.Run(ctx)
+	for _, result := range report.Checks {
+		if result.Err != nil {
+			slog.WarnContext(ctx, "Health check failed",
+				slog.String("check", result.Name),
+				slog.Bool("critical", result.Critical),
+				slog.String("error", result.Err.Error()),
+			)
+		}
+	}
+
+	return report
 }
 
-func NewHealthcheck(repo repository.HealthcheckRepo) *HealthcheckInteractor {
+// NewHealthcheck creates the healthcheck usecase. The app components add their checks to the registry.
+func NewHealthcheck(registry *health.Registry) *HealthcheckInteractor {
 	return &HealthcheckInteractor{
-		common:          newCommon(),
-		healthcheckRepo: repo,
+		common:   newCommon(),
+		registry: registry,
 	}
 }
@@ -12,22 +12,31 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.megpoid.dev/go-skel/app/repository"
+	"go.megpoid.dev/go-skel/pkg/health"
 )
 
 func TestApp_Healthcheck(t *testing.T) {
 	repo := repository.NewMockHealthcheckRepo(t)
 	repo.EXPECT().Execute(mock.Anything).Return(nil)
 
-	u := NewHealthcheck(repo)
-	err := u.Execute(context.Background())
-	assert.NoError(t, err)
+	registry := health.NewRegistry()
+	registry.Register(health.Check{Name: "database", Check: repo.Execute, Critical: true})
+
+	u := NewHealthcheck(registry)
+	report := u.Execute(context.Background())
+	assert.True(t, report.Ready())
+	assert.Equal(t, health.StatusUp, report.Status)
 }
 
 func TestApp_HealthcheckError(t *testing.T) {
 	repo := repository.NewMockHealthcheckRepo(t)
 	repo.EXPECT().Execute(mock.Anything).Return(errors.New("an error"))
 
-	app := NewHealthcheck(repo)
-	err := app.Execute(context.Background())
-	assert.Error(t, err)
+	registry := health.NewRegistry()
+	registry.Register(health.Check{Name: "database", Check: repo.Execute, Critical: true})
+
+	app := NewHealthcheck(registry)
+	report := app.Execute(context.Background())
+	assert.False(t, report.Ready())
+	assert.Error(t, report.Checks[0].Err)
 }
@@ -0,0 +1,478 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofrs/uuid"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository"
+	"go.megpoid.dev/go-skel/app/repository/uow"
+	"go.megpoid.dev/go-skel/pkg/apperror"
+	pkgModel "go.megpoid.dev/go-skel/pkg/model"
+	"go.megpoid.dev/go-skel/pkg/repo"
+	"go.megpoid.dev/go-skel/pkg/s3"
+	"go.megpoid.dev/go-skel/pkg/transfer"
+	customValidator "go.megpoid.dev/go-skel/pkg/validator"
+)
+
+const (
+	profileImportPrefix = "imports/profiles"
+	// rows processed between the saves of the progress
+	profileImportFlushSize = 100
+)
+
+// ErrInvalidImport is returned by Process when retrying can't succeed, like with a malformed file or a removed
+// import. The other errors are transient and the import can be processed again.
+var ErrInvalidImport = errors.New("invalid import")
+
+// used to validate that the implementation matches the interface
+var (
+	_ ProfileImport    = &ProfileImportInteractor{}
+	_ ProfileImportJob = &ProfileImportInteractor{}
+)
+
+type ProfileImportInteractor struct {
+	common
+	uow         uow.UnitOfWork
+	storage     s3.Client
+	validator   *customValidator.CustomValidator
+	importRepo  repository.ProfileImportRepo
+	profileRepo repository.ProfileRepo
+}
+
+func (u *ProfileImportInteractor) CreateImport(ctx context.Context, filename, format string, r io.Reader) (*model.ProfileImport, error) {
+	t := u.printer(ctx)
+
+	importFormat, err := transfer.ParseFormat(format, filename)
+	if err != nil {
+		return nil, apperror.NewValidationError(t.Sprintf("Unsupported import format"), err)
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, apperror.NewAppError(t.Sprintf("Failed to save import file"), err)
+	}
+
+	name := path.Base(strings.ReplaceAll(filename, "\\", "/"))
+	if name == "." || name == "/" {
+		name = "upload"
+	}
+
+	key, err := u.storage.Upload(ctx, path.Join(profileImportPrefix, id.String(), name), r)
+	if err != nil {
+		return nil, apperror.NewAppError(t.Sprintf("Failed to save import file"), err)
+	}
+
+	profileImport := model.NewProfileImport(pkgModel.WithTime(u.currentTime()))
+	profileImport.Filename = name
+	profileImport.Format = string(importFormat)
+	profileImport.FileKey = key
+
+	if err := u.importRepo.Insert(ctx, profileImport); err != nil {
+		return nil, apperror.NewAppError(t.Sprintf("Failed to save profile import"), err)
+	}
+
+	return profileImport, nil
+}
+
+func (u *ProfileImportInteractor) GetImport(ctx context.Context, id int64) (*model.ProfileImport, error) {
+	t := u.printer(ctx)
+
+	profileImport, err := u.importRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, apperror.NewAppError(t.Sprintf("Profile import not found"), err)
+		}
+
+		return nil, apperror.NewAppError(t.Sprintf("Failed to get profile import"), err)
+	}
+
+	return profileImport, nil
+}
+
+func (u *ProfileImportInteractor) FailImport(ctx context.Context, id int64, cause error) error {
+	t := u.printer(ctx)
+
+	now := u.currentTime()
+	err := u.importRepo.UpdateMap(ctx, id, map[string]any{
+		"status":      model.ProfileImportFailed,
+		"error":       cause.Error(),
+		"finished_at": now,
+		"updated_at":  now,
+	})
+	if err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to update profile import"), err)
+	}
+
+	return nil
+}
+
+// WriteErrorReport writes the rows that failed to import as CSV.
+func (u *ProfileImportInteractor) WriteErrorReport(ctx context.Context, id int64, w io.Writer) error {
+	t := u.printer(ctx)
+
+	if _, err := u.GetImport(ctx, id); err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"line", "email", "error"}); err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to write error report"), err)
+	}
+
+	err := u.importRepo.ListErrors(ctx, id, func(item *model.ProfileImportError) error {
+		return writer.Write([]string{strconv.FormatInt(item.Line, 10), item.Email, item.Message})
+	})
+	if err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to write error report"), err)
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return apperror.NewAppError(t.Sprintf("Failed to write error report"), err)
+	}
+
+	return nil
+}
+
+// Process imports the rows of the uploaded file, upserting the profiles by email. Invalid rows are recorded as
+// errors of the import and don't stop the processing. If the file is invalid the import is marked as failed and
+// ErrInvalidImport is returned, the other errors leave the import running so it can be retried. The failures to
+// report the progress are only logged.
+func (u *ProfileImportInteractor) Process(ctx context.Context, id int64, progress func(current, total int64) error) error {
+	profileImport, err := u.importRepo.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return fmt.Errorf("%w: %w", ErrInvalidImport, err)
+		}
+		return fmt.Errorf("failed to get profile import: %w", err)
+	}
+
+	if profileImport.Finished() {
+		return nil
+	}
+
+	report := func(current, total int64) {
+		if err := progress(current, total); err != nil {
+			slog.WarnContext(ctx, "Failed to report the import progress", slog.Int64("id", id), slog.String("error", err.Error()))
+		}
+	}
+
+	if err := u.process(ctx, profileImport, report); err != nil {
+		if !errors.Is(err, ErrInvalidImport) {
+			return err
+		}
+		if failErr := u.FailImport(context.WithoutCancel(ctx), id, err); failErr != nil {
+			return errors.Join(err, failErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (u *ProfileImportInteractor) process(ctx context.Context, profileImport *model.ProfileImport, progress func(current, total int64)) error {
+	// a previous attempt could have been interrupted, start from scratch
+	if err := u.importRepo.DeleteErrors(ctx, profileImport.ID); err != nil {
+		return fmt.Errorf("failed to clear previous errors: %w", err)
+	}
+
+	file, err := os.CreateTemp("", "profile-import-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	if _, err := u.storage.Download(ctx, profileImport.FileKey, file); err != nil {
+		return fmt.Errorf("failed to download import file: %w", err)
+	}
+
+	// first pass to know the total rows, so the progress can be reported
+	total, err := u.countRows(file, transfer.Format(profileImport.Format))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+
+	profileImport.Status = model.ProfileImportRunning
+	profileImport.Total = total
+	profileImport.Processed = 0
+	profileImport.Imported = 0
+	profileImport.Failed = 0
+	if err := u.saveProgress(ctx, profileImport, nil); err != nil {
+		return err
+	}
+
+	rows, closer, err := u.openRows(file, transfer.Format(profileImport.Format))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidImport, err)
+	}
+	defer closer.Close()
+
+	var rowErrors []*model.ProfileImportError
+	for {
+		row, err := rows.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w: failed to read import file: %w", ErrInvalidImport, err)
+		}
+
+		profileImport.Processed++
+		if rowErr := u.importRow(ctx, row); rowErr != nil {
+			profileImport.Failed++
+			rowErrors = append(rowErrors, &model.ProfileImportError{
+				ImportID: profileImport.ID,
+				Line:     row.line,
+				Email:    row.request.Email,
+				Message:  rowErr.Error(),
+			})
+		} else {
+			profileImport.Imported++
+		}
+
+		if profileImport.Processed%profileImportFlushSize == 0 {
+			if err := u.saveProgress(ctx, profileImport, rowErrors); err != nil {
+				return err
+			}
+			rowErrors = rowErrors[:0]
+
+			progress(profileImport.Processed, profileImport.Total)
+		}
+	}
+
+	now := u.currentTime()
+	profileImport.Status = model.ProfileImportCompleted
+	profileImport.FinishedAt = &now
+	if err := u.saveProgress(ctx, profileImport, rowErrors); err != nil {
+		return err
+	}
+
+	progress(profileImport.Processed, profileImport.Total)
+
+	return nil
+}
+
+// importRow validates and saves the row, the returned error is recorded in the report.
+func (u *ProfileImportInteractor) importRow(ctx context.Context, row *importRow) error {
+	if row.err != nil {
+		return row.err
+	}
+
+	if err := u.validator.Validate(&row.request); err != nil {
+		var validationErrs validator.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			fields := make([]string, 0, len(validationErrs))
+			for _, v := range validationErrs {
+				fields = append(fields, v.Field()+": "+v.ActualTag())
+			}
+			return fmt.Errorf("invalid fields: %s", strings.Join(fields, ", "))
+		}
+		return err
+	}
+
+	if _, err := u.profileRepo.Upsert(ctx, row.request.Profile(pkgModel.WithTime(u.currentTime())), "email"); err != nil {
+		return fmt.Errorf("failed to save profile: %w", err)
+	}
+
+	return nil
+}
+
+// saveProgress updates the counters of the import and saves the pending row errors in a single transaction.
+func (u *ProfileImportInteractor) saveProgress(ctx context.Context, profileImport *model.ProfileImport, rowErrors []*model.ProfileImportError) error {
+	err := u.uow.Do(ctx, func(uw uow.UnitOfWork) error {
+		if err := uw.Store().ProfileImports().AddErrors(ctx, rowErrors); err != nil {
+			return err
+		}
+
+		return uw.Store().ProfileImports().UpdateMap(ctx, profileImport.ID, map[string]any{
+			"status":      profileImport.Status,
+			"total":       profileImport.Total,
+			"processed":   profileImport.Processed,
+			"imported":    profileImport.Imported,
+			"failed":      profileImport.Failed,
+			"finished_at": profileImport.FinishedAt,
+			"updated_at":  u.currentTime(),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save import progress: %w", err)
+	}
+
+	return nil
+}
+
+func (u *ProfileImportInteractor) countRows(file *os.File, format transfer.Format) (int64, error) {
+	rows, closer, err := u.openRows(file, format)
+	if err != nil {
+		return 0, err
+	}
+	defer closer.Close()
+
+	var total int64
+	for {
+		_, err := rows.next()
+		if errors.Is(err, io.EOF) {
+			return total, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read import file: %w", err)
+		}
+		total++
+	}
+}
+
+// openRows reads the file from the start, decompressing it if needed.
+func (u *ProfileImportInteractor) openRows(file *os.File, format transfer.Format) (rowReader, io.Closer, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	r, err := transfer.NewReader(file)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read import file: %w", err)
+	}
+
+	rows, err := newRowReader(r, format)
+	if err != nil {
+		_ = r.Close()
+		return nil, nil, err
+	}
+
+	return rows, r, nil
+}
+
+func NewProfileImport(uow uow.UnitOfWork, storage s3.Client, opts ...Option) *ProfileImportInteractor {
+	return &ProfileImportInteractor{
+		common:      newCommon(opts...),
+		uow:         uow,
+		storage:     storage,
+		validator:   customValidator.NewCustomValidator(),
+		importRepo:  uow.Store().ProfileImports(),
+		profileRepo: uow.Store().Profiles(),
+	}
+}
+
+// importRow is a row of the import file, err is set if the row couldn't be parsed.
+type importRow struct {
+	line    int64
+	request model.ProfileRequest
+	err     error
+}
+
+type rowReader interface {
+	// next returns the next row of the file or io.EOF at the end. Malformed rows are returned with the error set.
+	next() (*importRow, error)
+}
+
+func newRowReader(r io.Reader, format transfer.Format) (rowReader, error) {
+	switch format {
+	case transfer.FormatNDJSON:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		return &jsonRowReader{scanner: scanner}, nil
+	default:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+
+		header, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("the file is empty")
+			}
+			return nil, fmt.Errorf("failed to read header: %w", err)
+		}
+
+		rows := &csvRowReader{reader: reader, columns: map[string]int{}}
+		for i, name := range header {
+			rows.columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+		}
+		if _, ok := rows.columns["email"]; !ok {
+			return nil, errors.New("the email column is missing from the header")
+		}
+
+		return rows, nil
+	}
+}
+
+type csvRowReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+}
+
+func (c *csvRowReader) next() (*importRow, error) {
+	record, err := c.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &importRow{line: int64(parseErr.StartLine), err: parseErr.Err}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	line, _ := c.reader.FieldPos(0)
+	row := &importRow{line: int64(line)}
+	row.request.Email = c.field(record, "email")
+	row.request.FirstName = c.field(record, "first_name")
+	row.request.LastName = c.field(record, "last_name")
+
+	return row, nil
+}
+
+func (c *csvRowReader) field(record []string, name string) string {
+	if i, ok := c.columns[name]; ok && i < len(record) {
+		return strings.TrimSpace(record[i])
+	}
+	return ""
+}
+
+type jsonRowReader struct {
+	scanner *bufio.Scanner
+	line    int64
+}
+
+func (j *jsonRowReader) next() (*importRow, error) {
+	for j.scanner.Scan() {
+		j.line++
+
+		data := j.scanner.Bytes()
+		if len(strings.TrimSpace(string(data))) == 0 {
+			continue
+		}
+
+		row := &importRow{line: j.line}
+		if err := json.Unmarshal(data, &row.request); err != nil {
+			row.err = fmt.Errorf("invalid JSON: %w", err)
+		}
+
+		return row, nil
+	}
+
+	if err := j.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
@@ -0,0 +1,219 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	appmodel "go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository"
+	"go.megpoid.dev/go-skel/app/repository/uow"
+	"go.megpoid.dev/go-skel/pkg/model"
+	"go.megpoid.dev/go-skel/pkg/s3"
+	"go.megpoid.dev/go-skel/pkg/transfer"
+)
+
+func TestProfileImportCreate(t *testing.T) {
+	storage := s3.NewMemoryClient()
+
+	importRepo := repository.NewMockProfileImportRepo(t)
+	importRepo.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, p *appmodel.ProfileImport) error {
+		p.ID = 1
+		return nil
+	})
+
+	store := uow.NewMockUnitOfWorkStore(t)
+	store.EXPECT().ProfileImports().Return(importRepo)
+	store.EXPECT().Profiles().Return(repository.NewMockProfileRepo(t))
+
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Store().Return(store)
+	uc := NewProfileImport(u, storage)
+
+	result, err := uc.CreateImport(context.Background(), "../profiles.ndjson.gz", "", strings.NewReader("{}"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.ID)
+	assert.Equal(t, appmodel.ProfileImportPending, result.Status)
+	assert.Equal(t, "profiles.ndjson.gz", result.Filename)
+	assert.Equal(t, "ndjson", result.Format)
+	assert.Contains(t, storage.Files, result.FileKey)
+	assert.True(t, strings.HasPrefix(result.FileKey, "imports/profiles/"))
+
+	_, err = uc.CreateImport(context.Background(), "profiles.csv", "xml", strings.NewReader(""))
+	assert.Error(t, err)
+}
+
+func TestProfileImportProcess(t *testing.T) {
+	storage := s3.NewMemoryClient()
+	storage.Files["imports/profiles/1/profiles.csv"] = []byte("Email,First_Name,Last_Name\n" +
+		"john.doe@example.com,John,Doe\n" +
+		"invalid,Jane,Doe\n" +
+		"jane.doe@example.com,Jane\n")
+
+	profileImport := appmodel.NewProfileImport(model.WithID(1))
+	profileImport.Format = "csv"
+	profileImport.FileKey = "imports/profiles/1/profiles.csv"
+
+	var saved []*appmodel.Profile
+	profileRepo := repository.NewMockProfileRepo(t)
+	profileRepo.EXPECT().Upsert(mock.Anything, mock.Anything, "email").RunAndReturn(func(_ context.Context, p *appmodel.Profile, _ string) (bool, error) {
+		saved = append(saved, p)
+		return true, nil
+	})
+
+	var rowErrors []*appmodel.ProfileImportError
+	var updates []map[string]any
+	importRepo := repository.NewMockProfileImportRepo(t)
+	importRepo.EXPECT().Get(mock.Anything, int64(1)).Return(profileImport, nil)
+	importRepo.EXPECT().DeleteErrors(mock.Anything, int64(1)).Return(nil)
+	importRepo.EXPECT().AddErrors(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, errs []*appmodel.ProfileImportError) error {
+		rowErrors = append(rowErrors, errs...)
+		return nil
+	})
+	importRepo.EXPECT().UpdateMap(mock.Anything, int64(1), mock.Anything).RunAndReturn(func(_ context.Context, _ int64, req map[string]any) error {
+		updates = append(updates, req)
+		return nil
+	})
+
+	store := uow.NewMockUnitOfWorkStore(t)
+	store.EXPECT().ProfileImports().Return(importRepo)
+	store.EXPECT().Profiles().Return(profileRepo)
+
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Store().Return(store)
+	u.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, fn uow.UnitOfWorkBlock) error {
+		return fn(u)
+	})
+	uc := NewProfileImport(u, storage)
+
+	// the failures to report the progress don't stop the import
+	var current, total int64
+	err := uc.Process(context.Background(), 1, func(c, t int64) error {
+		current, total = c, t
+		return errors.New("failed to write progress")
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(3), current)
+	assert.Equal(t, int64(3), total)
+
+	require.Len(t, saved, 1)
+	assert.Equal(t, "john.doe@example.com", saved[0].Email)
+
+	require.Len(t, rowErrors, 2)
+	assert.Equal(t, int64(3), rowErrors[0].Line)
+	assert.Equal(t, "invalid", rowErrors[0].Email)
+	assert.Contains(t, rowErrors[0].Message, "email")
+	assert.Equal(t, int64(4), rowErrors[1].Line)
+	assert.Contains(t, rowErrors[1].Message, "last_name")
+
+	last := updates[len(updates)-1]
+	assert.Equal(t, appmodel.ProfileImportCompleted, last["status"])
+	assert.Equal(t, int64(1), last["imported"])
+	assert.Equal(t, int64(2), last["failed"])
+	assert.NotNil(t, last["finished_at"])
+}
+
+func TestProfileImportProcessInvalidFile(t *testing.T) {
+	storage := s3.NewMemoryClient()
+	storage.Files["imports/profiles/1/profiles.csv"] = []byte("first_name,last_name\nJohn,Doe\n")
+
+	profileImport := appmodel.NewProfileImport(model.WithID(1))
+	profileImport.Format = "csv"
+	profileImport.FileKey = "imports/profiles/1/profiles.csv"
+
+	importRepo := repository.NewMockProfileImportRepo(t)
+	importRepo.EXPECT().Get(mock.Anything, int64(1)).Return(profileImport, nil)
+	importRepo.EXPECT().DeleteErrors(mock.Anything, int64(1)).Return(nil)
+	importRepo.EXPECT().UpdateMap(mock.Anything, int64(1), mock.MatchedBy(func(req map[string]any) bool {
+		return req["status"] == appmodel.ProfileImportFailed && strings.Contains(req["error"].(string), "email")
+	})).Return(nil)
+
+	store := uow.NewMockUnitOfWorkStore(t)
+	store.EXPECT().ProfileImports().Return(importRepo)
+	store.EXPECT().Profiles().Return(repository.NewMockProfileRepo(t))
+
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Store().Return(store)
+	uc := NewProfileImport(u, storage)
+
+	err := uc.Process(context.Background(), 1, func(_, _ int64) error { return nil })
+	assert.ErrorIs(t, err, ErrInvalidImport)
+}
+
+func TestProfileImportProcessTransient(t *testing.T) {
+	// the database isn't available, the import isn't failed so it can be retried
+	profileImport := appmodel.NewProfileImport(model.WithID(1))
+	profileImport.Format = "csv"
+	profileImport.FileKey = "imports/profiles/1/profiles.csv"
+
+	importRepo := repository.NewMockProfileImportRepo(t)
+	importRepo.EXPECT().Get(mock.Anything, int64(1)).Return(profileImport, nil)
+	importRepo.EXPECT().DeleteErrors(mock.Anything, int64(1)).Return(errors.New("connection reset"))
+
+	store := uow.NewMockUnitOfWorkStore(t)
+	store.EXPECT().ProfileImports().Return(importRepo)
+	store.EXPECT().Profiles().Return(repository.NewMockProfileRepo(t))
+
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Store().Return(store)
+	uc := NewProfileImport(u, s3.NewMemoryClient())
+
+	err := uc.Process(context.Background(), 1, func(_, _ int64) error { return nil })
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidImport)
+}
+
+func TestProfileImportErrorReport(t *testing.T) {
+	importRepo := repository.NewMockProfileImportRepo(t)
+	importRepo.EXPECT().Get(mock.Anything, int64(1)).Return(appmodel.NewProfileImport(model.WithID(1)), nil)
+	importRepo.EXPECT().ListErrors(mock.Anything, int64(1), mock.Anything).RunAndReturn(func(_ context.Context, _ int64, fn func(*appmodel.ProfileImportError) error) error {
+		return fn(&appmodel.ProfileImportError{ImportID: 1, Line: 3, Email: "invalid", Message: "invalid fields: email: email"})
+	})
+
+	store := uow.NewMockUnitOfWorkStore(t)
+	store.EXPECT().ProfileImports().Return(importRepo)
+	store.EXPECT().Profiles().Return(repository.NewMockProfileRepo(t))
+
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Store().Return(store)
+	uc := NewProfileImport(u, s3.NewMemoryClient())
+
+	var buf bytes.Buffer
+	err := uc.WriteErrorReport(context.Background(), 1, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, "line,email,error\n3,invalid,invalid fields: email: email\n", buf.String())
+}
+
+func TestJSONRowReader(t *testing.T) {
+	input := `{"email": "john.doe@example.com", "first_name": "John", "last_name": "Doe"}
+
+not json
+`
+	rows, err := newRowReader(strings.NewReader(input), transfer.FormatNDJSON)
+	require.NoError(t, err)
+
+	row, err := rows.next()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), row.line)
+	assert.Equal(t, "john.doe@example.com", row.request.Email)
+	assert.NoError(t, row.err)
+
+	row, err = rows.next()
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), row.line)
+	assert.Error(t, row.err)
+
+	_, err = rows.next()
+	assert.ErrorIs(t, err, io.EOF)
+}
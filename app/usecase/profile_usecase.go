@@ -7,15 +7,20 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/go-playground/validator/v10"
 	"go.megpoid.dev/go-skel/app/model"
 	"go.megpoid.dev/go-skel/app/repository"
 	"go.megpoid.dev/go-skel/app/repository/uow"
 	"go.megpoid.dev/go-skel/pkg/apperror"
 	"go.megpoid.dev/go-skel/pkg/clause"
+	pkgModel "go.megpoid.dev/go-skel/pkg/model"
+	"go.megpoid.dev/go-skel/pkg/patch"
 	"go.megpoid.dev/go-skel/pkg/repo"
 	"go.megpoid.dev/go-skel/pkg/request"
 	"go.megpoid.dev/go-skel/pkg/response"
+	customValidator "go.megpoid.dev/go-skel/pkg/validator"
 )
 
 // used to validate that the implementation matches the interface
@@ -24,6 +29,7 @@ var _ Profile = &ProfileInteractor{}
 type ProfileInteractor struct {
 	common
 	uow         uow.UnitOfWork
+	validator   *customValidator.CustomValidator
 	profileRepo repository.ProfileRepo
 }
 
@@ -69,33 +75,136 @@ func (u *ProfileInteractor) SaveProfile(ctx context.Context, req *model.ProfileR
 	return profile, nil
 }
 
-func (u *ProfileInteractor) UpdateProfile(ctx context.Context, id int64, req *model.ProfileRequest) (*model.Profile, error) {
+func (u *ProfileInteractor) UpdateProfile(ctx context.Context, id int64, req *model.ProfileRequest, precondition Precondition) (*model.Profile, error) {
 	t := u.printer(ctx)
 
 	profile := req.Profile()
 	profile.ID = id
-	err := u.profileRepo.Update(ctx, profile)
+	err := u.withPrecondition(ctx, id, precondition, func(profileRepo repository.ProfileRepo) error {
+		return profileRepo.Update(ctx, profile)
+	})
 	if err != nil {
+		var preconditionErr *preconditionError
+		if errors.As(err, &preconditionErr) {
+			return nil, apperror.NewAppError(t.Sprintf("Profile was modified by another request"), preconditionErr.err)
+		}
+
 		return nil, apperror.NewAppError(t.Sprintf("Failed to update profile"), err)
 	}
 
 	return profile, nil
 }
 
-func (u *ProfileInteractor) RemoveProfile(ctx context.Context, id int64) error {
+// PatchProfile applies the patch to the editable fields of the stored profile and saves the columns that changed,
+// the row is locked until the update is done.
+func (u *ProfileInteractor) PatchProfile(ctx context.Context, id int64, p patch.Patch, precondition Precondition) (*model.Profile, error) {
 	t := u.printer(ctx)
 
-	if err := u.profileRepo.Delete(ctx, id); err != nil {
+	var profile *model.Profile
+	err := u.uow.Do(ctx, func(uw uow.UnitOfWork) error {
+		profileRepo := uw.Store().Profiles()
+
+		current, err := profileRepo.GetForUpdate(ctx, repo.Ex{"id": id})
+		if err != nil {
+			return err
+		}
+
+		if precondition != nil {
+			if err := precondition(current.ID, current.UpdatedAt); err != nil {
+				return &preconditionError{err: err}
+			}
+		}
+
+		var req model.ProfileRequest
+		if err := patch.ApplyTo(p, current.Request(), &req); err != nil {
+			return err
+		}
+		if err := u.validator.Validate(&req); err != nil {
+			return err
+		}
+
+		profile = current
+		changes := req.Changes(current)
+		if len(changes) == 0 {
+			return nil
+		}
+
+		changes["updated_at"] = u.currentTime()
+		if err := profileRepo.UpdateMap(ctx, id, changes); err != nil {
+			return err
+		}
+
+		profile = req.Profile(pkgModel.WithID(id), pkgModel.WithTime(current.CreatedAt))
+		profile.UpdatedAt = changes["updated_at"].(time.Time)
+
+		return nil
+	})
+	if err != nil {
+		var preconditionErr *preconditionError
+		var validationErrs validator.ValidationErrors
+		switch {
+		case errors.As(err, &preconditionErr):
+			return nil, apperror.NewAppError(t.Sprintf("Profile was modified by another request"), preconditionErr.err)
+		case errors.Is(err, repo.ErrNotFound):
+			return nil, apperror.NewAppError(t.Sprintf("Profile not found"), err)
+		case errors.Is(err, patch.ErrCannotApply):
+			return nil, apperror.NewUnprocessableError(t.Sprintf("The patch cannot be applied to the profile"), err)
+		case errors.As(err, &validationErrs):
+			return nil, apperror.NewAppError(t.Sprintf("The patched profile did not pass validation"), err)
+		default:
+			return nil, apperror.NewAppError(t.Sprintf("Failed to update profile"), err)
+		}
+	}
+
+	return profile, nil
+}
+
+func (u *ProfileInteractor) RemoveProfile(ctx context.Context, id int64, precondition Precondition) error {
+	t := u.printer(ctx)
+
+	err := u.withPrecondition(ctx, id, precondition, func(profileRepo repository.ProfileRepo) error {
+		return profileRepo.Delete(ctx, id)
+	})
+	if err != nil {
+		var preconditionErr *preconditionError
+		if errors.As(err, &preconditionErr) {
+			return apperror.NewAppError(t.Sprintf("Profile was modified by another request"), preconditionErr.err)
+		}
+
 		return apperror.NewAppError(t.Sprintf("Failed to remove profile"), err)
 	}
 
 	return nil
 }
 
-func NewProfile(uow uow.UnitOfWork) *ProfileInteractor {
+// withPrecondition runs fn after checking the precondition against the locked row, in a single transaction.
+// Without precondition fn runs directly.
+func (u *ProfileInteractor) withPrecondition(ctx context.Context, id int64, precondition Precondition, fn func(profileRepo repository.ProfileRepo) error) error {
+	if precondition == nil {
+		return fn(u.profileRepo)
+	}
+
+	return u.uow.Do(ctx, func(uw uow.UnitOfWork) error {
+		profileRepo := uw.Store().Profiles()
+
+		current, err := profileRepo.GetForUpdate(ctx, repo.Ex{"id": id})
+		if err != nil {
+			return err
+		}
+
+		if err := precondition(current.ID, current.UpdatedAt); err != nil {
+			return &preconditionError{err: err}
+		}
+
+		return fn(profileRepo)
+	})
+}
+
+func NewProfile(uow uow.UnitOfWork, opts ...Option) *ProfileInteractor {
 	return &ProfileInteractor{
-		common:      newCommon(),
+		common:      newCommon(opts...),
 		uow:         uow,
+		validator:   customValidator.NewCustomValidator(),
 		profileRepo: uow.Store().Profiles(),
 	}
 }
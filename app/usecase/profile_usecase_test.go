@@ -6,15 +6,21 @@ package usecase
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	appmodel "go.megpoid.dev/go-skel/app/model"
 	"go.megpoid.dev/go-skel/app/repository"
 	"go.megpoid.dev/go-skel/app/repository/uow"
+	"go.megpoid.dev/go-skel/pkg/apperror"
 	"go.megpoid.dev/go-skel/pkg/model"
 	"go.megpoid.dev/go-skel/pkg/paginator"
+	"go.megpoid.dev/go-skel/pkg/patch"
 	"go.megpoid.dev/go-skel/pkg/repo"
 	"go.megpoid.dev/go-skel/pkg/request"
 	"go.megpoid.dev/go-skel/pkg/response"
@@ -109,7 +115,7 @@ func TestProfileUpdate(t *testing.T) {
 		Email: "test@test.com",
 	}
 
-	updated, err := uc.UpdateProfile(context.Background(), 1, updateRequest)
+	updated, err := uc.UpdateProfile(context.Background(), 1, updateRequest, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, "test@test.com", updated.Email)
 }
@@ -125,10 +131,108 @@ func TestProfileDelete(t *testing.T) {
 	u.EXPECT().Store().Return(store)
 	uc := NewProfile(u)
 
-	err := uc.RemoveProfile(context.Background(), 1)
+	err := uc.RemoveProfile(context.Background(), 1, nil)
 	assert.NoError(t, err)
 }
 
+func TestProfileUpdatePrecondition(t *testing.T) {
+	updatedAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	current := &appmodel.Profile{Model: model.Model{ID: 1, UpdatedAt: updatedAt}}
+
+	r := repository.NewMockProfileRepo(t)
+	r.EXPECT().GetForUpdate(mock.Anything, repo.Ex{"id": int64(1)}).Return(current, nil)
+
+	store := uow.NewMockUnitOfWorkStore(t)
+	store.EXPECT().Profiles().Return(r)
+
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Store().Return(store)
+	u.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, fn uow.UnitOfWorkBlock) error {
+		return fn(u)
+	})
+	uc := NewProfile(u)
+
+	stale := func(id int64, modified time.Time) error {
+		assert.Equal(t, int64(1), id)
+		assert.Equal(t, updatedAt, modified)
+		return echo.NewHTTPError(http.StatusPreconditionFailed)
+	}
+
+	_, err := uc.UpdateProfile(context.Background(), 1, &appmodel.ProfileRequest{Email: "test@test.com"}, stale)
+	var appErr *apperror.Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, http.StatusPreconditionFailed, appErr.StatusCode)
+
+	r.EXPECT().Delete(mock.Anything, int64(1)).Return(nil)
+	err = uc.RemoveProfile(context.Background(), 1, func(_ int64, _ time.Time) error { return nil })
+	assert.NoError(t, err)
+}
+
+func TestProfilePatch(t *testing.T) {
+	created := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	now := created.Add(time.Hour)
+	current := &appmodel.Profile{
+		Model:     model.Model{ID: 1, CreatedAt: created, UpdatedAt: created},
+		FirstName: "John",
+		LastName:  "Doe",
+		Email:     "john.doe@example.com",
+	}
+
+	r := repository.NewMockProfileRepo(t)
+	r.EXPECT().GetForUpdate(mock.Anything, repo.Ex{"id": int64(1)}).Return(current, nil)
+
+	store := uow.NewMockUnitOfWorkStore(t)
+	store.EXPECT().Profiles().Return(r)
+
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Store().Return(store)
+	u.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, fn uow.UnitOfWorkBlock) error {
+		return fn(u)
+	})
+	uc := NewProfile(u, WithTime(func() time.Time { return now }))
+
+	// only the modified column is saved
+	r.EXPECT().UpdateMap(mock.Anything, int64(1), map[string]any{"first_name": "Jane", "updated_at": now}).Return(nil).Once()
+
+	p, err := patch.Parse(patch.MIMEApplicationMergePatch, []byte(`{"first_name":"Jane"}`))
+	require.NoError(t, err)
+	profile, err := uc.PatchProfile(context.Background(), 1, p, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Jane", profile.FirstName)
+	assert.Equal(t, "Doe", profile.LastName)
+	assert.Equal(t, created, profile.CreatedAt)
+	assert.Equal(t, now, profile.UpdatedAt)
+
+	// nothing is saved if the patch doesn't change the profile
+	p, err = patch.Parse(patch.MIMEApplicationJSONPatch, []byte(`[{"op":"replace","path":"/last_name","value":"Doe"}]`))
+	require.NoError(t, err)
+	profile, err = uc.PatchProfile(context.Background(), 1, p, nil)
+	require.NoError(t, err)
+	assert.Equal(t, created, profile.UpdatedAt)
+
+	tests := []struct {
+		contentType string
+		patch       string
+		status      int
+	}{
+		{patch.MIMEApplicationMergePatch, `{"email":"invalid"}`, http.StatusBadRequest},
+		{patch.MIMEApplicationMergePatch, `{"email":null}`, http.StatusBadRequest},
+		{patch.MIMEApplicationMergePatch, `{"id":2}`, http.StatusUnprocessableEntity},
+		{patch.MIMEApplicationJSONPatch, `[{"op":"test","path":"/email","value":"other@example.com"}]`, http.StatusUnprocessableEntity},
+		{patch.MIMEApplicationJSONPatch, `[{"op":"remove","path":"/phone"}]`, http.StatusUnprocessableEntity},
+	}
+
+	for _, test := range tests {
+		p, err := patch.Parse(test.contentType, []byte(test.patch))
+		require.NoError(t, err)
+
+		_, err = uc.PatchProfile(context.Background(), 1, p, nil)
+		var appErr *apperror.Error
+		require.ErrorAs(t, err, &appErr, test.patch)
+		assert.Equal(t, test.status, appErr.StatusCode, test.patch)
+	}
+}
+
 func TestProfileError(t *testing.T) {
 	r := repository.NewMockProfileRepo(t)
 	r.EXPECT().Get(mock.Anything, int64(1)).Return(nil, repo.ErrNotFound)
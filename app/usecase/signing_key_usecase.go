@@ -0,0 +1,179 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository"
+	"go.megpoid.dev/go-skel/app/repository/uow"
+	"go.megpoid.dev/go-skel/pkg/crypto/aes"
+	"go.megpoid.dev/go-skel/pkg/jwks"
+	"go.megpoid.dev/go-skel/pkg/repo"
+)
+
+// used to validate that the implementation matches the interface
+var _ SigningKey = &SigningKeyInteractor{}
+
+// SigningKeySettings configure the rotation of the keys that sign the access tokens.
+type SigningKeySettings struct {
+	Algorithm string
+	// Secret encrypts the private keys stored on the database
+	Secret []byte
+	// Rotation is the age of the active key when it's replaced
+	Rotation time.Duration
+	// Validity is how long a retired key verifies the tokens, it must cover the publish delay of the key ring and the
+	// lifetime of the access tokens
+	Validity time.Duration
+}
+
+type SigningKeyInteractor struct {
+	common
+	uow      uow.UnitOfWork
+	ring     *jwks.KeyRing
+	settings SigningKeySettings
+}
+
+// Rotate replaces the active key if it's older than the rotation period or uses another algorithm, or creates it if
+// there is none, then loads the keys into the ring. The instances can rotate concurrently, only one of them creates
+// the new key.
+func (uc *SigningKeyInteractor) Rotate(ctx context.Context) error {
+	now := uc.currentTime()
+
+	err := uc.uow.Do(ctx, func(uw uow.UnitOfWork) error {
+		keys := uw.Store().SigningKeys()
+
+		active, err := keys.GetActiveForUpdate(ctx)
+		switch {
+		case errors.Is(err, repo.ErrNotFound):
+		case err != nil:
+			return err
+		case active.Algorithm == uc.settings.Algorithm && now.Before(active.CreatedAt.Add(uc.settings.Rotation)):
+			if _, err = uc.readKey(active); err == nil {
+				return nil
+			}
+			// the jwt secret was changed, the key can't sign the tokens anymore
+			slog.WarnContext(ctx, "Replacing the signing key that can't be read",
+				slog.String("kid", active.KeyID), slog.String("error", err.Error()))
+			fallthrough
+		default:
+			err = keys.UpdateMap(ctx, active.ID, map[string]any{
+				"retired_at": now,
+				"expires_at": now.Add(uc.settings.Validity),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return uc.createKey(ctx, keys, now)
+	})
+	// the active key was created by another instance
+	if err != nil && !errors.Is(err, repo.ErrDuplicated) {
+		return fmt.Errorf("failed to rotate the signing key: %w", err)
+	}
+
+	return uc.Load(ctx)
+}
+
+// Load replaces the keys of the ring with the active key and the retired keys that didn't expire. The keys that
+// can't be read, like the ones encrypted with a previous jwt secret, are skipped.
+func (uc *SigningKeyInteractor) Load(ctx context.Context) error {
+	stored, err := uc.uow.Store().SigningKeys().ListValid(ctx, uc.currentTime())
+	if err != nil {
+		return fmt.Errorf("failed to list the signing keys: %w", err)
+	}
+
+	keys := make([]*jwks.Key, 0, len(stored))
+	for _, s := range stored {
+		key, err := uc.readKey(s)
+		if err != nil {
+			slog.WarnContext(ctx, "Skipping the signing key that can't be read",
+				slog.String("kid", s.KeyID), slog.String("error", err.Error()))
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	uc.ring.Replace(keys)
+
+	return nil
+}
+
+// DeleteExpired removes the retired keys that can't verify any token.
+func (uc *SigningKeyInteractor) DeleteExpired(ctx context.Context) (int64, error) {
+	return uc.uow.Store().SigningKeys().DeleteBy(ctx, repo.Ex{"expires_at": repo.Op{"lte": uc.currentTime()}})
+}
+
+// createKey generates a key for the configured algorithm and saves it encrypted.
+func (uc *SigningKeyInteractor) createKey(ctx context.Context, keys repository.SigningKeyRepo, now time.Time) error {
+	key, err := jwks.GenerateKey(uc.settings.Algorithm, now)
+	if err != nil {
+		return err
+	}
+
+	data, err := jwks.MarshalPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := aes.Encrypt(uc.encryptionKey(), data)
+	if err != nil {
+		return err
+	}
+
+	return keys.Insert(ctx, &model.SigningKey{
+		CreatedAt:  now,
+		KeyID:      key.ID,
+		Algorithm:  key.Algorithm,
+		PrivateKey: encrypted,
+	})
+}
+
+// readKey decrypts the stored private key.
+func (uc *SigningKeyInteractor) readKey(s *model.SigningKey) (*jwks.Key, error) {
+	data, err := aes.Decrypt(uc.encryptionKey(), s.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the signing key %s: %w", s.KeyID, err)
+	}
+
+	privateKey, err := jwks.UnmarshalPrivateKey(s.Algorithm, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the signing key %s: %w", s.KeyID, err)
+	}
+
+	key := &jwks.Key{
+		ID:         s.KeyID,
+		Algorithm:  s.Algorithm,
+		PrivateKey: privateKey,
+		CreatedAt:  s.CreatedAt,
+	}
+	if s.ExpiresAt != nil {
+		key.ExpiresAt = *s.ExpiresAt
+	}
+
+	return key, nil
+}
+
+// encryptionKey derives the AES key of the private keys from the secret.
+func (uc *SigningKeyInteractor) encryptionKey() []byte {
+	sum := sha256.Sum256(uc.settings.Secret)
+	return sum[:]
+}
+
+func NewSigningKey(uow uow.UnitOfWork, ring *jwks.KeyRing, settings SigningKeySettings, opts ...Option) *SigningKeyInteractor {
+	return &SigningKeyInteractor{
+		common:   newCommon(opts...),
+		uow:      uow,
+		ring:     ring,
+		settings: settings,
+	}
+}
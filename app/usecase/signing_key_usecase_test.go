@@ -0,0 +1,161 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	appmodel "go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository"
+	"go.megpoid.dev/go-skel/app/repository/uow"
+	"go.megpoid.dev/go-skel/pkg/crypto/aes"
+	"go.megpoid.dev/go-skel/pkg/jwks"
+	"go.megpoid.dev/go-skel/pkg/repo"
+)
+
+func newSigningKeyUsecase(t *testing.T, now time.Time) (*SigningKeyInteractor, *jwks.KeyRing, *repository.MockSigningKeyRepo) {
+	keys := repository.NewMockSigningKeyRepo(t)
+
+	store := uow.NewMockUnitOfWorkStore(t)
+	store.EXPECT().SigningKeys().Return(keys).Maybe()
+
+	u := uow.NewMockUnitOfWork(t)
+	u.EXPECT().Store().Return(store).Maybe()
+	u.EXPECT().Do(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, fn uow.UnitOfWorkBlock) error {
+		return fn(u)
+	}).Maybe()
+
+	ring := jwks.NewKeyRing(jwks.WithTime(func() time.Time { return now }))
+	uc := NewSigningKey(u, ring, SigningKeySettings{
+		Algorithm: jwks.AlgorithmEdDSA,
+		Secret:    testJwtSecret,
+		Rotation:  24 * time.Hour,
+		Validity:  time.Hour,
+	}, WithTime(func() time.Time { return now }))
+
+	return uc, ring, keys
+}
+
+func TestSigningKeyRotateCreatesKey(t *testing.T) {
+	now := time.Now()
+	uc, ring, keys := newSigningKeyUsecase(t, now)
+
+	var saved *appmodel.SigningKey
+	keys.EXPECT().GetActiveForUpdate(mock.Anything).Return(nil, repo.NewRepoError(repo.ErrNotFound, nil))
+	keys.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, key *appmodel.SigningKey) error {
+		saved = key
+		return nil
+	})
+	keys.EXPECT().ListValid(mock.Anything, now).RunAndReturn(func(ctx context.Context, now time.Time) ([]*appmodel.SigningKey, error) {
+		return []*appmodel.SigningKey{saved}, nil
+	})
+
+	require.NoError(t, uc.Rotate(context.Background()))
+
+	assert.Equal(t, jwks.AlgorithmEdDSA, saved.Algorithm)
+	assert.Nil(t, saved.RetiredAt)
+
+	key, err := ring.SigningKey()
+	require.NoError(t, err)
+	assert.Equal(t, saved.KeyID, key.ID)
+
+	// the private key is stored encrypted
+	data, err := jwks.MarshalPrivateKey(key)
+	require.NoError(t, err)
+	assert.NotContains(t, string(saved.PrivateKey), string(data))
+
+	signed, err := ring.Sign(jwt.RegisteredClaims{Subject: "1"})
+	require.NoError(t, err)
+	_, err = jwt.Parse(signed, ring.Keyfunc)
+	require.NoError(t, err)
+}
+
+func TestSigningKeyRotate(t *testing.T) {
+	now := time.Now()
+	uc, _, keys := newSigningKeyUsecase(t, now)
+
+	// not due yet
+	fresh := encryptedSigningKey(t, uc, now.Add(-time.Hour))
+	keys.EXPECT().GetActiveForUpdate(mock.Anything).Return(fresh, nil).Once()
+	keys.EXPECT().ListValid(mock.Anything, now).Return(nil, nil)
+
+	require.NoError(t, uc.Rotate(context.Background()))
+
+	// the old key is retired and verifies the tokens for the validity period
+	old := &appmodel.SigningKey{ID: 1, CreatedAt: now.Add(-25 * time.Hour), Algorithm: jwks.AlgorithmEdDSA}
+	keys.EXPECT().GetActiveForUpdate(mock.Anything).Return(old, nil).Once()
+	keys.EXPECT().UpdateMap(mock.Anything, int64(1), map[string]any{
+		"retired_at": now,
+		"expires_at": now.Add(time.Hour),
+	}).Return(nil).Once()
+	keys.EXPECT().Insert(mock.Anything, mock.Anything).Return(nil).Once()
+
+	require.NoError(t, uc.Rotate(context.Background()))
+
+	// another algorithm was configured
+	other := &appmodel.SigningKey{ID: 2, CreatedAt: now, Algorithm: jwks.AlgorithmRS256}
+	keys.EXPECT().GetActiveForUpdate(mock.Anything).Return(other, nil).Once()
+	keys.EXPECT().UpdateMap(mock.Anything, int64(2), mock.Anything).Return(nil).Once()
+	// created concurrently by another instance
+	keys.EXPECT().Insert(mock.Anything, mock.Anything).Return(repo.NewRepoError(repo.ErrDuplicated, nil)).Once()
+
+	require.NoError(t, uc.Rotate(context.Background()))
+}
+
+func TestSigningKeyLoadWrongSecret(t *testing.T) {
+	now := time.Now()
+	uc, ring, keys := newSigningKeyUsecase(t, now)
+
+	keys.EXPECT().ListValid(mock.Anything, now).Return([]*appmodel.SigningKey{
+		{ID: 1, KeyID: "kid", Algorithm: jwks.AlgorithmEdDSA, PrivateKey: []byte("not encrypted with the secret")},
+	}, nil)
+
+	// the key is skipped, so the rotation can replace it
+	require.NoError(t, uc.Load(context.Background()))
+	_, err := ring.SigningKey()
+	assert.Error(t, err)
+}
+
+func TestSigningKeyRotateWrongSecret(t *testing.T) {
+	now := time.Now()
+	uc, ring, keys := newSigningKeyUsecase(t, now)
+
+	// the active key is fresh but was encrypted with another secret
+	active := &appmodel.SigningKey{ID: 1, KeyID: "kid", CreatedAt: now.Add(-time.Hour), Algorithm: jwks.AlgorithmEdDSA, PrivateKey: []byte("not encrypted with the secret")}
+	var saved *appmodel.SigningKey
+	keys.EXPECT().GetActiveForUpdate(mock.Anything).Return(active, nil)
+	keys.EXPECT().UpdateMap(mock.Anything, int64(1), mock.Anything).Return(nil)
+	keys.EXPECT().Insert(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, key *appmodel.SigningKey) error {
+		saved = key
+		return nil
+	})
+	keys.EXPECT().ListValid(mock.Anything, now).RunAndReturn(func(ctx context.Context, now time.Time) ([]*appmodel.SigningKey, error) {
+		return []*appmodel.SigningKey{active, saved}, nil
+	})
+
+	require.NoError(t, uc.Rotate(context.Background()))
+
+	key, err := ring.SigningKey()
+	require.NoError(t, err)
+	assert.Equal(t, saved.KeyID, key.ID)
+}
+
+// encryptedSigningKey returns a key encrypted like the ones created by the usecase.
+func encryptedSigningKey(t *testing.T, uc *SigningKeyInteractor, createdAt time.Time) *appmodel.SigningKey {
+	key, err := jwks.GenerateKey(jwks.AlgorithmEdDSA, createdAt)
+	require.NoError(t, err)
+	data, err := jwks.MarshalPrivateKey(key)
+	require.NoError(t, err)
+	encrypted, err := aes.Encrypt(uc.encryptionKey(), data)
+	require.NoError(t, err)
+
+	return &appmodel.SigningKey{ID: 1, KeyID: key.ID, CreatedAt: createdAt, Algorithm: key.Algorithm, PrivateKey: encrypted}
+}
@@ -6,29 +6,95 @@ package usecase
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/health"
+	"go.megpoid.dev/go-skel/pkg/patch"
+	"go.megpoid.dev/go-skel/pkg/principal"
 	"go.megpoid.dev/go-skel/pkg/request"
 	"go.megpoid.dev/go-skel/pkg/response"
 )
 
+// Precondition checks the current version of a resource before modifying it, e.g. against the If-Match header.
+// It runs in the same transaction as the modification, with the row locked. A nil Precondition is skipped.
+type Precondition func(id int64, updatedAt time.Time) error
+
+// preconditionError wraps the error returned by a Precondition, to tell it apart from the repository errors.
+type preconditionError struct {
+	err error
+}
+
+func (e *preconditionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *preconditionError) Unwrap() error {
+	return e.err
+}
+
 type Auth interface {
-	Login(ctx context.Context, username, password string) (string, error)
+	Login(ctx context.Context, username, password, ipAddress string) (*model.AuthTokens, error)
+	Refresh(ctx context.Context, refreshToken, ipAddress string) (*model.AuthTokens, error)
+	Logout(ctx context.Context, session *model.Session, refreshToken, ipAddress string) error
+	TokenRevoked(ctx context.Context, tokenID string) (bool, error)
+	DeleteExpiredTokens(ctx context.Context) (int64, error)
+	Register(ctx context.Context, req *model.UserRequest, ipAddress string) (*model.User, error)
+	ChangePassword(ctx context.Context, userID int64, req *model.PasswordChangeRequest, ipAddress string) error
+}
+
+// SigningKey keeps the key ring of the access tokens in sync with the keys shared by the instances.
+type SigningKey interface {
+	Rotate(ctx context.Context) error
+	Load(ctx context.Context) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+type APIKey interface {
+	CreateKey(ctx context.Context, req *model.APIKeyRequest) (*model.APIKey, string, error)
+	ListKeys(ctx context.Context, query *request.QueryParams) (*response.ListResponse[*model.APIKey], error)
+	RotateKey(ctx context.Context, id int64) (*model.APIKey, string, error)
+	RevokeKey(ctx context.Context, id int64) error
+	Authenticate(ctx context.Context, key string) (*principal.Principal, error)
 }
 
 type Profile interface {
 	GetProfile(ctx context.Context, id int64) (*model.Profile, error)
 	ListProfiles(ctx context.Context, query *request.QueryParams) (*response.ListResponse[*model.Profile], error)
 	SaveProfile(ctx context.Context, req *model.ProfileRequest) (*model.Profile, error)
-	UpdateProfile(ctx context.Context, id int64, req *model.ProfileRequest) (*model.Profile, error)
-	RemoveProfile(ctx context.Context, id int64) error
+	UpdateProfile(ctx context.Context, id int64, req *model.ProfileRequest, precondition Precondition) (*model.Profile, error)
+	PatchProfile(ctx context.Context, id int64, p patch.Patch, precondition Precondition) (*model.Profile, error)
+	RemoveProfile(ctx context.Context, id int64, precondition Precondition) error
+}
+
+type ProfileImport interface {
+	CreateImport(ctx context.Context, filename, format string, r io.Reader) (*model.ProfileImport, error)
+	GetImport(ctx context.Context, id int64) (*model.ProfileImport, error)
+	FailImport(ctx context.Context, id int64, cause error) error
+	WriteErrorReport(ctx context.Context, id int64, w io.Writer) error
+}
+
+type ProfileImportJob interface {
+	Process(ctx context.Context, id int64, progress func(current, total int64) error) error
+	FailImport(ctx context.Context, id int64, cause error) error
+}
+
+// BatchOperation runs a single operation of a batch, ctx is bound to the transaction of the batch.
+type BatchOperation func(ctx context.Context, index int) error
+
+type Batch interface {
+	Run(ctx context.Context, operations int, continueOnError bool, fn BatchOperation) (bool, error)
 }
 
 type Healthcheck interface {
-	Execute(ctx context.Context) error
+	Execute(ctx context.Context) *health.Report
 }
 
 type DelayJob interface {
 	Process(ctx context.Context, delay time.Duration) (*Timers, error)
 }
+
+type Event interface {
+	Stream(ctx context.Context, resources []string, lastEventID *int64) (<-chan *model.Event, error)
+}
@@ -6,13 +6,908 @@ package usecase
 
 import (
 	"context"
+	"io"
 
 	mock "github.com/stretchr/testify/mock"
 	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/pkg/health"
+	"go.megpoid.dev/go-skel/pkg/patch"
+	"go.megpoid.dev/go-skel/pkg/principal"
 	"go.megpoid.dev/go-skel/pkg/request"
 	"go.megpoid.dev/go-skel/pkg/response"
 )
 
+// NewMockAuth creates a new instance of MockAuth. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuth(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuth {
+	mock := &MockAuth{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAuth is an autogenerated mock type for the Auth type
+type MockAuth struct {
+	mock.Mock
+}
+
+type MockAuth_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuth) EXPECT() *MockAuth_Expecter {
+	return &MockAuth_Expecter{mock: &_m.Mock}
+}
+
+// ChangePassword provides a mock function for the type MockAuth
+func (_mock *MockAuth) ChangePassword(ctx context.Context, userID int64, req *model.PasswordChangeRequest, ipAddress string) error {
+	ret := _mock.Called(ctx, userID, req, ipAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.PasswordChangeRequest, string) error); ok {
+		r0 = returnFunc(ctx, userID, req, ipAddress)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuth_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type MockAuth_ChangePassword_Call struct {
+	*mock.Call
+}
+
+// ChangePassword is a helper method to define mock.On call
+//   - ctx
+//   - userID
+//   - req
+//   - ipAddress
+func (_e *MockAuth_Expecter) ChangePassword(ctx interface{}, userID interface{}, req interface{}, ipAddress interface{}) *MockAuth_ChangePassword_Call {
+	return &MockAuth_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, userID, req, ipAddress)}
+}
+
+func (_c *MockAuth_ChangePassword_Call) Run(run func(ctx context.Context, userID int64, req *model.PasswordChangeRequest, ipAddress string)) *MockAuth_ChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*model.PasswordChangeRequest), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuth_ChangePassword_Call) Return(err error) *MockAuth_ChangePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuth_ChangePassword_Call) RunAndReturn(run func(ctx context.Context, userID int64, req *model.PasswordChangeRequest, ipAddress string) error) *MockAuth_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredTokens provides a mock function for the type MockAuth
+func (_mock *MockAuth) DeleteExpiredTokens(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredTokens")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuth_DeleteExpiredTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredTokens'
+type MockAuth_DeleteExpiredTokens_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredTokens is a helper method to define mock.On call
+//   - ctx
+func (_e *MockAuth_Expecter) DeleteExpiredTokens(ctx interface{}) *MockAuth_DeleteExpiredTokens_Call {
+	return &MockAuth_DeleteExpiredTokens_Call{Call: _e.mock.On("DeleteExpiredTokens", ctx)}
+}
+
+func (_c *MockAuth_DeleteExpiredTokens_Call) Run(run func(ctx context.Context)) *MockAuth_DeleteExpiredTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockAuth_DeleteExpiredTokens_Call) Return(n int64, err error) *MockAuth_DeleteExpiredTokens_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockAuth_DeleteExpiredTokens_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *MockAuth_DeleteExpiredTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function for the type MockAuth
+func (_mock *MockAuth) Login(ctx context.Context, username string, password string, ipAddress string) (*model.AuthTokens, error) {
+	ret := _mock.Called(ctx, username, password, ipAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *model.AuthTokens
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*model.AuthTokens, error)); ok {
+		return returnFunc(ctx, username, password, ipAddress)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *model.AuthTokens); ok {
+		r0 = returnFunc(ctx, username, password, ipAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuthTokens)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, username, password, ipAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuth_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockAuth_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx
+//   - username
+//   - password
+//   - ipAddress
+func (_e *MockAuth_Expecter) Login(ctx interface{}, username interface{}, password interface{}, ipAddress interface{}) *MockAuth_Login_Call {
+	return &MockAuth_Login_Call{Call: _e.mock.On("Login", ctx, username, password, ipAddress)}
+}
+
+func (_c *MockAuth_Login_Call) Run(run func(ctx context.Context, username string, password string, ipAddress string)) *MockAuth_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuth_Login_Call) Return(authTokens *model.AuthTokens, err error) *MockAuth_Login_Call {
+	_c.Call.Return(authTokens, err)
+	return _c
+}
+
+func (_c *MockAuth_Login_Call) RunAndReturn(run func(ctx context.Context, username string, password string, ipAddress string) (*model.AuthTokens, error)) *MockAuth_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function for the type MockAuth
+func (_mock *MockAuth) Logout(ctx context.Context, session *model.Session, refreshToken string, ipAddress string) error {
+	ret := _mock.Called(ctx, session, refreshToken, ipAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.Session, string, string) error); ok {
+		r0 = returnFunc(ctx, session, refreshToken, ipAddress)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAuth_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type MockAuth_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx
+//   - session
+//   - refreshToken
+//   - ipAddress
+func (_e *MockAuth_Expecter) Logout(ctx interface{}, session interface{}, refreshToken interface{}, ipAddress interface{}) *MockAuth_Logout_Call {
+	return &MockAuth_Logout_Call{Call: _e.mock.On("Logout", ctx, session, refreshToken, ipAddress)}
+}
+
+func (_c *MockAuth_Logout_Call) Run(run func(ctx context.Context, session *model.Session, refreshToken string, ipAddress string)) *MockAuth_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Session), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuth_Logout_Call) Return(err error) *MockAuth_Logout_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAuth_Logout_Call) RunAndReturn(run func(ctx context.Context, session *model.Session, refreshToken string, ipAddress string) error) *MockAuth_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function for the type MockAuth
+func (_mock *MockAuth) Refresh(ctx context.Context, refreshToken string, ipAddress string) (*model.AuthTokens, error) {
+	ret := _mock.Called(ctx, refreshToken, ipAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 *model.AuthTokens
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*model.AuthTokens, error)); ok {
+		return returnFunc(ctx, refreshToken, ipAddress)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *model.AuthTokens); ok {
+		r0 = returnFunc(ctx, refreshToken, ipAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuthTokens)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, refreshToken, ipAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuth_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockAuth_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx
+//   - refreshToken
+//   - ipAddress
+func (_e *MockAuth_Expecter) Refresh(ctx interface{}, refreshToken interface{}, ipAddress interface{}) *MockAuth_Refresh_Call {
+	return &MockAuth_Refresh_Call{Call: _e.mock.On("Refresh", ctx, refreshToken, ipAddress)}
+}
+
+func (_c *MockAuth_Refresh_Call) Run(run func(ctx context.Context, refreshToken string, ipAddress string)) *MockAuth_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuth_Refresh_Call) Return(authTokens *model.AuthTokens, err error) *MockAuth_Refresh_Call {
+	_c.Call.Return(authTokens, err)
+	return _c
+}
+
+func (_c *MockAuth_Refresh_Call) RunAndReturn(run func(ctx context.Context, refreshToken string, ipAddress string) (*model.AuthTokens, error)) *MockAuth_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function for the type MockAuth
+func (_mock *MockAuth) Register(ctx context.Context, req *model.UserRequest, ipAddress string) (*model.User, error) {
+	ret := _mock.Called(ctx, req, ipAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 *model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.UserRequest, string) (*model.User, error)); ok {
+		return returnFunc(ctx, req, ipAddress)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.UserRequest, string) *model.User); ok {
+		r0 = returnFunc(ctx, req, ipAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.UserRequest, string) error); ok {
+		r1 = returnFunc(ctx, req, ipAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuth_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type MockAuth_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - ctx
+//   - req
+//   - ipAddress
+func (_e *MockAuth_Expecter) Register(ctx interface{}, req interface{}, ipAddress interface{}) *MockAuth_Register_Call {
+	return &MockAuth_Register_Call{Call: _e.mock.On("Register", ctx, req, ipAddress)}
+}
+
+func (_c *MockAuth_Register_Call) Run(run func(ctx context.Context, req *model.UserRequest, ipAddress string)) *MockAuth_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.UserRequest), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuth_Register_Call) Return(user *model.User, err error) *MockAuth_Register_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockAuth_Register_Call) RunAndReturn(run func(ctx context.Context, req *model.UserRequest, ipAddress string) (*model.User, error)) *MockAuth_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TokenRevoked provides a mock function for the type MockAuth
+func (_mock *MockAuth) TokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	ret := _mock.Called(ctx, tokenID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TokenRevoked")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, tokenID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, tokenID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, tokenID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuth_TokenRevoked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TokenRevoked'
+type MockAuth_TokenRevoked_Call struct {
+	*mock.Call
+}
+
+// TokenRevoked is a helper method to define mock.On call
+//   - ctx
+//   - tokenID
+func (_e *MockAuth_Expecter) TokenRevoked(ctx interface{}, tokenID interface{}) *MockAuth_TokenRevoked_Call {
+	return &MockAuth_TokenRevoked_Call{Call: _e.mock.On("TokenRevoked", ctx, tokenID)}
+}
+
+func (_c *MockAuth_TokenRevoked_Call) Run(run func(ctx context.Context, tokenID string)) *MockAuth_TokenRevoked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAuth_TokenRevoked_Call) Return(b bool, err error) *MockAuth_TokenRevoked_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockAuth_TokenRevoked_Call) RunAndReturn(run func(ctx context.Context, tokenID string) (bool, error)) *MockAuth_TokenRevoked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSigningKey creates a new instance of MockSigningKey. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSigningKey(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSigningKey {
+	mock := &MockSigningKey{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSigningKey is an autogenerated mock type for the SigningKey type
+type MockSigningKey struct {
+	mock.Mock
+}
+
+type MockSigningKey_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSigningKey) EXPECT() *MockSigningKey_Expecter {
+	return &MockSigningKey_Expecter{mock: &_m.Mock}
+}
+
+// DeleteExpired provides a mock function for the type MockSigningKey
+func (_mock *MockSigningKey) DeleteExpired(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpired")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSigningKey_DeleteExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpired'
+type MockSigningKey_DeleteExpired_Call struct {
+	*mock.Call
+}
+
+// DeleteExpired is a helper method to define mock.On call
+//   - ctx
+func (_e *MockSigningKey_Expecter) DeleteExpired(ctx interface{}) *MockSigningKey_DeleteExpired_Call {
+	return &MockSigningKey_DeleteExpired_Call{Call: _e.mock.On("DeleteExpired", ctx)}
+}
+
+func (_c *MockSigningKey_DeleteExpired_Call) Run(run func(ctx context.Context)) *MockSigningKey_DeleteExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSigningKey_DeleteExpired_Call) Return(n int64, err error) *MockSigningKey_DeleteExpired_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSigningKey_DeleteExpired_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *MockSigningKey_DeleteExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Load provides a mock function for the type MockSigningKey
+func (_mock *MockSigningKey) Load(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Load")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSigningKey_Load_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Load'
+type MockSigningKey_Load_Call struct {
+	*mock.Call
+}
+
+// Load is a helper method to define mock.On call
+//   - ctx
+func (_e *MockSigningKey_Expecter) Load(ctx interface{}) *MockSigningKey_Load_Call {
+	return &MockSigningKey_Load_Call{Call: _e.mock.On("Load", ctx)}
+}
+
+func (_c *MockSigningKey_Load_Call) Run(run func(ctx context.Context)) *MockSigningKey_Load_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSigningKey_Load_Call) Return(err error) *MockSigningKey_Load_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSigningKey_Load_Call) RunAndReturn(run func(ctx context.Context) error) *MockSigningKey_Load_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rotate provides a mock function for the type MockSigningKey
+func (_mock *MockSigningKey) Rotate(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSigningKey_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type MockSigningKey_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+//   - ctx
+func (_e *MockSigningKey_Expecter) Rotate(ctx interface{}) *MockSigningKey_Rotate_Call {
+	return &MockSigningKey_Rotate_Call{Call: _e.mock.On("Rotate", ctx)}
+}
+
+func (_c *MockSigningKey_Rotate_Call) Run(run func(ctx context.Context)) *MockSigningKey_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSigningKey_Rotate_Call) Return(err error) *MockSigningKey_Rotate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSigningKey_Rotate_Call) RunAndReturn(run func(ctx context.Context) error) *MockSigningKey_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPIKey creates a new instance of MockAPIKey. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKey(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKey {
+	mock := &MockAPIKey{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAPIKey is an autogenerated mock type for the APIKey type
+type MockAPIKey struct {
+	mock.Mock
+}
+
+type MockAPIKey_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKey) EXPECT() *MockAPIKey_Expecter {
+	return &MockAPIKey_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function for the type MockAPIKey
+func (_mock *MockAPIKey) Authenticate(ctx context.Context, key string) (*principal.Principal, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *principal.Principal
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*principal.Principal, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *principal.Principal); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*principal.Principal)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKey_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockAPIKey_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx
+//   - key
+func (_e *MockAPIKey_Expecter) Authenticate(ctx interface{}, key interface{}) *MockAPIKey_Authenticate_Call {
+	return &MockAPIKey_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, key)}
+}
+
+func (_c *MockAPIKey_Authenticate_Call) Run(run func(ctx context.Context, key string)) *MockAPIKey_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPIKey_Authenticate_Call) Return(principal1 *principal.Principal, err error) *MockAPIKey_Authenticate_Call {
+	_c.Call.Return(principal1, err)
+	return _c
+}
+
+func (_c *MockAPIKey_Authenticate_Call) RunAndReturn(run func(ctx context.Context, key string) (*principal.Principal, error)) *MockAPIKey_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateKey provides a mock function for the type MockAPIKey
+func (_mock *MockAPIKey) CreateKey(ctx context.Context, req *model.APIKeyRequest) (*model.APIKey, string, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateKey")
+	}
+
+	var r0 *model.APIKey
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.APIKeyRequest) (*model.APIKey, string, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.APIKeyRequest) *model.APIKey); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.APIKeyRequest) string); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, *model.APIKeyRequest) error); ok {
+		r2 = returnFunc(ctx, req)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockAPIKey_CreateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateKey'
+type MockAPIKey_CreateKey_Call struct {
+	*mock.Call
+}
+
+// CreateKey is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockAPIKey_Expecter) CreateKey(ctx interface{}, req interface{}) *MockAPIKey_CreateKey_Call {
+	return &MockAPIKey_CreateKey_Call{Call: _e.mock.On("CreateKey", ctx, req)}
+}
+
+func (_c *MockAPIKey_CreateKey_Call) Run(run func(ctx context.Context, req *model.APIKeyRequest)) *MockAPIKey_CreateKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.APIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *MockAPIKey_CreateKey_Call) Return(aPIKey *model.APIKey, s string, err error) *MockAPIKey_CreateKey_Call {
+	_c.Call.Return(aPIKey, s, err)
+	return _c
+}
+
+func (_c *MockAPIKey_CreateKey_Call) RunAndReturn(run func(ctx context.Context, req *model.APIKeyRequest) (*model.APIKey, string, error)) *MockAPIKey_CreateKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListKeys provides a mock function for the type MockAPIKey
+func (_mock *MockAPIKey) ListKeys(ctx context.Context, query *request.QueryParams) (*response.ListResponse[*model.APIKey], error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKeys")
+	}
+
+	var r0 *response.ListResponse[*model.APIKey]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *request.QueryParams) (*response.ListResponse[*model.APIKey], error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *request.QueryParams) *response.ListResponse[*model.APIKey]); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.APIKey])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *request.QueryParams) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKey_ListKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKeys'
+type MockAPIKey_ListKeys_Call struct {
+	*mock.Call
+}
+
+// ListKeys is a helper method to define mock.On call
+//   - ctx
+//   - query
+func (_e *MockAPIKey_Expecter) ListKeys(ctx interface{}, query interface{}) *MockAPIKey_ListKeys_Call {
+	return &MockAPIKey_ListKeys_Call{Call: _e.mock.On("ListKeys", ctx, query)}
+}
+
+func (_c *MockAPIKey_ListKeys_Call) Run(run func(ctx context.Context, query *request.QueryParams)) *MockAPIKey_ListKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*request.QueryParams))
+	})
+	return _c
+}
+
+func (_c *MockAPIKey_ListKeys_Call) Return(listResponse *response.ListResponse[*model.APIKey], err error) *MockAPIKey_ListKeys_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockAPIKey_ListKeys_Call) RunAndReturn(run func(ctx context.Context, query *request.QueryParams) (*response.ListResponse[*model.APIKey], error)) *MockAPIKey_ListKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeKey provides a mock function for the type MockAPIKey
+func (_mock *MockAPIKey) RevokeKey(ctx context.Context, id int64) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAPIKey_RevokeKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeKey'
+type MockAPIKey_RevokeKey_Call struct {
+	*mock.Call
+}
+
+// RevokeKey is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockAPIKey_Expecter) RevokeKey(ctx interface{}, id interface{}) *MockAPIKey_RevokeKey_Call {
+	return &MockAPIKey_RevokeKey_Call{Call: _e.mock.On("RevokeKey", ctx, id)}
+}
+
+func (_c *MockAPIKey_RevokeKey_Call) Run(run func(ctx context.Context, id int64)) *MockAPIKey_RevokeKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPIKey_RevokeKey_Call) Return(err error) *MockAPIKey_RevokeKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAPIKey_RevokeKey_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockAPIKey_RevokeKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateKey provides a mock function for the type MockAPIKey
+func (_mock *MockAPIKey) RotateKey(ctx context.Context, id int64) (*model.APIKey, string, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateKey")
+	}
+
+	var r0 *model.APIKey
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.APIKey, string, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.APIKey); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) string); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, int64) error); ok {
+		r2 = returnFunc(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockAPIKey_RotateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateKey'
+type MockAPIKey_RotateKey_Call struct {
+	*mock.Call
+}
+
+// RotateKey is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockAPIKey_Expecter) RotateKey(ctx interface{}, id interface{}) *MockAPIKey_RotateKey_Call {
+	return &MockAPIKey_RotateKey_Call{Call: _e.mock.On("RotateKey", ctx, id)}
+}
+
+func (_c *MockAPIKey_RotateKey_Call) Run(run func(ctx context.Context, id int64)) *MockAPIKey_RotateKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockAPIKey_RotateKey_Call) Return(aPIKey *model.APIKey, s string, err error) *MockAPIKey_RotateKey_Call {
+	_c.Call.Return(aPIKey, s, err)
+	return _c
+}
+
+func (_c *MockAPIKey_RotateKey_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.APIKey, string, error)) *MockAPIKey_RotateKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockProfile creates a new instance of MockProfile. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockProfile(t interface {
@@ -22,295 +917,677 @@ func NewMockProfile(t interface {
 	mock := &MockProfile{}
 	mock.Mock.Test(t)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProfile is an autogenerated mock type for the Profile type
+type MockProfile struct {
+	mock.Mock
+}
+
+type MockProfile_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProfile) EXPECT() *MockProfile_Expecter {
+	return &MockProfile_Expecter{mock: &_m.Mock}
+}
+
+// GetProfile provides a mock function for the type MockProfile
+func (_mock *MockProfile) GetProfile(ctx context.Context, id int64) (*model.Profile, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfile")
+	}
+
+	var r0 *model.Profile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.Profile, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.Profile); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Profile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfile_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
+type MockProfile_GetProfile_Call struct {
+	*mock.Call
+}
+
+// GetProfile is a helper method to define mock.On call
+//   - ctx
+//   - id
+func (_e *MockProfile_Expecter) GetProfile(ctx interface{}, id interface{}) *MockProfile_GetProfile_Call {
+	return &MockProfile_GetProfile_Call{Call: _e.mock.On("GetProfile", ctx, id)}
+}
+
+func (_c *MockProfile_GetProfile_Call) Run(run func(ctx context.Context, id int64)) *MockProfile_GetProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockProfile_GetProfile_Call) Return(profile *model.Profile, err error) *MockProfile_GetProfile_Call {
+	_c.Call.Return(profile, err)
+	return _c
+}
+
+func (_c *MockProfile_GetProfile_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.Profile, error)) *MockProfile_GetProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListProfiles provides a mock function for the type MockProfile
+func (_mock *MockProfile) ListProfiles(ctx context.Context, query *request.QueryParams) (*response.ListResponse[*model.Profile], error) {
+	ret := _mock.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProfiles")
+	}
+
+	var r0 *response.ListResponse[*model.Profile]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *request.QueryParams) (*response.ListResponse[*model.Profile], error)); ok {
+		return returnFunc(ctx, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *request.QueryParams) *response.ListResponse[*model.Profile]); ok {
+		r0 = returnFunc(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.ListResponse[*model.Profile])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *request.QueryParams) error); ok {
+		r1 = returnFunc(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfile_ListProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProfiles'
+type MockProfile_ListProfiles_Call struct {
+	*mock.Call
+}
+
+// ListProfiles is a helper method to define mock.On call
+//   - ctx
+//   - query
+func (_e *MockProfile_Expecter) ListProfiles(ctx interface{}, query interface{}) *MockProfile_ListProfiles_Call {
+	return &MockProfile_ListProfiles_Call{Call: _e.mock.On("ListProfiles", ctx, query)}
+}
+
+func (_c *MockProfile_ListProfiles_Call) Run(run func(ctx context.Context, query *request.QueryParams)) *MockProfile_ListProfiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*request.QueryParams))
+	})
+	return _c
+}
+
+func (_c *MockProfile_ListProfiles_Call) Return(listResponse *response.ListResponse[*model.Profile], err error) *MockProfile_ListProfiles_Call {
+	_c.Call.Return(listResponse, err)
+	return _c
+}
+
+func (_c *MockProfile_ListProfiles_Call) RunAndReturn(run func(ctx context.Context, query *request.QueryParams) (*response.ListResponse[*model.Profile], error)) *MockProfile_ListProfiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchProfile provides a mock function for the type MockProfile
+func (_mock *MockProfile) PatchProfile(ctx context.Context, id int64, p patch.Patch, precondition Precondition) (*model.Profile, error) {
+	ret := _mock.Called(ctx, id, p, precondition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchProfile")
+	}
+
+	var r0 *model.Profile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, patch.Patch, Precondition) (*model.Profile, error)); ok {
+		return returnFunc(ctx, id, p, precondition)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, patch.Patch, Precondition) *model.Profile); ok {
+		r0 = returnFunc(ctx, id, p, precondition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Profile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, patch.Patch, Precondition) error); ok {
+		r1 = returnFunc(ctx, id, p, precondition)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfile_PatchProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchProfile'
+type MockProfile_PatchProfile_Call struct {
+	*mock.Call
+}
+
+// PatchProfile is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - p
+//   - precondition
+func (_e *MockProfile_Expecter) PatchProfile(ctx interface{}, id interface{}, p interface{}, precondition interface{}) *MockProfile_PatchProfile_Call {
+	return &MockProfile_PatchProfile_Call{Call: _e.mock.On("PatchProfile", ctx, id, p, precondition)}
+}
+
+func (_c *MockProfile_PatchProfile_Call) Run(run func(ctx context.Context, id int64, p patch.Patch, precondition Precondition)) *MockProfile_PatchProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(patch.Patch), args[3].(Precondition))
+	})
+	return _c
+}
+
+func (_c *MockProfile_PatchProfile_Call) Return(profile *model.Profile, err error) *MockProfile_PatchProfile_Call {
+	_c.Call.Return(profile, err)
+	return _c
+}
+
+func (_c *MockProfile_PatchProfile_Call) RunAndReturn(run func(ctx context.Context, id int64, p patch.Patch, precondition Precondition) (*model.Profile, error)) *MockProfile_PatchProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveProfile provides a mock function for the type MockProfile
+func (_mock *MockProfile) RemoveProfile(ctx context.Context, id int64, precondition Precondition) error {
+	ret := _mock.Called(ctx, id, precondition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, Precondition) error); ok {
+		r0 = returnFunc(ctx, id, precondition)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfile_RemoveProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveProfile'
+type MockProfile_RemoveProfile_Call struct {
+	*mock.Call
+}
+
+// RemoveProfile is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - precondition
+func (_e *MockProfile_Expecter) RemoveProfile(ctx interface{}, id interface{}, precondition interface{}) *MockProfile_RemoveProfile_Call {
+	return &MockProfile_RemoveProfile_Call{Call: _e.mock.On("RemoveProfile", ctx, id, precondition)}
+}
+
+func (_c *MockProfile_RemoveProfile_Call) Run(run func(ctx context.Context, id int64, precondition Precondition)) *MockProfile_RemoveProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(Precondition))
+	})
+	return _c
+}
+
+func (_c *MockProfile_RemoveProfile_Call) Return(err error) *MockProfile_RemoveProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfile_RemoveProfile_Call) RunAndReturn(run func(ctx context.Context, id int64, precondition Precondition) error) *MockProfile_RemoveProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveProfile provides a mock function for the type MockProfile
+func (_mock *MockProfile) SaveProfile(ctx context.Context, req *model.ProfileRequest) (*model.Profile, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveProfile")
+	}
+
+	var r0 *model.Profile
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.ProfileRequest) (*model.Profile, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.ProfileRequest) *model.Profile); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Profile)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.ProfileRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProfile_SaveProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveProfile'
+type MockProfile_SaveProfile_Call struct {
+	*mock.Call
+}
 
-	return mock
+// SaveProfile is a helper method to define mock.On call
+//   - ctx
+//   - req
+func (_e *MockProfile_Expecter) SaveProfile(ctx interface{}, req interface{}) *MockProfile_SaveProfile_Call {
+	return &MockProfile_SaveProfile_Call{Call: _e.mock.On("SaveProfile", ctx, req)}
 }
 
-// MockProfile is an autogenerated mock type for the Profile type
-type MockProfile struct {
-	mock.Mock
+func (_c *MockProfile_SaveProfile_Call) Run(run func(ctx context.Context, req *model.ProfileRequest)) *MockProfile_SaveProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.ProfileRequest))
+	})
+	return _c
 }
 
-type MockProfile_Expecter struct {
-	mock *mock.Mock
+func (_c *MockProfile_SaveProfile_Call) Return(profile *model.Profile, err error) *MockProfile_SaveProfile_Call {
+	_c.Call.Return(profile, err)
+	return _c
 }
 
-func (_m *MockProfile) EXPECT() *MockProfile_Expecter {
-	return &MockProfile_Expecter{mock: &_m.Mock}
+func (_c *MockProfile_SaveProfile_Call) RunAndReturn(run func(ctx context.Context, req *model.ProfileRequest) (*model.Profile, error)) *MockProfile_SaveProfile_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// GetProfile provides a mock function for the type MockProfile
-func (_mock *MockProfile) GetProfile(ctx context.Context, id int64) (*model.Profile, error) {
-	ret := _mock.Called(ctx, id)
+// UpdateProfile provides a mock function for the type MockProfile
+func (_mock *MockProfile) UpdateProfile(ctx context.Context, id int64, req *model.ProfileRequest, precondition Precondition) (*model.Profile, error) {
+	ret := _mock.Called(ctx, id, req, precondition)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetProfile")
+		panic("no return value specified for UpdateProfile")
 	}
 
 	var r0 *model.Profile
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.Profile, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.ProfileRequest, Precondition) (*model.Profile, error)); ok {
+		return returnFunc(ctx, id, req, precondition)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.Profile); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.ProfileRequest, Precondition) *model.Profile); ok {
+		r0 = returnFunc(ctx, id, req, precondition)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Profile)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.ProfileRequest, Precondition) error); ok {
+		r1 = returnFunc(ctx, id, req, precondition)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockProfile_GetProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfile'
-type MockProfile_GetProfile_Call struct {
+// MockProfile_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockProfile_UpdateProfile_Call struct {
 	*mock.Call
 }
 
-// GetProfile is a helper method to define mock.On call
+// UpdateProfile is a helper method to define mock.On call
 //   - ctx
 //   - id
-func (_e *MockProfile_Expecter) GetProfile(ctx interface{}, id interface{}) *MockProfile_GetProfile_Call {
-	return &MockProfile_GetProfile_Call{Call: _e.mock.On("GetProfile", ctx, id)}
+//   - req
+//   - precondition
+func (_e *MockProfile_Expecter) UpdateProfile(ctx interface{}, id interface{}, req interface{}, precondition interface{}) *MockProfile_UpdateProfile_Call {
+	return &MockProfile_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, id, req, precondition)}
 }
 
-func (_c *MockProfile_GetProfile_Call) Run(run func(ctx context.Context, id int64)) *MockProfile_GetProfile_Call {
+func (_c *MockProfile_UpdateProfile_Call) Run(run func(ctx context.Context, id int64, req *model.ProfileRequest, precondition Precondition)) *MockProfile_UpdateProfile_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(*model.ProfileRequest), args[3].(Precondition))
 	})
 	return _c
 }
 
-func (_c *MockProfile_GetProfile_Call) Return(profile *model.Profile, err error) *MockProfile_GetProfile_Call {
+func (_c *MockProfile_UpdateProfile_Call) Return(profile *model.Profile, err error) *MockProfile_UpdateProfile_Call {
 	_c.Call.Return(profile, err)
 	return _c
 }
 
-func (_c *MockProfile_GetProfile_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.Profile, error)) *MockProfile_GetProfile_Call {
+func (_c *MockProfile_UpdateProfile_Call) RunAndReturn(run func(ctx context.Context, id int64, req *model.ProfileRequest, precondition Precondition) (*model.Profile, error)) *MockProfile_UpdateProfile_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListProfiles provides a mock function for the type MockProfile
-func (_mock *MockProfile) ListProfiles(ctx context.Context, query *request.QueryParams) (*response.ListResponse[*model.Profile], error) {
-	ret := _mock.Called(ctx, query)
+// NewMockProfileImport creates a new instance of MockProfileImport. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProfileImport(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProfileImport {
+	mock := &MockProfileImport{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProfileImport is an autogenerated mock type for the ProfileImport type
+type MockProfileImport struct {
+	mock.Mock
+}
+
+type MockProfileImport_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProfileImport) EXPECT() *MockProfileImport_Expecter {
+	return &MockProfileImport_Expecter{mock: &_m.Mock}
+}
+
+// CreateImport provides a mock function for the type MockProfileImport
+func (_mock *MockProfileImport) CreateImport(ctx context.Context, filename string, format string, r io.Reader) (*model.ProfileImport, error) {
+	ret := _mock.Called(ctx, filename, format, r)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListProfiles")
+		panic("no return value specified for CreateImport")
 	}
 
-	var r0 *response.ListResponse[*model.Profile]
+	var r0 *model.ProfileImport
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *request.QueryParams) (*response.ListResponse[*model.Profile], error)); ok {
-		return returnFunc(ctx, query)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, io.Reader) (*model.ProfileImport, error)); ok {
+		return returnFunc(ctx, filename, format, r)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *request.QueryParams) *response.ListResponse[*model.Profile]); ok {
-		r0 = returnFunc(ctx, query)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, io.Reader) *model.ProfileImport); ok {
+		r0 = returnFunc(ctx, filename, format, r)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*response.ListResponse[*model.Profile])
+			r0 = ret.Get(0).(*model.ProfileImport)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *request.QueryParams) error); ok {
-		r1 = returnFunc(ctx, query)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, io.Reader) error); ok {
+		r1 = returnFunc(ctx, filename, format, r)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockProfile_ListProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProfiles'
-type MockProfile_ListProfiles_Call struct {
+// MockProfileImport_CreateImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateImport'
+type MockProfileImport_CreateImport_Call struct {
 	*mock.Call
 }
 
-// ListProfiles is a helper method to define mock.On call
+// CreateImport is a helper method to define mock.On call
 //   - ctx
-//   - query
-func (_e *MockProfile_Expecter) ListProfiles(ctx interface{}, query interface{}) *MockProfile_ListProfiles_Call {
-	return &MockProfile_ListProfiles_Call{Call: _e.mock.On("ListProfiles", ctx, query)}
+//   - filename
+//   - format
+//   - r
+func (_e *MockProfileImport_Expecter) CreateImport(ctx interface{}, filename interface{}, format interface{}, r interface{}) *MockProfileImport_CreateImport_Call {
+	return &MockProfileImport_CreateImport_Call{Call: _e.mock.On("CreateImport", ctx, filename, format, r)}
 }
 
-func (_c *MockProfile_ListProfiles_Call) Run(run func(ctx context.Context, query *request.QueryParams)) *MockProfile_ListProfiles_Call {
+func (_c *MockProfileImport_CreateImport_Call) Run(run func(ctx context.Context, filename string, format string, r io.Reader)) *MockProfileImport_CreateImport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*request.QueryParams))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(io.Reader))
 	})
 	return _c
 }
 
-func (_c *MockProfile_ListProfiles_Call) Return(listResponse *response.ListResponse[*model.Profile], err error) *MockProfile_ListProfiles_Call {
-	_c.Call.Return(listResponse, err)
+func (_c *MockProfileImport_CreateImport_Call) Return(profileImport *model.ProfileImport, err error) *MockProfileImport_CreateImport_Call {
+	_c.Call.Return(profileImport, err)
 	return _c
 }
 
-func (_c *MockProfile_ListProfiles_Call) RunAndReturn(run func(ctx context.Context, query *request.QueryParams) (*response.ListResponse[*model.Profile], error)) *MockProfile_ListProfiles_Call {
+func (_c *MockProfileImport_CreateImport_Call) RunAndReturn(run func(ctx context.Context, filename string, format string, r io.Reader) (*model.ProfileImport, error)) *MockProfileImport_CreateImport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveProfile provides a mock function for the type MockProfile
-func (_mock *MockProfile) RemoveProfile(ctx context.Context, id int64) error {
-	ret := _mock.Called(ctx, id)
+// FailImport provides a mock function for the type MockProfileImport
+func (_mock *MockProfileImport) FailImport(ctx context.Context, id int64, cause error) error {
+	ret := _mock.Called(ctx, id, cause)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveProfile")
+		panic("no return value specified for FailImport")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, error) error); ok {
+		r0 = returnFunc(ctx, id, cause)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockProfile_RemoveProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveProfile'
-type MockProfile_RemoveProfile_Call struct {
+// MockProfileImport_FailImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailImport'
+type MockProfileImport_FailImport_Call struct {
 	*mock.Call
 }
 
-// RemoveProfile is a helper method to define mock.On call
+// FailImport is a helper method to define mock.On call
 //   - ctx
 //   - id
-func (_e *MockProfile_Expecter) RemoveProfile(ctx interface{}, id interface{}) *MockProfile_RemoveProfile_Call {
-	return &MockProfile_RemoveProfile_Call{Call: _e.mock.On("RemoveProfile", ctx, id)}
+//   - cause
+func (_e *MockProfileImport_Expecter) FailImport(ctx interface{}, id interface{}, cause interface{}) *MockProfileImport_FailImport_Call {
+	return &MockProfileImport_FailImport_Call{Call: _e.mock.On("FailImport", ctx, id, cause)}
 }
 
-func (_c *MockProfile_RemoveProfile_Call) Run(run func(ctx context.Context, id int64)) *MockProfile_RemoveProfile_Call {
+func (_c *MockProfileImport_FailImport_Call) Run(run func(ctx context.Context, id int64, cause error)) *MockProfileImport_FailImport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(error))
 	})
 	return _c
 }
 
-func (_c *MockProfile_RemoveProfile_Call) Return(err error) *MockProfile_RemoveProfile_Call {
+func (_c *MockProfileImport_FailImport_Call) Return(err error) *MockProfileImport_FailImport_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockProfile_RemoveProfile_Call) RunAndReturn(run func(ctx context.Context, id int64) error) *MockProfile_RemoveProfile_Call {
+func (_c *MockProfileImport_FailImport_Call) RunAndReturn(run func(ctx context.Context, id int64, cause error) error) *MockProfileImport_FailImport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveProfile provides a mock function for the type MockProfile
-func (_mock *MockProfile) SaveProfile(ctx context.Context, req *model.ProfileRequest) (*model.Profile, error) {
-	ret := _mock.Called(ctx, req)
+// GetImport provides a mock function for the type MockProfileImport
+func (_mock *MockProfileImport) GetImport(ctx context.Context, id int64) (*model.ProfileImport, error) {
+	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveProfile")
+		panic("no return value specified for GetImport")
 	}
 
-	var r0 *model.Profile
+	var r0 *model.ProfileImport
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.ProfileRequest) (*model.Profile, error)); ok {
-		return returnFunc(ctx, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) (*model.ProfileImport, error)); ok {
+		return returnFunc(ctx, id)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *model.ProfileRequest) *model.Profile); ok {
-		r0 = returnFunc(ctx, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) *model.ProfileImport); ok {
+		r0 = returnFunc(ctx, id)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Profile)
+			r0 = ret.Get(0).(*model.ProfileImport)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *model.ProfileRequest) error); ok {
-		r1 = returnFunc(ctx, req)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockProfile_SaveProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveProfile'
-type MockProfile_SaveProfile_Call struct {
+// MockProfileImport_GetImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImport'
+type MockProfileImport_GetImport_Call struct {
 	*mock.Call
 }
 
-// SaveProfile is a helper method to define mock.On call
+// GetImport is a helper method to define mock.On call
 //   - ctx
-//   - req
-func (_e *MockProfile_Expecter) SaveProfile(ctx interface{}, req interface{}) *MockProfile_SaveProfile_Call {
-	return &MockProfile_SaveProfile_Call{Call: _e.mock.On("SaveProfile", ctx, req)}
+//   - id
+func (_e *MockProfileImport_Expecter) GetImport(ctx interface{}, id interface{}) *MockProfileImport_GetImport_Call {
+	return &MockProfileImport_GetImport_Call{Call: _e.mock.On("GetImport", ctx, id)}
 }
 
-func (_c *MockProfile_SaveProfile_Call) Run(run func(ctx context.Context, req *model.ProfileRequest)) *MockProfile_SaveProfile_Call {
+func (_c *MockProfileImport_GetImport_Call) Run(run func(ctx context.Context, id int64)) *MockProfileImport_GetImport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.ProfileRequest))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *MockProfile_SaveProfile_Call) Return(profile *model.Profile, err error) *MockProfile_SaveProfile_Call {
-	_c.Call.Return(profile, err)
+func (_c *MockProfileImport_GetImport_Call) Return(profileImport *model.ProfileImport, err error) *MockProfileImport_GetImport_Call {
+	_c.Call.Return(profileImport, err)
 	return _c
 }
 
-func (_c *MockProfile_SaveProfile_Call) RunAndReturn(run func(ctx context.Context, req *model.ProfileRequest) (*model.Profile, error)) *MockProfile_SaveProfile_Call {
+func (_c *MockProfileImport_GetImport_Call) RunAndReturn(run func(ctx context.Context, id int64) (*model.ProfileImport, error)) *MockProfileImport_GetImport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateProfile provides a mock function for the type MockProfile
-func (_mock *MockProfile) UpdateProfile(ctx context.Context, id int64, req *model.ProfileRequest) (*model.Profile, error) {
-	ret := _mock.Called(ctx, id, req)
+// WriteErrorReport provides a mock function for the type MockProfileImport
+func (_mock *MockProfileImport) WriteErrorReport(ctx context.Context, id int64, w io.Writer) error {
+	ret := _mock.Called(ctx, id, w)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateProfile")
+		panic("no return value specified for WriteErrorReport")
 	}
 
-	var r0 *model.Profile
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, io.Writer) error); ok {
+		r0 = returnFunc(ctx, id, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProfileImport_WriteErrorReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteErrorReport'
+type MockProfileImport_WriteErrorReport_Call struct {
+	*mock.Call
+}
+
+// WriteErrorReport is a helper method to define mock.On call
+//   - ctx
+//   - id
+//   - w
+func (_e *MockProfileImport_Expecter) WriteErrorReport(ctx interface{}, id interface{}, w interface{}) *MockProfileImport_WriteErrorReport_Call {
+	return &MockProfileImport_WriteErrorReport_Call{Call: _e.mock.On("WriteErrorReport", ctx, id, w)}
+}
+
+func (_c *MockProfileImport_WriteErrorReport_Call) Run(run func(ctx context.Context, id int64, w io.Writer)) *MockProfileImport_WriteErrorReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockProfileImport_WriteErrorReport_Call) Return(err error) *MockProfileImport_WriteErrorReport_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProfileImport_WriteErrorReport_Call) RunAndReturn(run func(ctx context.Context, id int64, w io.Writer) error) *MockProfileImport_WriteErrorReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBatch creates a new instance of MockBatch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBatch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBatch {
+	mock := &MockBatch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBatch is an autogenerated mock type for the Batch type
+type MockBatch struct {
+	mock.Mock
+}
+
+type MockBatch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBatch) EXPECT() *MockBatch_Expecter {
+	return &MockBatch_Expecter{mock: &_m.Mock}
+}
+
+// Run provides a mock function for the type MockBatch
+func (_mock *MockBatch) Run(ctx context.Context, operations int, continueOnError bool, fn BatchOperation) (bool, error) {
+	ret := _mock.Called(ctx, operations, continueOnError, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.ProfileRequest) (*model.Profile, error)); ok {
-		return returnFunc(ctx, id, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, bool, BatchOperation) (bool, error)); ok {
+		return returnFunc(ctx, operations, continueOnError, fn)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, *model.ProfileRequest) *model.Profile); ok {
-		r0 = returnFunc(ctx, id, req)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, bool, BatchOperation) bool); ok {
+		r0 = returnFunc(ctx, operations, continueOnError, fn)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Profile)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, *model.ProfileRequest) error); ok {
-		r1 = returnFunc(ctx, id, req)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, bool, BatchOperation) error); ok {
+		r1 = returnFunc(ctx, operations, continueOnError, fn)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockProfile_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
-type MockProfile_UpdateProfile_Call struct {
+// MockBatch_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type MockBatch_Run_Call struct {
 	*mock.Call
 }
 
-// UpdateProfile is a helper method to define mock.On call
+// Run is a helper method to define mock.On call
 //   - ctx
-//   - id
-//   - req
-func (_e *MockProfile_Expecter) UpdateProfile(ctx interface{}, id interface{}, req interface{}) *MockProfile_UpdateProfile_Call {
-	return &MockProfile_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, id, req)}
+//   - operations
+//   - continueOnError
+//   - fn
+func (_e *MockBatch_Expecter) Run(ctx interface{}, operations interface{}, continueOnError interface{}, fn interface{}) *MockBatch_Run_Call {
+	return &MockBatch_Run_Call{Call: _e.mock.On("Run", ctx, operations, continueOnError, fn)}
 }
 
-func (_c *MockProfile_UpdateProfile_Call) Run(run func(ctx context.Context, id int64, req *model.ProfileRequest)) *MockProfile_UpdateProfile_Call {
+func (_c *MockBatch_Run_Call) Run(run func(ctx context.Context, operations int, continueOnError bool, fn BatchOperation)) *MockBatch_Run_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64), args[2].(*model.ProfileRequest))
+		run(args[0].(context.Context), args[1].(int), args[2].(bool), args[3].(BatchOperation))
 	})
 	return _c
 }
 
-func (_c *MockProfile_UpdateProfile_Call) Return(profile *model.Profile, err error) *MockProfile_UpdateProfile_Call {
-	_c.Call.Return(profile, err)
+func (_c *MockBatch_Run_Call) Return(b bool, err error) *MockBatch_Run_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockProfile_UpdateProfile_Call) RunAndReturn(run func(ctx context.Context, id int64, req *model.ProfileRequest) (*model.Profile, error)) *MockProfile_UpdateProfile_Call {
+func (_c *MockBatch_Run_Call) RunAndReturn(run func(ctx context.Context, operations int, continueOnError bool, fn BatchOperation) (bool, error)) *MockBatch_Run_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -343,18 +1620,20 @@ func (_m *MockHealthcheck) EXPECT() *MockHealthcheck_Expecter {
 }
 
 // Execute provides a mock function for the type MockHealthcheck
-func (_mock *MockHealthcheck) Execute(ctx context.Context) error {
+func (_mock *MockHealthcheck) Execute(ctx context.Context) *health.Report {
 	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Execute")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+	var r0 *health.Report
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *health.Report); ok {
 		r0 = returnFunc(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*health.Report)
+		}
 	}
 	return r0
 }
@@ -377,12 +1656,97 @@ func (_c *MockHealthcheck_Execute_Call) Run(run func(ctx context.Context)) *Mock
 	return _c
 }
 
-func (_c *MockHealthcheck_Execute_Call) Return(err error) *MockHealthcheck_Execute_Call {
-	_c.Call.Return(err)
+func (_c *MockHealthcheck_Execute_Call) Return(report *health.Report) *MockHealthcheck_Execute_Call {
+	_c.Call.Return(report)
+	return _c
+}
+
+func (_c *MockHealthcheck_Execute_Call) RunAndReturn(run func(ctx context.Context) *health.Report) *MockHealthcheck_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockEvent creates a new instance of MockEvent. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEvent(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEvent {
+	mock := &MockEvent{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEvent is an autogenerated mock type for the Event type
+type MockEvent struct {
+	mock.Mock
+}
+
+type MockEvent_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEvent) EXPECT() *MockEvent_Expecter {
+	return &MockEvent_Expecter{mock: &_m.Mock}
+}
+
+// Stream provides a mock function for the type MockEvent
+func (_mock *MockEvent) Stream(ctx context.Context, resources []string, lastEventID *int64) (<-chan *model.Event, error) {
+	ret := _mock.Called(ctx, resources, lastEventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stream")
+	}
+
+	var r0 <-chan *model.Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, *int64) (<-chan *model.Event, error)); ok {
+		return returnFunc(ctx, resources, lastEventID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string, *int64) <-chan *model.Event); ok {
+		r0 = returnFunc(ctx, resources, lastEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *model.Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string, *int64) error); ok {
+		r1 = returnFunc(ctx, resources, lastEventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEvent_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type MockEvent_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - ctx
+//   - resources
+//   - lastEventID
+func (_e *MockEvent_Expecter) Stream(ctx interface{}, resources interface{}, lastEventID interface{}) *MockEvent_Stream_Call {
+	return &MockEvent_Stream_Call{Call: _e.mock.On("Stream", ctx, resources, lastEventID)}
+}
+
+func (_c *MockEvent_Stream_Call) Run(run func(ctx context.Context, resources []string, lastEventID *int64)) *MockEvent_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(*int64))
+	})
+	return _c
+}
+
+func (_c *MockEvent_Stream_Call) Return(eventCh <-chan *model.Event, err error) *MockEvent_Stream_Call {
+	_c.Call.Return(eventCh, err)
 	return _c
 }
 
-func (_c *MockHealthcheck_Execute_Call) RunAndReturn(run func(ctx context.Context) error) *MockHealthcheck_Execute_Call {
+func (_c *MockEvent_Stream_Call) RunAndReturn(run func(ctx context.Context, resources []string, lastEventID *int64) (<-chan *model.Event, error)) *MockEvent_Stream_Call {
 	_c.Call.Return(run)
 	return _c
 }
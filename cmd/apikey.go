@@ -0,0 +1,202 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository/uow"
+	"go.megpoid.dev/go-skel/app/usecase"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/pkg/request"
+	"go.megpoid.dev/go-skel/pkg/sql"
+	"go.megpoid.dev/go-skel/pkg/validator"
+)
+
+// apikeyCmd represents the apikey command
+var apikeyCmd = &cobra.Command{
+	Use:   "apikey",
+	Short: "Manage the API keys",
+	Long:  `Create, list, rotate and revoke the keys used on the X-API-Key header`,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		cobra.CheckErr(viper.BindPFlags(cmd.Flags()))
+	},
+}
+
+// apikeyCreateCmd represents the apikey create command
+var apikeyCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an API key",
+	Long:  `Create an API key, the key is only shown once`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		req := &model.APIKeyRequest{
+			Name:  viper.GetString("name"),
+			Owner: viper.GetString("owner"),
+		}
+		for _, scope := range strings.Split(viper.GetString("scopes"), ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				req.Scopes = append(req.Scopes, scope)
+			}
+		}
+		if expiresIn := viper.GetDuration("expires-in"); expiresIn > 0 {
+			expiresAt := time.Now().Add(expiresIn)
+			req.ExpiresAt = &expiresAt
+		}
+
+		if err := validator.NewCustomValidator().Validate(req); err != nil {
+			return err
+		}
+
+		apiKeyUsecase, closeFn, err := newAPIKeyUsecase()
+		if err != nil {
+			return err
+		}
+		defer closeFn()
+
+		apiKey, key, err := apiKeyUsecase.CreateKey(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		return printAPIKey(apiKey, key)
+	},
+}
+
+// apikeyListCmd represents the apikey list command
+var apikeyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the API keys",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		apiKeyUsecase, closeFn, err := newAPIKeyUsecase()
+		if err != nil {
+			return err
+		}
+		defer closeFn()
+
+		limit := viper.GetInt("limit")
+		query := &request.QueryParams{Pagination: request.Pagination{Limit: &limit}}
+		if owner := viper.GetString("owner"); owner != "" {
+			query.Filters = append(query.Filters, request.Filter{Field: "owner", Operation: "eq", Value: owner})
+		}
+
+		result, err := apiKeyUsecase.ListKeys(cmd.Context(), query)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "ID\tNAME\tOWNER\tPREFIX\tSCOPES\tEXPIRES\tLAST USED\tREVOKED")
+		for _, apiKey := range result.Items {
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", apiKey.ID, apiKey.Name, apiKey.Owner, apiKey.Prefix,
+				strings.Join(apiKey.Scopes, ","), formatTime(apiKey.ExpiresAt), formatTime(apiKey.LastUsedAt),
+				formatTime(apiKey.RevokedAt))
+		}
+
+		return w.Flush()
+	},
+}
+
+// apikeyRotateCmd represents the apikey rotate command
+var apikeyRotateCmd = &cobra.Command{
+	Use:   "rotate <id>",
+	Short: "Rotate an API key",
+	Long:  `Replace an API key keeping its settings, the previous key stops working and the new one is only shown once`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid id %q: %w", args[0], err)
+		}
+
+		apiKeyUsecase, closeFn, err := newAPIKeyUsecase()
+		if err != nil {
+			return err
+		}
+		defer closeFn()
+
+		apiKey, key, err := apiKeyUsecase.RotateKey(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		return printAPIKey(apiKey, key)
+	},
+}
+
+// apikeyRevokeCmd represents the apikey revoke command
+var apikeyRevokeCmd = &cobra.Command{
+	Use:   "revoke <id>",
+	Short: "Revoke an API key",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid id %q: %w", args[0], err)
+		}
+
+		apiKeyUsecase, closeFn, err := newAPIKeyUsecase()
+		if err != nil {
+			return err
+		}
+		defer closeFn()
+
+		return apiKeyUsecase.RevokeKey(cmd.Context(), id)
+	},
+}
+
+func newAPIKeyUsecase() (usecase.APIKey, func(), error) {
+	pool, err := newDatabasePool()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unitOfWork := uow.New(sql.NewContextExecutor(sql.NewPgxPool(pool)))
+
+	return usecase.NewAPIKey(unitOfWork), pool.Close, nil
+}
+
+func printAPIKey(apiKey *model.APIKey, key string) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(struct {
+		*model.APIKey
+		Key string `json:"key"`
+	}{apiKey, key})
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func init() {
+	rootCmd.AddCommand(apikeyCmd)
+	apikeyCmd.AddCommand(apikeyCreateCmd, apikeyListCmd, apikeyRotateCmd, apikeyRevokeCmd)
+
+	apikeyCmd.PersistentFlags().AddFlagSet(config.LoadDatabaseFlags(apikeyCmd.Name()))
+
+	apikeyCreateCmd.Flags().String("name", "", "Name of the key")
+	apikeyCreateCmd.Flags().String("owner", "", "Owner of the key")
+	apikeyCreateCmd.Flags().String("scopes", "", "Comma separated scopes granted to the key")
+	apikeyCreateCmd.Flags().Duration("expires-in", 0, "Time until the key expires, never if zero")
+
+	apikeyListCmd.Flags().String("owner", "", "Only list the keys of the owner")
+	apikeyListCmd.Flags().Int("limit", 100, "Maximum number of keys to list")
+}
@@ -0,0 +1,198 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/pkg/logger"
+	"go.megpoid.dev/go-skel/pkg/transfer"
+)
+
+// dataCmd represents the data command
+var dataCmd = &cobra.Command{
+	Use:   "data",
+	Short: "Export and import table data",
+	Long:  `Move the rows of a table between environments in CSV or NDJSON format`,
+}
+
+// dataExportCmd represents the data export command
+var dataExportCmd = &cobra.Command{
+	Use:   "export <table>",
+	Short: "Export the rows of a table",
+	Long:  `Stream the rows of a table in CSV or NDJSON format, optionally gzipped and filtered with the API syntax`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, _ []string) {
+		cobra.CheckErr(viper.BindPFlags(cmd.Flags()))
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filename := viper.GetString("file")
+		toStdout := filename == "" || filename == "-"
+
+		// the logs would be mixed with the exported rows
+		if !toStdout {
+			logger.InitLogger()
+		}
+
+		format, err := transfer.ParseFormat(viper.GetString("format"), filename)
+		if err != nil {
+			return err
+		}
+
+		pool, err := newDatabasePool()
+		if err != nil {
+			return err
+		}
+		defer pool.Close()
+
+		var output io.WriteCloser = nopWriteCloser{os.Stdout}
+		if !toStdout {
+			file, err := os.Create(filename)
+			if err != nil {
+				return fmt.Errorf("failed to create output file: %w", err)
+			}
+			output = file
+		}
+
+		w := output
+		if viper.GetBool("gzip") || strings.HasSuffix(filename, ".gz") {
+			w = gzip.NewWriter(output)
+		}
+
+		count, exportErr := transfer.Export(cmd.Context(), pool, w, transfer.ExportOptions{
+			Table:  args[0],
+			Format: format,
+			Where:  viper.GetString("where"),
+		})
+
+		// the gzip writer must be closed before the file
+		if w != output {
+			if err := w.Close(); err != nil && exportErr == nil {
+				exportErr = err
+			}
+		}
+		if err := output.Close(); err != nil && exportErr == nil {
+			exportErr = err
+		}
+		if exportErr != nil {
+			return exportErr
+		}
+
+		if !toStdout {
+			slog.Info("Exported rows", slog.String("table", args[0]), slog.Int64("count", count))
+		}
+
+		return nil
+	},
+}
+
+// dataImportCmd represents the data import command
+var dataImportCmd = &cobra.Command{
+	Use:   "import <table>",
+	Short: "Import rows into a table",
+	Long:  `Insert or upsert the rows of a CSV or NDJSON file, optionally gzipped, reporting the rejected rows`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, _ []string) {
+		cobra.CheckErr(viper.BindPFlags(cmd.Flags()))
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger.InitLogger()
+
+		filename := viper.GetString("file")
+
+		format, err := transfer.ParseFormat(viper.GetString("format"), filename)
+		if err != nil {
+			return err
+		}
+
+		input := os.Stdin
+		if filename != "" && filename != "-" {
+			input, err = os.Open(filename)
+			if err != nil {
+				return fmt.Errorf("failed to open input file: %w", err)
+			}
+			defer input.Close()
+		}
+
+		// gzipped input is detected from the content
+		r, err := transfer.NewReader(input)
+		if err != nil {
+			return fmt.Errorf("failed to read input: %w", err)
+		}
+		defer r.Close()
+
+		var rejects io.Writer
+		if rejectsFile := viper.GetString("rejects"); rejectsFile != "" {
+			file, err := os.Create(rejectsFile)
+			if err != nil {
+				return fmt.Errorf("failed to create rejects file: %w", err)
+			}
+			defer file.Close()
+			rejects = file
+		}
+
+		pool, err := newDatabasePool()
+		if err != nil {
+			return err
+		}
+		defer pool.Close()
+
+		var conflictTarget []string
+		if target := viper.GetString("on-conflict"); target != "" {
+			conflictTarget = strings.Split(target, ",")
+		}
+
+		result, err := transfer.Import(cmd.Context(), pool, r, transfer.ImportOptions{
+			Table:          args[0],
+			Format:         format,
+			ConflictTarget: conflictTarget,
+			Rejects:        rejects,
+			BatchSize:      viper.GetInt("batch-size"),
+		})
+		if err != nil {
+			return err
+		}
+
+		slog.Info("Imported rows",
+			slog.String("table", args[0]),
+			slog.Int64("imported", result.Imported),
+			slog.Int64("rejected", result.Rejected),
+		)
+
+		return nil
+	},
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func init() {
+	rootCmd.AddCommand(dataCmd)
+	dataCmd.AddCommand(dataExportCmd, dataImportCmd)
+
+	dataCmd.PersistentFlags().AddFlagSet(config.LoadDatabaseFlags(dataCmd.Name()))
+
+	dataExportCmd.Flags().StringP("file", "f", "", "Output file, stdout if empty or -")
+	dataExportCmd.Flags().String("format", "", "Output format, csv or ndjson (default from the file extension, or csv)")
+	dataExportCmd.Flags().String("where", "", "Filter using the API syntax, e.g. 'status__eq=active&age__gte=18'")
+	dataExportCmd.Flags().Bool("gzip", false, "Compress the output, enabled if the file ends with .gz")
+
+	dataImportCmd.Flags().StringP("file", "f", "", "Input file, stdin if empty or -")
+	dataImportCmd.Flags().String("format", "", "Input format, csv or ndjson (default from the file extension, or csv)")
+	dataImportCmd.Flags().String("on-conflict", "", "Comma separated columns of the unique constraint used to update the existing rows")
+	dataImportCmd.Flags().String("rejects", "", "File where the rejected rows are written as JSON lines")
+	dataImportCmd.Flags().Int("batch-size", transfer.DefaultBatchSize, "Rows copied to the database at once")
+}
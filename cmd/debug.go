@@ -0,0 +1,126 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.megpoid.dev/go-skel/app/controller"
+	"go.megpoid.dev/go-skel/oapi"
+)
+
+const (
+	DefaultDebugURL     = "http://localhost:8000"
+	DefaultDebugTimeout = 10 * time.Second
+	debugStatementWidth = 80
+)
+
+// debugCmd represents the debug command
+var debugCmd = &cobra.Command{
+	Use:   "debug",
+	Short: "Inspect a running service",
+	Long:  `Inspect the internal state of a running service using the admin endpoints`,
+}
+
+// debugQueriesCmd represents the debug queries command
+var debugQueriesCmd = &cobra.Command{
+	Use:   "queries",
+	Short: "Show the query statistics",
+	Long:  `Show the statistics of the database queries executed by a running service`,
+	PreRun: func(cmd *cobra.Command, _ []string) {
+		cobra.CheckErr(viper.BindPFlags(cmd.Flags()))
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(cmd.Context(), DefaultDebugTimeout)
+		defer cancel()
+
+		method := http.MethodGet
+		if viper.GetBool("reset") {
+			method = http.MethodDelete
+		}
+
+		endpoint, err := url.JoinPath(viper.GetString("url"), controller.BaseURL(), "admin", "queries")
+		if err != nil {
+			return fmt.Errorf("invalid url: %w", err)
+		}
+		if limit := viper.GetInt("limit"); limit > 0 && method == http.MethodGet {
+			endpoint += "?limit=" + strconv.Itoa(limit)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		if token := viper.GetString("token"); token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if key := viper.GetString("api-key"); key != "" {
+			req.Header.Set("X-API-Key", key)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to fetch query statistics: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("failed to fetch query statistics: %s", resp.Status)
+		}
+
+		if method == http.MethodDelete {
+			fmt.Println("Query statistics removed")
+			return nil
+		}
+
+		var stats oapi.QueryStatList
+		if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+			return fmt.Errorf("failed to decode query statistics: %w", err)
+		}
+
+		printQueryStats(&stats)
+
+		return nil
+	},
+}
+
+func printQueryStats(stats *oapi.QueryStatList) {
+	fmt.Printf("Query statistics since %s\n\n", stats.Since.Format(time.RFC3339))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	_, _ = fmt.Fprintln(w, "CALLS\tERRORS\tROWS\tTOTAL (ms)\tMEAN (ms)\tP95 (ms)\tMAX (ms)\t\tSTATEMENT")
+	for _, stat := range stats.Items {
+		statement := stat.Statement
+		if !viper.GetBool("full") && len(statement) > debugStatementWidth {
+			statement = statement[:debugStatementWidth-3] + "..."
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%d\t%d\t%.2f\t%.2f\t%.2f\t%.2f\t\t%s\n", stat.Calls, stat.Errors, stat.Rows,
+			stat.TotalMs, stat.MeanMs, stat.P95Ms, stat.MaxMs, strings.ReplaceAll(statement, "\t", " "))
+	}
+	_ = w.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(debugCmd)
+	debugCmd.AddCommand(debugQueriesCmd)
+
+	debugQueriesCmd.Flags().String("url", DefaultDebugURL, "Address of the running service")
+	debugQueriesCmd.Flags().String("token", "", "Bearer token used to access the admin endpoints")
+	debugQueriesCmd.Flags().String("api-key", "", "API key used to access the admin endpoints")
+	debugQueriesCmd.Flags().IntP("limit", "n", 20, "Max statements to show")
+	debugQueriesCmd.Flags().Bool("full", false, "Do not truncate the statements")
+	debugQueriesCmd.Flags().Bool("reset", false, "Remove the collected statistics instead of showing them")
+}
@@ -11,7 +11,10 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"text/tabwriter"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.megpoid.dev/go-skel/config"
@@ -34,99 +37,275 @@ var migrateCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		logger.InitLogger()
 
-		databaseSettings := config.DatabaseSettings{}
-		if err := cfg.ReadConfig(&databaseSettings); err != nil {
-			return fmt.Errorf("failed to read database settings: %w", err)
-		}
-
 		migrationSettings := config.MigrationSettings{}
 		if err := cfg.ReadConfig(&migrationSettings); err != nil {
 			return fmt.Errorf("failed to read migration settings: %w", err)
 		}
 
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
+		return runMigrations(migrationSettings)
+	},
+}
+
+// migrateUpCmd represents the migrate up command
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply pending migrations",
+	Long:  `Apply the pending migrations, optionally up to a target migration`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, _ []string) {
+		cobra.CheckErr(viper.BindPFlags(cmd.Flags()))
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger.InitLogger()
+
+		return runMigrations(config.MigrationSettings{
+			Step:   viper.GetInt("step"),
+			To:     viper.GetString("to"),
+			DryRun: viper.GetBool("dry-run"),
+		})
+	},
+}
 
-		quit := make(chan os.Signal, 1)
+// migrateDownCmd represents the migrate down command
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Revert applied migrations",
+	Long:  `Revert the last applied migrations, or all the migrations applied after a target migration`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, _ []string) {
+		cobra.CheckErr(viper.BindPFlags(cmd.Flags()))
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger.InitLogger()
 
-		// Database initialization
-		pool, err := sql.NewConnection(sql.Config{
-			DataSourceName:  databaseSettings.DataSourceName,
-			MaxIdleConns:    databaseSettings.MaxIdleConns,
-			MaxOpenConns:    databaseSettings.MaxOpenConns,
-			ConnMaxLifetime: databaseSettings.ConnMaxLifetime,
-			ConnMaxIdleTime: databaseSettings.ConnMaxIdleTime,
+		return runMigrations(config.MigrationSettings{
+			Rollback: true,
+			Step:     viper.GetInt("step"),
+			To:       viper.GetString("to"),
+			DryRun:   viper.GetBool("dry-run"),
 		})
+	},
+}
+
+// migrateStatusCmd represents the migrate status command
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the migration status",
+	Long:  `List the applied and pending migrations`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, _ []string) {
+		cobra.CheckErr(viper.BindPFlags(cmd.Flags()))
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger.InitLogger()
+
+		pool, err := newDatabasePool()
 		if err != nil {
 			return err
 		}
 		defer pool.Close()
 
-		var migrationErr error
-
-		migrationConfig := migration.Options{
-			TableName: "app_migrations",
-			Redo:      migrationSettings.Redo,
-			Reset:     migrationSettings.Reset,
-			Rollback:  migrationSettings.Rollback,
-			Step:      migrationSettings.Step,
-			MigrationAsset: migration.AssetOptions{
-				FS:   db.Assets(),
-				Root: "migrations",
-			},
+		statuses, err := migration.Statuses(cmd.Context(), pool, migration.DefaultTableName, migrationAssets())
+		if err != nil {
+			return fmt.Errorf("failed to read migration status: %w", err)
 		}
 
-		go func() {
-			defer func() {
-				quit <- os.Interrupt
-			}()
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "ID\tKIND\tSTATUS\tAPPLIED AT")
 
-			migrationErr = migration.RunMigrations(ctx, pool, migrationConfig)
-			if migrationErr != nil {
-				slog.Error("migration failed", "error", migrationErr)
-				return
+		pending := 0
+		for _, status := range statuses {
+			kind := "sql"
+			if status.Go {
+				kind = "go"
+			}
+			state := "pending"
+			appliedAt := "-"
+			switch {
+			case status.Unknown:
+				kind = "-"
+				state = "unknown"
+				appliedAt = status.AppliedAt.Local().Format(time.DateTime)
+			case status.Applied:
+				state = "applied"
+				appliedAt = status.AppliedAt.Local().Format(time.DateTime)
+			default:
+				pending++
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", status.ID, kind, state, appliedAt)
+		}
+
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		fmt.Printf("\n%d migrations, %d pending\n", len(statuses), pending)
+
+		return nil
+	},
+}
+
+// migrateVerifyCmd represents the migrate verify command
+var migrateVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Detect schema drift",
+	Long:  `Apply the migrations to a scratch schema and compare it with the live one, failing if they differ`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, _ []string) {
+		cobra.CheckErr(viper.BindPFlags(cmd.Flags()))
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger.InitLogger()
+
+		pool, err := newDatabasePool()
+		if err != nil {
+			return err
+		}
+		defer pool.Close()
+
+		drifts, err := migration.Verify(cmd.Context(), pool, migration.VerifyOptions{
+			Schema:         viper.GetString("schema"),
+			TableName:      migration.DefaultTableName,
+			MigrationAsset: migrationAssets(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to verify schema: %w", err)
+		}
+
+		if len(drifts) == 0 {
+			fmt.Println("The schema matches the migrations")
+			return nil
+		}
+
+		for _, drift := range drifts {
+			fmt.Println(drift)
+		}
+
+		return fmt.Errorf("schema drift detected: %d differences", len(drifts))
+	},
+}
+
+func migrationAssets() migration.AssetOptions {
+	return migration.AssetOptions{
+		FS:           db.Assets(),
+		Root:         "migrations",
+		GoMigrations: db.GoMigrations(),
+	}
+}
+
+func newDatabasePool() (*pgxpool.Pool, error) {
+	databaseSettings := config.DatabaseSettings{}
+	if err := cfg.ReadConfig(&databaseSettings); err != nil {
+		return nil, fmt.Errorf("failed to read database settings: %w", err)
+	}
+
+	return sql.NewConnection(sql.Config{
+		DataSourceName:  databaseSettings.DataSourceName,
+		MaxIdleConns:    databaseSettings.MaxIdleConns,
+		MaxOpenConns:    databaseSettings.MaxOpenConns,
+		ConnMaxLifetime: databaseSettings.ConnMaxLifetime,
+		ConnMaxIdleTime: databaseSettings.ConnMaxIdleTime,
+	})
+}
+
+func runMigrations(migrationSettings config.MigrationSettings) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	quit := make(chan os.Signal, 1)
+
+	// Database initialization
+	pool, err := newDatabasePool()
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	var migrationErr error
+
+	migrationConfig := migration.Options{
+		TableName:      migration.DefaultTableName,
+		Redo:           migrationSettings.Redo,
+		Reset:          migrationSettings.Reset,
+		Rollback:       migrationSettings.Rollback,
+		Step:           migrationSettings.Step,
+		Target:         migrationSettings.To,
+		DryRun:         migrationSettings.DryRun,
+		MigrationAsset: migrationAssets(),
+	}
+
+	go func() {
+		defer func() {
+			quit <- os.Interrupt
+		}()
+
+		migrationErr = migration.RunMigrations(ctx, pool, migrationConfig)
+		if migrationErr != nil {
+			slog.Error("migration failed", "error", migrationErr)
+			return
+		}
+
+		if migrationSettings.DryRun {
+			if migrationSettings.Seed || migrationSettings.Test {
+				slog.Warn("Skipping the seed and test data on a dry run")
 			}
+			return
+		}
 
-			if migrationSettings.Seed {
-				seedAssets := migration.AssetOptions{
+		if migrationSettings.Seed {
+			_, migrationErr = migration.RunSeeds(ctx, pool, migration.SeedOptions{
+				TableName:   migration.DefaultSeedTableName,
+				Environment: migrationSettings.Env,
+				SeedAsset: migration.AssetOptions{
 					FS:   db.Seeds(),
 					Root: "seed",
-				}
-				migrationErr = migration.ApplySQLFiles(ctx, sql.NewPgxPool(pool), seedAssets)
-				if migrationErr != nil {
-					slog.Error("migration failed", "error", migrationErr)
-					return
-				}
+				},
+			})
+			if migrationErr != nil {
+				slog.Error("migration failed", "error", migrationErr)
+				return
 			}
+		}
 
-			if migrationSettings.Test {
-				testAssets := migration.AssetOptions{
-					FS:   testdata.SqlAssets(),
-					Root: "sql",
-				}
-				migrationErr = migration.ApplySQLFiles(ctx, sql.NewPgxPool(pool), testAssets)
-				if migrationErr != nil {
-					slog.Error("migration failed", "error", migrationErr)
-					return
-				}
+		if migrationSettings.Test {
+			testAssets := migration.AssetOptions{
+				FS:   testdata.SqlAssets(),
+				Root: "sql",
 			}
-		}()
+			migrationErr = migration.ApplySQLFiles(ctx, sql.NewPgxPool(pool), testAssets)
+			if migrationErr != nil {
+				slog.Error("migration failed", "error", migrationErr)
+				return
+			}
+		}
+	}()
 
-		signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
-		<-quit
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
 
-		cancel()
+	cancel()
 
-		return migrationErr
-	},
+	return migrationErr
 }
 
 func init() {
 	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd, migrateVerifyCmd)
 
 	databaseFlags := config.LoadDatabaseFlags(migrateCmd.Name())
 	migrateFlags := config.LoadMigrateFlags(migrateCmd.Name())
 
-	migrateCmd.Flags().AddFlagSet(databaseFlags)
+	// shared with the subcommands
+	migrateCmd.PersistentFlags().AddFlagSet(databaseFlags)
 	migrateCmd.Flags().AddFlagSet(migrateFlags)
+
+	migrateUpCmd.Flags().String("to", "", "ID or version of the last migration to apply")
+	migrateUpCmd.Flags().Int("step", 0, "Max migrations to apply (0 for all)")
+	migrateUpCmd.Flags().Bool("dry-run", false, "Print the SQL of the planned migrations without running them")
+
+	migrateDownCmd.Flags().String("to", "", "ID or version of the migration to roll back to, it isn't reverted")
+	migrateDownCmd.Flags().Int("step", 1, "Max migrations to revert, ignored if --to is set")
+	migrateDownCmd.Flags().Bool("dry-run", false, "Print the SQL of the planned migrations without running them")
+
+	migrateVerifyCmd.Flags().String("schema", migration.DefaultSchema, "Live schema to compare with the migrations")
 }
@@ -30,6 +30,31 @@ var templateContent = `-- +migrate Up
 -- Migrate Down
 `
 
+var goTemplateContent = `// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package db
+
+import (
+	"context"
+
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+func init() {
+	RegisterMigration("%[1]s", up%[2]s, down%[2]s)
+}
+
+func up%[2]s(ctx context.Context, tx sql.Tx) error {
+	return nil
+}
+
+func down%[2]s(ctx context.Context, tx sql.Tx) error {
+	return nil
+}
+`
+
 func toSnakeCase(str string) string {
 	snake := matchFirstCap.ReplaceAllString(str, "${1}_${2}")
 	snake = matchAllCap.ReplaceAllString(snake, "${1}_${2}")
@@ -49,6 +74,16 @@ var migrationCmd = &cobra.Command{
 		timestamp := time.Now().Format("20060102150405")
 		name := toSnakeCase(args[0])
 
+		if viper.GetBool("go") {
+			id := timestamp + "_" + name
+			filename := path.Join("db", id+".go")
+			err := os.WriteFile(filename, []byte(fmt.Sprintf(goTemplateContent, id, timestamp)), 0o644)
+			if err != nil {
+				return fmt.Errorf("failed to create migration file: %w", err)
+			}
+			return nil
+		}
+
 		var baseDir string
 		if viper.GetBool("seed") {
 			baseDir = "db/seed"
@@ -69,4 +104,5 @@ func init() {
 	rootCmd.AddCommand(migrationCmd)
 
 	migrationCmd.Flags().Bool("seed", false, "Create a seed file")
+	migrationCmd.Flags().Bool("go", false, "Create a Go migration, for changes that can't be done in SQL")
 }
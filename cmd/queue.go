@@ -7,15 +7,22 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"log/slog"
+	"net/http"
 
 	"github.com/hibiken/asynq"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.megpoid.dev/go-skel/app"
+	"go.megpoid.dev/go-skel/app/repository/uow"
 	"go.megpoid.dev/go-skel/app/tasks"
 	"go.megpoid.dev/go-skel/app/usecase"
 	"go.megpoid.dev/go-skel/config"
 	"go.megpoid.dev/go-skel/pkg/cfg"
 	"go.megpoid.dev/go-skel/pkg/logger"
+	"go.megpoid.dev/go-skel/pkg/metrics"
+	"go.megpoid.dev/go-skel/pkg/sql"
+	"go.megpoid.dev/go-skel/pkg/task"
 )
 
 // migrateCmd represents the migrate command
@@ -35,15 +42,68 @@ var queueCmd = &cobra.Command{
 			return fmt.Errorf("failed to read config: %w", err)
 		}
 
+		telemetrySettings := config.TelemetrySettings{}
+		if err := cfg.ReadConfig(&telemetrySettings); err != nil {
+			return fmt.Errorf("failed to read telemetry config: %w", err)
+		}
+
+		storageSettings := config.StorageSettings{}
+		if err := cfg.ReadConfig(&storageSettings); err != nil {
+			return fmt.Errorf("failed to read storage config: %w", err)
+		}
+
+		shutdownTelemetry, err := setupTelemetry(telemetrySettings)
+		if err != nil {
+			return err
+		}
+		defer shutdownTelemetry()
+
 		queue := asynq.NewServer(
 			asynq.RedisClientOpt{Addr: generalSettings.RedisAddr},
 			asynq.Config{Concurrency: generalSettings.Workers},
 		)
 
+		pool, err := newDatabasePool()
+		if err != nil {
+			return err
+		}
+		defer pool.Close()
+
+		storage, err := app.NewStorage(storageSettings)
+		if err != nil {
+			return fmt.Errorf("failed to create storage: %w", err)
+		}
+
+		unitOfWork := uow.New(sql.NewPgxPool(pool))
+
 		backgroundUsecase := usecase.NewDelay()
+		profileImportUsecase := usecase.NewProfileImport(unitOfWork, storage)
 
 		mux := asynq.NewServeMux()
+		mux.Use(task.TracingMiddleware())
+
+		if telemetrySettings.Metrics {
+			registry := metrics.NewRegistry()
+			mux.Use(metrics.TaskMiddleware(registry))
+
+			listen := telemetrySettings.MetricsListen
+			if listen == "" {
+				listen = config.DefaultWorkerMetrics
+			}
+
+			metricsServer := metrics.NewServer(listen, registry)
+			defer metricsServer.Close()
+
+			go func() {
+				slog.Info("Starting metrics server", "address", listen)
+				if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					slog.Error("Error starting metrics server", slog.String("error", err.Error()))
+				}
+			}()
+		}
+
 		mux.Handle(tasks.TypeDelay, tasks.NewDelayProcessor(backgroundUsecase))
+		mux.Handle(tasks.TypeProfileImport, tasks.NewProfileImportProcessor(profileImportUsecase))
 
 		if err := queue.Run(mux); err != nil {
 			if !errors.Is(err, asynq.ErrServerClosed) {
@@ -60,7 +120,11 @@ func init() {
 
 	databaseFlags := config.LoadDatabaseFlags(queueCmd.Name())
 	generalFlags := config.LoadGeneralFlags(queueCmd.Name())
+	telemetryFlags := config.LoadTelemetryFlags(queueCmd.Name())
+	storageFlags := config.LoadStorageFlags(queueCmd.Name())
 
 	queueCmd.Flags().AddFlagSet(databaseFlags)
 	queueCmd.Flags().AddFlagSet(generalFlags)
+	queueCmd.Flags().AddFlagSet(telemetryFlags)
+	queueCmd.Flags().AddFlagSet(storageFlags)
 }
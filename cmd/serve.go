@@ -53,6 +53,20 @@ func runServer() error {
 		return fmt.Errorf("failed to read oidc config: %w", err)
 	}
 
+	if err := cfg.ReadConfig(&appConfig.Telemetry); err != nil {
+		return fmt.Errorf("failed to read telemetry config: %w", err)
+	}
+
+	if err := cfg.ReadConfig(&appConfig.Storage); err != nil {
+		return fmt.Errorf("failed to read storage config: %w", err)
+	}
+
+	shutdownTelemetry, err := setupTelemetry(appConfig.Telemetry)
+	if err != nil {
+		return err
+	}
+	defer shutdownTelemetry()
+
 	// setup channel to check when app is stopped
 	quit := make(chan os.Signal, 1)
 
@@ -82,9 +96,13 @@ func init() {
 	serverFs := config.LoadServerFlags(serveCmd.Name())
 	databaseFs := config.LoadDatabaseFlags(serveCmd.Name())
 	oidcFs := config.LoadOIDCFlags(serveCmd.Name())
+	telemetryFs := config.LoadTelemetryFlags(serveCmd.Name())
+	storageFs := config.LoadStorageFlags(serveCmd.Name())
 
 	serveCmd.Flags().AddFlagSet(generalFs)
 	serveCmd.Flags().AddFlagSet(serverFs)
 	serveCmd.Flags().AddFlagSet(databaseFs)
 	serveCmd.Flags().AddFlagSet(oidcFs)
+	serveCmd.Flags().AddFlagSet(telemetryFs)
+	serveCmd.Flags().AddFlagSet(storageFs)
 }
@@ -0,0 +1,41 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/pkg/telemetry"
+	"go.megpoid.dev/go-skel/version"
+)
+
+const telemetryShutdownTimeout = 5 * time.Second
+
+// setupTelemetry configures the trace exporter, the returned function flushes the pending spans.
+func setupTelemetry(settings config.TelemetrySettings) (func(), error) {
+	shutdown, err := telemetry.Setup(context.Background(), telemetry.Config{
+		ServiceName:    settings.ServiceName,
+		ServiceVersion: version.Tag,
+		Exporter:       settings.TraceExporter,
+		Endpoint:       settings.TraceEndpoint,
+		SampleRatio:    settings.TraceSampleRatio,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup telemetry: %w", err)
+	}
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), telemetryShutdownTimeout)
+		defer cancel()
+
+		if err := shutdown(ctx); err != nil {
+			slog.Error("Failed to flush traces", slog.String("error", err.Error()))
+		}
+	}, nil
+}
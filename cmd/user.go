@@ -0,0 +1,83 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.megpoid.dev/go-skel/app/model"
+	"go.megpoid.dev/go-skel/app/repository/uow"
+	"go.megpoid.dev/go-skel/app/usecase"
+	"go.megpoid.dev/go-skel/config"
+	"go.megpoid.dev/go-skel/pkg/sql"
+	"go.megpoid.dev/go-skel/pkg/validator"
+)
+
+// userCmd represents the user command
+var userCmd = &cobra.Command{
+	Use:   "user",
+	Short: "Manage the users",
+	Long:  `Manage the users that log in with a password`,
+}
+
+// userCreateCmd represents the user create command
+var userCreateCmd = &cobra.Command{
+	Use:   "create <username>",
+	Short: "Create a user",
+	Long:  `Create a user, the password is read from the standard input if the flag is empty`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, _ []string) {
+		cobra.CheckErr(viper.BindPFlags(cmd.Flags()))
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		password := viper.GetString("password")
+		if password == "" {
+			line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+			if err != nil && line == "" {
+				return errors.New("a password is required")
+			}
+			password = strings.TrimRight(line, "\r\n")
+		}
+
+		req := &model.UserRequest{Username: args[0], Password: password, Scopes: viper.GetStringSlice("scopes")}
+		if err := validator.NewCustomValidator().Validate(req); err != nil {
+			return err
+		}
+
+		pool, err := newDatabasePool()
+		if err != nil {
+			return err
+		}
+		defer pool.Close()
+
+		unitOfWork := uow.New(sql.NewContextExecutor(sql.NewPgxPool(pool)))
+		authUsecase := usecase.NewAuth(unitOfWork, usecase.AuthSettings{})
+
+		user, err := authUsecase.Register(cmd.Context(), req, "")
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Created user %s with ID %d\n", user.Username, user.ID)
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(userCmd)
+	userCmd.AddCommand(userCreateCmd)
+
+	userCmd.PersistentFlags().AddFlagSet(config.LoadDatabaseFlags(userCmd.Name()))
+
+	userCreateCmd.Flags().String("password", "", "Password of the user, read from the standard input if empty")
+	userCreateCmd.Flags().StringSlice("scopes", nil, "Scopes granted to the access tokens of the user, like admin")
+}
@@ -18,6 +18,7 @@ const (
 	DefaultConnMaxLifetime = 1 * time.Hour
 	DefaultConnMaxIdleTime = 5 * time.Minute
 	DefaultQueryLimit      = 1000
+	DefaultSlowQuery       = 200 * time.Millisecond
 )
 
 type DatabaseSettings struct {
@@ -27,6 +28,7 @@ type DatabaseSettings struct {
 	ConnMaxLifetime time.Duration `mapstructure:"conn-max-lifetime"`
 	ConnMaxIdleTime time.Duration `mapstructure:"conn-max-idle-time"`
 	QueryLimit      uint          `mapstructure:"query-limit"`
+	SlowQuery       time.Duration `mapstructure:"slow-query"`
 }
 
 func (cfg *DatabaseSettings) SetDefaults() {
@@ -48,6 +50,9 @@ func (cfg *DatabaseSettings) SetDefaults() {
 	if cfg.QueryLimit == 0 {
 		cfg.QueryLimit = DefaultQueryLimit
 	}
+	if cfg.SlowQuery == 0 {
+		cfg.SlowQuery = DefaultSlowQuery
+	}
 }
 
 func (cfg *DatabaseSettings) Validate() error {
@@ -63,6 +68,7 @@ func LoadDatabaseFlags(name string) *pflag.FlagSet {
 	fs.Duration("conn-max-lifetime", DefaultConnMaxLifetime, "Max lifetime of the connection")
 	fs.Duration("conn-max-idle-time", DefaultConnMaxIdleTime, "Max idle time of the connection")
 	fs.Int("query-limit", DefaultQueryLimit, "Max results per query")
+	fs.Duration("slow-query", DefaultSlowQuery, "Log queries slower than this duration (negative to disable)")
 
 	return fs
 }
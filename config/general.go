@@ -6,6 +6,7 @@ package config
 
 import (
 	"errors"
+	"time"
 
 	"github.com/spf13/pflag"
 )
@@ -13,15 +14,19 @@ import (
 const (
 	DefaultWorkers   = 5
 	DefaultRedisAddr = "127.0.0.1:6379"
+	// DefaultMigrationTimeout covers the wait for the migration lock held by another instance
+	DefaultMigrationTimeout = 5 * time.Minute
 )
 
 type GeneralSettings struct {
-	Debug         bool   `mapstructure:"debug"`
-	LogFormat     string `mapstructure:"log-format"`
-	RunMigrations bool   `mapstructure:"run-migrations"`
-	EncryptionKey []byte `mapstructure:"encryption-key"`
-	RedisAddr     string `mapstructure:"redis-addr"`
-	Workers       int    `mapstructure:"workers"`
+	Debug            bool          `mapstructure:"debug"`
+	LogFormat        string        `mapstructure:"log-format"`
+	RunMigrations    bool          `mapstructure:"run-migrations"`
+	StrictMigrations bool          `mapstructure:"strict-migrations"`
+	MigrationTimeout time.Duration `mapstructure:"migration-timeout"`
+	EncryptionKey    []byte        `mapstructure:"encryption-key"`
+	RedisAddr        string        `mapstructure:"redis-addr"`
+	Workers          int           `mapstructure:"workers"`
 }
 
 func (cfg *GeneralSettings) Validate() error {
@@ -40,6 +45,9 @@ func (cfg *GeneralSettings) SetDefaults() {
 	if cfg.RedisAddr == "" {
 		cfg.RedisAddr = DefaultRedisAddr
 	}
+	if cfg.MigrationTimeout <= 0 {
+		cfg.MigrationTimeout = DefaultMigrationTimeout
+	}
 }
 
 func LoadGeneralFlags(name string) *pflag.FlagSet {
@@ -47,6 +55,9 @@ func LoadGeneralFlags(name string) *pflag.FlagSet {
 	fs.String("encryption-key", "", "Application encryption key")
 	fs.Int("workers", DefaultWorkers, "Workers")
 	fs.String("redis-addr", DefaultRedisAddr, "Redis address")
+	fs.Bool("run-migrations", false, "Apply the pending migrations on start")
+	fs.Bool("strict-migrations", false, "Refuse to start if there are pending migrations, instead of only warning")
+	fs.Duration("migration-timeout", DefaultMigrationTimeout, "Maximum time to apply or check the migrations on start")
 
 	return fs
 }
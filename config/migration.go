@@ -5,9 +5,13 @@
 package config
 
 import (
+	"errors"
+
 	"github.com/spf13/pflag"
 )
 
+const DefaultSeedEnvironment = "dev"
+
 type MigrationSettings struct {
 	Redo     bool
 	Reset    bool
@@ -15,15 +19,25 @@ type MigrationSettings struct {
 	Seed     bool
 	Step     int
 	Test     bool
+	To       string
+	DryRun   bool   `mapstructure:"dry-run"`
+	Env      string `mapstructure:"env"`
 }
 
 func (cfg *MigrationSettings) SetDefaults() {
+	if cfg.Env == "" {
+		cfg.Env = DefaultSeedEnvironment
+	}
 	if cfg.Step == 0 && (cfg.Rollback || cfg.Redo) {
 		cfg.Step = 1
 	}
 }
 
 func (cfg *MigrationSettings) Validate() error {
+	if cfg.To != "" && (cfg.Redo || cfg.Reset) {
+		return errors.New("MigrationSettings: target migration can't be used with redo or reset")
+	}
+
 	return nil
 }
 
@@ -32,9 +46,12 @@ func LoadMigrateFlags(name string) *pflag.FlagSet {
 	fs.Bool("redo", false, "Rollback last migration then migrate again")
 	fs.Bool("reset", false, "Drop all tables and run migration")
 	fs.Bool("rollback", false, "Rollback last migration")
-	fs.Bool("seed", false, "Seed the database")
+	fs.Bool("seed", false, "Seed the database, each seed is only applied once")
+	fs.String("env", DefaultSeedEnvironment, "Environment of the seeds to apply (dev, staging, test)")
 	fs.Int("step", 1, "Steps to rollback/redo")
 	fs.Bool("test", false, "Load test data")
+	fs.String("to", "", "Migrate up to this migration ID or version, or down to it with --rollback")
+	fs.Bool("dry-run", false, "Print the SQL of the planned migrations without running them")
 
 	return fs
 }
@@ -6,6 +6,8 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"net"
 	"time"
 
 	"github.com/spf13/pflag"
@@ -17,6 +19,22 @@ const (
 	DefaultWriteTimeout  = 1 * time.Minute
 	DefaultIdleTimeout   = 1 * time.Minute
 	DefaultBodyLimit     = "10MB"
+	// DefaultIdempotencyTTL is how long the responses are replayed for a repeated Idempotency-Key
+	DefaultIdempotencyTTL = 24 * time.Hour
+	// DefaultRateLimit is the number of requests allowed to every principal in the period
+	DefaultRateLimit = "300/1m"
+	// DefaultRateLimitIP is the number of requests allowed to every client IP, including the unauthenticated ones
+	DefaultRateLimitIP    = "1200/1m"
+	DefaultRateLimitStore = "redis"
+	// DefaultLoginMaxAttempts is the number of consecutive failed logins that lock the account
+	DefaultLoginMaxAttempts = 5
+	DefaultLoginLockout     = 15 * time.Minute
+	// DefaultAccessTokenTTL is short since the access tokens are only revoked by the logout
+	DefaultAccessTokenTTL  = 15 * time.Minute
+	DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+	// DefaultJwtAlgorithm signs the tokens with the jwt secret, the other algorithms use keys that are rotated
+	DefaultJwtAlgorithm   = "HS256"
+	DefaultJwtKeyRotation = 30 * 24 * time.Hour
 )
 
 type ServerSettings struct {
@@ -27,7 +45,20 @@ type ServerSettings struct {
 	IdleTimeout      time.Duration `mapstructure:"idle-timeout"`
 	BodyLimit        string        `mapstore:"body-limit"`
 	CorsAllowOrigins []string      `mapstructure:"cors-allow-origin"`
+	TrustedProxies   []string      `mapstructure:"trusted-proxies"`
 	JwtSecret        []byte        `mapstructure:"jwt-secret"`
+	JwtAlgorithm     string        `mapstructure:"jwt-algorithm"`
+	JwtKeyRotation   time.Duration `mapstructure:"jwt-key-rotation"`
+	ShutdownDelay    time.Duration `mapstructure:"shutdown-delay"`
+	IdempotencyTTL   time.Duration `mapstructure:"idempotency-ttl"`
+	RateLimit        string        `mapstructure:"rate-limit"`
+	RateLimitIP      string        `mapstructure:"rate-limit-ip"`
+	RateLimitRoutes  []string      `mapstructure:"rate-limit-routes"`
+	RateLimitStore   string        `mapstructure:"rate-limit-store"`
+	LoginMaxAttempts int           `mapstructure:"login-max-attempts"`
+	LoginLockout     time.Duration `mapstructure:"login-lockout"`
+	AccessTokenTTL   time.Duration `mapstructure:"access-token-ttl"`
+	RefreshTokenTTL  time.Duration `mapstructure:"refresh-token-ttl"`
 }
 
 func (cfg *ServerSettings) SetDefaults() {
@@ -60,14 +91,74 @@ func (cfg *ServerSettings) SetDefaults() {
 		cfg.BodyLimit = DefaultBodyLimit
 	}
 
+	if cfg.IdempotencyTTL == 0 {
+		cfg.IdempotencyTTL = DefaultIdempotencyTTL
+	}
+
+	if cfg.RateLimit == "" {
+		cfg.RateLimit = DefaultRateLimit
+	}
+	if cfg.RateLimitIP == "" {
+		cfg.RateLimitIP = DefaultRateLimitIP
+	}
+	if cfg.RateLimitStore == "" {
+		cfg.RateLimitStore = DefaultRateLimitStore
+	}
+
+	if cfg.LoginLockout == 0 {
+		cfg.LoginLockout = DefaultLoginLockout
+	}
+	if cfg.JwtAlgorithm == "" {
+		cfg.JwtAlgorithm = DefaultJwtAlgorithm
+	}
+	if cfg.JwtKeyRotation == 0 {
+		cfg.JwtKeyRotation = DefaultJwtKeyRotation
+	}
+	if cfg.AccessTokenTTL == 0 {
+		cfg.AccessTokenTTL = DefaultAccessTokenTTL
+	}
+	if cfg.RefreshTokenTTL == 0 {
+		cfg.RefreshTokenTTL = DefaultRefreshTokenTTL
+	}
+
 	if len(cfg.CorsAllowOrigins) == 0 {
 		cfg.CorsAllowOrigins = append(cfg.CorsAllowOrigins, "*")
 	}
 }
 
 func (cfg *ServerSettings) Validate() error {
-	if len(cfg.JwtSecret) > 0 && len(cfg.JwtSecret) < 32 {
-		return errors.New("GeneralSettings: jwt secret must have at least 32 bytes")
+	switch cfg.JwtAlgorithm {
+	case "HS256":
+		// the secret signs the tokens
+		if len(cfg.JwtSecret) < 32 {
+			return errors.New("ServerSettings: jwt secret must have at least 32 bytes with HS256")
+		}
+	case "RS256", "ES256", "EdDSA":
+		// the private keys are stored encrypted with the secret
+		if len(cfg.JwtSecret) == 0 {
+			return errors.New("ServerSettings: jwt secret is required to store the signing keys")
+		}
+		if cfg.JwtKeyRotation < time.Hour {
+			return errors.New("ServerSettings: jwt key rotation must be at least one hour")
+		}
+	default:
+		return errors.New("ServerSettings: jwt algorithm must be either HS256, RS256, ES256 or EdDSA")
+	}
+
+	switch cfg.RateLimitStore {
+	case "redis", "memory", "none":
+	default:
+		return errors.New("ServerSettings: rate limit store must be either redis, memory or none")
+	}
+
+	if cfg.LoginMaxAttempts < 0 {
+		return errors.New("ServerSettings: login max attempts cannot be negative")
+	}
+
+	for _, proxy := range cfg.TrustedProxies {
+		if _, _, err := net.ParseCIDR(proxy); err != nil {
+			return fmt.Errorf("ServerSettings: invalid trusted proxy %q, must be a CIDR", proxy)
+		}
 	}
 
 	return nil
@@ -82,7 +173,20 @@ func LoadServerFlags(name string) *pflag.FlagSet {
 	fs.Duration("idle-timeout", 0, "Request idle timeout")
 	fs.String("body-limit", DefaultBodyLimit, "Max body size for http requests")
 	fs.StringSlice("cors-allow-origin", []string{}, "CORS Allowed origins")
-	fs.String("jwt-secret", "", "JWT secret key")
+	fs.StringSlice("trusted-proxies", []string{}, "CIDRs of the proxies trusted to set X-Forwarded-For, if empty the client IP is the address of the connection")
+	fs.String("jwt-secret", "", "JWT secret key, signs the tokens with HS256 (at least 32 bytes) or encrypts the signing keys of the other algorithms")
+	fs.String("jwt-algorithm", DefaultJwtAlgorithm, "Algorithm of the JWT signatures (HS256, RS256, ES256, EdDSA), the asymmetric keys are published on /.well-known/jwks.json")
+	fs.Duration("jwt-key-rotation", DefaultJwtKeyRotation, "Age of the JWT signing key when it's replaced, only for the asymmetric algorithms")
+	fs.Duration("shutdown-delay", 0, "Time to keep serving requests after the readiness check starts failing on shutdown")
+	fs.Duration("idempotency-ttl", DefaultIdempotencyTTL, "Time to keep the responses of the requests with an Idempotency-Key")
+	fs.String("rate-limit", DefaultRateLimit, "Requests allowed to every principal, as requests/period")
+	fs.String("rate-limit-ip", DefaultRateLimitIP, "Requests allowed to every client IP before the authentication, as requests/period")
+	fs.StringSlice("rate-limit-routes", []string{}, "Limits of the routes, as operationId=requests/period or METHOD /path=requests/period")
+	fs.String("rate-limit-store", DefaultRateLimitStore, "Store of the rate limits (redis, memory, none), redis falls back to memory on errors")
+	fs.Int("login-max-attempts", DefaultLoginMaxAttempts, "Consecutive failed logins that lock the account, zero disables the lockout")
+	fs.Duration("login-lockout", DefaultLoginLockout, "Time that an account stays locked after too many failed logins")
+	fs.Duration("access-token-ttl", DefaultAccessTokenTTL, "Time that the access tokens issued by the login are valid")
+	fs.Duration("refresh-token-ttl", DefaultRefreshTokenTTL, "Time that a refresh token can be exchanged for a new access token")
 
 	return fs
 }
@@ -0,0 +1,64 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package config
+
+import (
+	"errors"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	DefaultStorageDriver = "local"
+	DefaultStoragePath   = "storage"
+)
+
+type StorageSettings struct {
+	Driver           string `mapstructure:"storage-driver"`
+	Path             string `mapstructure:"storage-path"`
+	S3Endpoint       string `mapstructure:"s3-endpoint"`
+	S3Region         string `mapstructure:"s3-region"`
+	S3Bucket         string `mapstructure:"s3-bucket"`
+	S3AccessKey      string `mapstructure:"s3-access-key"`
+	S3SecretKey      string `mapstructure:"s3-secret-key"`
+	S3ForcePathStyle bool   `mapstructure:"s3-force-path-style"`
+}
+
+func (cfg *StorageSettings) SetDefaults() {
+	if cfg.Driver == "" {
+		cfg.Driver = DefaultStorageDriver
+	}
+	if cfg.Path == "" {
+		cfg.Path = DefaultStoragePath
+	}
+}
+
+func (cfg *StorageSettings) Validate() error {
+	switch cfg.Driver {
+	case "local":
+	case "s3":
+		if cfg.S3Bucket == "" {
+			return errors.New("StorageSettings: the S3 bucket is required")
+		}
+	default:
+		return errors.New("StorageSettings: storage driver must be either local or s3")
+	}
+
+	return nil
+}
+
+func LoadStorageFlags(name string) *pflag.FlagSet {
+	fs := pflag.NewFlagSet(name, pflag.ContinueOnError)
+	fs.String("storage-driver", DefaultStorageDriver, "Storage of the uploaded files (local, s3)")
+	fs.String("storage-path", DefaultStoragePath, "Directory of the local storage, must be shared with the queue workers")
+	fs.String("s3-endpoint", "", "S3 endpoint, uses AWS if empty")
+	fs.String("s3-region", "", "S3 region")
+	fs.String("s3-bucket", "", "S3 bucket")
+	fs.String("s3-access-key", "", "S3 access key")
+	fs.String("s3-secret-key", "", "S3 secret key")
+	fs.Bool("s3-force-path-style", false, "Use path-style addressing on the S3 requests")
+
+	return fs
+}
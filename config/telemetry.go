@@ -0,0 +1,66 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package config
+
+import (
+	"errors"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	DefaultTraceExporter    = "none"
+	DefaultTraceSampleRatio = 1.0
+	DefaultServiceName      = "goapp"
+	DefaultWorkerMetrics    = ":9091"
+)
+
+type TelemetrySettings struct {
+	TraceExporter    string  `mapstructure:"trace-exporter"`
+	TraceEndpoint    string  `mapstructure:"trace-endpoint"`
+	TraceSampleRatio float64 `mapstructure:"trace-sample-ratio"`
+	ServiceName      string  `mapstructure:"service-name"`
+	Metrics          bool    `mapstructure:"metrics"`
+	MetricsListen    string  `mapstructure:"metrics-listen"`
+}
+
+func (cfg *TelemetrySettings) SetDefaults() {
+	if cfg.TraceExporter == "" {
+		cfg.TraceExporter = DefaultTraceExporter
+	}
+	// zero is unset, the traces are disabled with the none exporter
+	if cfg.TraceSampleRatio == 0 {
+		cfg.TraceSampleRatio = DefaultTraceSampleRatio
+	}
+	if cfg.ServiceName == "" {
+		cfg.ServiceName = DefaultServiceName
+	}
+}
+
+func (cfg *TelemetrySettings) Validate() error {
+	switch cfg.TraceExporter {
+	case "otlp", "stdout", "none":
+	default:
+		return errors.New("TelemetrySettings: trace exporter must be one of otlp, stdout or none")
+	}
+
+	if cfg.TraceSampleRatio < 0 || cfg.TraceSampleRatio > 1 {
+		return errors.New("TelemetrySettings: trace sample ratio must be between 0 and 1")
+	}
+
+	return nil
+}
+
+func LoadTelemetryFlags(name string) *pflag.FlagSet {
+	fs := pflag.NewFlagSet(name, pflag.ContinueOnError)
+	fs.String("trace-exporter", DefaultTraceExporter, "Trace exporter (otlp, stdout, none)")
+	fs.String("trace-endpoint", "", "OTLP/HTTP endpoint URL, uses the OTEL_EXPORTER_OTLP_* variables if empty")
+	fs.Float64("trace-sample-ratio", DefaultTraceSampleRatio, "Ratio of traces to sample, use the none exporter to disable them")
+	fs.String("service-name", DefaultServiceName, "Service name reported on the traces")
+	fs.Bool("metrics", true, "Expose Prometheus metrics on /metrics")
+	fs.String("metrics-listen", "", "Separate listen address for /metrics (default: the server listener, or "+DefaultWorkerMetrics+" for the queue)")
+
+	return fs
+}
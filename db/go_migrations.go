@@ -0,0 +1,44 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package db
+
+import (
+	"fmt"
+	"sync"
+
+	"go.megpoid.dev/go-skel/pkg/migration"
+)
+
+var (
+	goMigrationsMu sync.Mutex
+	goMigrations   []migration.GoMigration
+)
+
+// RegisterMigration adds a Go migration, applied along with the files of the migrations directory. The ID must
+// use the same timestamp prefix as the files so it sorts between them. It's meant to be called from an init
+// function and panics if the ID is already registered.
+func RegisterMigration(id string, up, down migration.GoMigrationFunc) {
+	goMigrationsMu.Lock()
+	defer goMigrationsMu.Unlock()
+
+	for _, m := range goMigrations {
+		if m.ID == id {
+			panic(fmt.Sprintf("migration %s is already registered", id))
+		}
+	}
+
+	goMigrations = append(goMigrations, migration.GoMigration{ID: id, Up: up, Down: down})
+}
+
+// GoMigrations returns the registered Go migrations.
+func GoMigrations() []migration.GoMigration {
+	goMigrationsMu.Lock()
+	defer goMigrationsMu.Unlock()
+
+	result := make([]migration.GoMigration, len(goMigrations))
+	copy(result, goMigrations)
+
+	return result
+}
@@ -0,0 +1,58 @@
+-- +migrate Up
+
+create table if not exists events
+(
+    id          bigint generated always as identity,
+    created_at  timestamptz not null default now(),
+    operation   text        not null,
+    resource    text        not null,
+    resource_id bigint      not null,
+    primary key (id)
+);
+
+create index if not exists events_resource_id_idx on events (resource, id);
+
+-- Records the change on the event log and notifies the listeners of the 'events' channel.
+-- The log is bounded to the last 10000 events, older ones are removed on every insert.
+-- +migrate StatementBegin
+create or replace function notify_change() returns trigger as
+$$
+declare
+    event_id  bigint;
+    record_id bigint;
+    event_time timestamptz := now();
+begin
+    if tg_op = 'DELETE' then
+        record_id := old.id;
+    else
+        record_id := new.id;
+    end if;
+
+    insert into events (created_at, operation, resource, resource_id)
+    values (event_time, tg_op, tg_table_name, record_id)
+    returning id into event_id;
+
+    delete from events where id <= event_id - 10000;
+
+    perform pg_notify('events', json_build_object(
+            'id', event_id,
+            'created_at', event_time,
+            'operation', tg_op,
+            'resource', tg_table_name,
+            'resource_id', record_id)::text);
+
+    return null;
+end;
+$$ language plpgsql;
+-- +migrate StatementEnd
+
+create trigger profiles_notify_change
+    after insert or update or delete
+    on profiles
+    for each row
+execute function notify_change();
+
+-- +migrate Down
+drop trigger if exists profiles_notify_change on profiles;
+drop function if exists notify_change();
+drop table if exists events;
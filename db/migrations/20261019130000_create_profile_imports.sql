@@ -0,0 +1,37 @@
+-- +migrate Up
+
+create table if not exists profile_imports
+(
+    id          bigint generated always as identity,
+    created_at  timestamptz not null,
+    updated_at  timestamptz not null,
+    status      text        not null,
+    filename    text        not null,
+    format      text        not null,
+    file_key    text        not null,
+    total       bigint      not null default 0,
+    processed   bigint      not null default 0,
+    imported    bigint      not null default 0,
+    failed      bigint      not null default 0,
+    error       text,
+    finished_at timestamptz,
+    primary key (id),
+    check (status in ('pending', 'running', 'completed', 'failed'))
+);
+
+-- Rows of the import that couldn't be validated or saved, used to build the error report.
+create table if not exists profile_import_errors
+(
+    id        bigint generated always as identity,
+    import_id bigint not null references profile_imports (id) on delete cascade,
+    line      bigint not null,
+    email     text   not null,
+    message   text   not null,
+    primary key (id)
+);
+
+create index if not exists profile_import_errors_import_id_idx on profile_import_errors (import_id, id);
+
+-- +migrate Down
+drop table if exists profile_import_errors;
+drop table if exists profile_imports;
@@ -0,0 +1,20 @@
+-- +migrate Up
+
+-- Responses of the requests sent with an Idempotency-Key, the response is set once the request is completed.
+create table if not exists idempotency_keys
+(
+    key              text        not null,
+    fingerprint      text        not null,
+    completed        boolean     not null default false,
+    response_status  integer,
+    response_headers jsonb,
+    response_body    bytea,
+    created_at       timestamptz not null,
+    expires_at       timestamptz not null,
+    primary key (key)
+);
+
+create index if not exists idempotency_keys_expires_at_idx on idempotency_keys (expires_at);
+
+-- +migrate Down
+drop table if exists idempotency_keys;
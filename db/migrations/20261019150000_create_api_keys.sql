@@ -0,0 +1,26 @@
+-- +migrate Up
+
+-- Only a hash of the keys is stored, the prefix identifies the key and can be shown to the users.
+create table if not exists api_keys
+(
+    id           bigint generated always as identity,
+    created_at   timestamptz not null,
+    updated_at   timestamptz not null,
+    name         text        not null,
+    owner        text        not null,
+    prefix       text        not null,
+    hash         text        not null,
+    scopes       text[]      not null default '{}',
+    expires_at   timestamptz,
+    last_used_at timestamptz,
+    revoked_at   timestamptz,
+    primary key (id),
+    unique (prefix),
+    check (char_length(name) <= 255),
+    check (char_length(owner) <= 255)
+);
+
+create index if not exists api_keys_owner_idx on api_keys (owner);
+
+-- +migrate Down
+drop table if exists api_keys;
@@ -0,0 +1,38 @@
+-- +migrate Up
+
+-- The usernames are stored in lowercase and the passwords as argon2 hashes. Too many failed logins lock the
+-- account until locked_until.
+create table if not exists users
+(
+    id            bigint generated always as identity,
+    created_at    timestamptz not null,
+    updated_at    timestamptz not null,
+    username      text        not null,
+    password_hash text        not null,
+    failed_logins integer     not null default 0,
+    locked_until  timestamptz,
+    last_login_at timestamptz,
+    primary key (id),
+    unique (username),
+    check (char_length(username) <= 255)
+);
+
+-- Security relevant actions, like the logins. The username is kept for the attempts of unknown users.
+create table if not exists audit_events
+(
+    id         bigint generated always as identity,
+    created_at timestamptz not null,
+    action     text        not null,
+    success    boolean     not null,
+    user_id    bigint references users (id) on delete set null,
+    username   text        not null default '',
+    ip_address text        not null default '',
+    reason     text        not null default '',
+    primary key (id)
+);
+
+create index if not exists audit_events_user_id_idx on audit_events (user_id, created_at);
+
+-- +migrate Down
+drop table if exists audit_events;
+drop table if exists users;
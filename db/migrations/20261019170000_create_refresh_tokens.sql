@@ -0,0 +1,36 @@
+-- +migrate Up
+
+-- The refresh tokens are rotated on every use, the tokens of the same login share the family. Only a hash of the
+-- tokens is stored.
+create table if not exists refresh_tokens
+(
+    id         bigint generated always as identity,
+    created_at timestamptz not null,
+    user_id    bigint      not null references users (id) on delete cascade,
+    family_id  uuid        not null,
+    token_hash text        not null,
+    expires_at timestamptz not null,
+    used_at    timestamptz,
+    revoked_at timestamptz,
+    primary key (id),
+    unique (token_hash)
+);
+
+create index if not exists refresh_tokens_family_id_idx on refresh_tokens (family_id);
+create index if not exists refresh_tokens_expires_at_idx on refresh_tokens (expires_at);
+
+-- The access tokens revoked before they expire, identified by their jti claim.
+create table if not exists revoked_tokens
+(
+    id         bigint generated always as identity,
+    token_id   text        not null,
+    expires_at timestamptz not null,
+    primary key (id),
+    unique (token_id)
+);
+
+create index if not exists revoked_tokens_expires_at_idx on revoked_tokens (expires_at);
+
+-- +migrate Down
+drop table if exists revoked_tokens;
+drop table if exists refresh_tokens;
@@ -0,0 +1,22 @@
+-- +migrate Up
+
+-- The keys that sign the access tokens, shared by all the instances. The private keys are encrypted with the jwt
+-- secret. Only one key is active, the retired keys verify the tokens until they expire.
+create table if not exists signing_keys
+(
+    id          bigint generated always as identity,
+    created_at  timestamptz not null,
+    key_id      text        not null,
+    algorithm   text        not null,
+    private_key bytea       not null,
+    retired_at  timestamptz,
+    expires_at  timestamptz,
+    primary key (id),
+    unique (key_id)
+);
+
+create unique index if not exists signing_keys_active_idx on signing_keys ((true)) where retired_at is null;
+create index if not exists signing_keys_expires_at_idx on signing_keys (expires_at);
+
+-- +migrate Down
+drop table if exists signing_keys;
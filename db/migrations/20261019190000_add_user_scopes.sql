@@ -0,0 +1,9 @@
+-- +migrate Up
+
+-- The scopes granted to the access tokens of the user, like admin.
+alter table users
+    add column if not exists scopes text[] not null default '{}';
+
+-- +migrate Down
+alter table users
+    drop column if exists scopes;
@@ -0,0 +1,13 @@
+-- +migrate Up
+
+-- The end of the lease of the request that holds the key, a retry can take over the key once it ends. The keys
+-- created before have an ended lease.
+alter table idempotency_keys
+    add column if not exists locked_until timestamptz not null default now();
+
+alter table idempotency_keys
+    alter column locked_until drop default;
+
+-- +migrate Down
+alter table idempotency_keys
+    drop column if exists locked_until;
@@ -13,9 +13,30 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Reset the query statistics
+	// (DELETE /admin/queries)
+	ResetQueryStats(ctx echo.Context) error
+	// Retrieve the query statistics
+	// (GET /admin/queries)
+	ListQueryStats(ctx echo.Context, params ListQueryStatsParams) error
+	// Retrieve a list of API keys
+	// (GET /api-keys)
+	ListApiKeys(ctx echo.Context, params ListApiKeysParams) error
+	// Create a new API key
+	// (POST /api-keys)
+	CreateApiKey(ctx echo.Context) error
+	// Revoke an API key
+	// (DELETE /api-keys/{id})
+	RevokeApiKey(ctx echo.Context, id ApiKeyId) error
+	// Replace an API key
+	// (POST /api-keys/{id}/rotate)
+	RotateApiKey(ctx echo.Context, id ApiKeyId) error
 
 	// (POST /auth/login)
 	Login(ctx echo.Context) error
+	// Log out
+	// (POST /auth/logout)
+	Logout(ctx echo.Context) error
 
 	// (GET /auth/oauth/callback)
 	OAuthCallback(ctx echo.Context) error
@@ -23,11 +44,26 @@ type ServerInterface interface {
 	// (GET /auth/oauth/login)
 	OAuthLogin(ctx echo.Context) error
 
-	// (POST /auth/refresh)
+	// (POST /auth/oauth/refresh)
 	OAuthRefresh(ctx echo.Context) error
+	// Change the password of the logged user
+	// (POST /auth/password)
+	ChangePassword(ctx echo.Context) error
+	// Exchange a refresh token for new tokens
+	// (POST /auth/refresh)
+	RefreshToken(ctx echo.Context) error
+	// Register a new user
+	// (POST /auth/register)
+	Register(ctx echo.Context) error
 	// Create a new delay job request
 	// (POST /background/delay)
 	ProcessBackground(ctx echo.Context) error
+	// Run several operations in a single transaction
+	// (POST /batch)
+	Batch(ctx echo.Context) error
+	// Stream the changes of the resources
+	// (GET /events)
+	StreamEvents(ctx echo.Context, params StreamEventsParams) error
 	// Check if the app is started
 	// (GET /health/live)
 	LiveCheck(ctx echo.Context, params LiveCheckParams) error
@@ -40,6 +76,15 @@ type ServerInterface interface {
 	// Create a new profile
 	// (POST /profiles)
 	SaveProfile(ctx echo.Context) error
+	// Import profiles from a CSV or NDJSON file
+	// (POST /profiles/imports)
+	CreateProfileImport(ctx echo.Context) error
+	// Get the progress of a profile import
+	// (GET /profiles/imports/{id})
+	GetProfileImport(ctx echo.Context, id ImportId) error
+	// Download the rows of a profile import that failed
+	// (GET /profiles/imports/{id}/errors)
+	GetProfileImportErrors(ctx echo.Context, id ImportId) error
 	// Delete a profile by ID
 	// (DELETE /profiles/{id})
 	RemoveProfile(ctx echo.Context, id ProfileId) error
@@ -53,6 +98,9 @@ type ServerInterface interface {
 	// (GET /queues/{name}/tasks/{id})
 	GetTask(ctx echo.Context, name QueueName, id TaskId) error
 
+	// (GET /queues/{name}/tasks/{id}/events)
+	StreamTask(ctx echo.Context, name QueueName, id TaskId) error
+
 	// (GET /queues/{name}/tasks/{id}/response)
 	GetTaskResponse(ctx echo.Context, name QueueName, id TaskId) error
 }
@@ -62,6 +110,196 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// ResetQueryStats converts echo context to params.
+func (w *ServerInterfaceWrapper) ResetQueryStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	ctx.Set(ApikeyAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ResetQueryStats(ctx)
+	return err
+}
+
+// ListQueryStats converts echo context to params.
+func (w *ServerInterfaceWrapper) ListQueryStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	ctx.Set(ApikeyAuthScopes, []string{"admin"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListQueryStatsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListQueryStats(ctx, params)
+	return err
+}
+
+// ListApiKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) ListApiKeys(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApikeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListApiKeysParams
+
+	paramsMap := map[string]bool{
+		"before":   true,
+		"after":    true,
+		"page":     true,
+		"q":        true,
+		"limit":    true,
+		"includes": true,
+		"filters":  true,
+		"fields":   true,
+		"sort":     true,
+		"format":   true,
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", ctx.QueryParams(), &params.Before)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before: %s", err))
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "includes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includes", ctx.QueryParams(), &params.Includes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includes: %s", err))
+	}
+
+	// ------------- Optional query parameter "filters" -------------
+
+	params.Filters = &Filters{}
+	for key, values := range ctx.QueryParams() {
+		if !paramsMap[key] {
+			(*params.Filters)[key] = values[0]
+		}
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", ctx.QueryParams(), &params.Fields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListApiKeys(ctx, params)
+	return err
+}
+
+// CreateApiKey converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateApiKey(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApikeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateApiKey(ctx)
+	return err
+}
+
+// RevokeApiKey converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeApiKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id ApiKeyId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApikeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeApiKey(ctx, id)
+	return err
+}
+
+// RotateApiKey converts echo context to params.
+func (w *ServerInterfaceWrapper) RotateApiKey(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id ApiKeyId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApikeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RotateApiKey(ctx, id)
+	return err
+}
+
 // Login converts echo context to params.
 func (w *ServerInterfaceWrapper) Login(ctx echo.Context) error {
 	var err error
@@ -71,6 +309,17 @@ func (w *ServerInterfaceWrapper) Login(ctx echo.Context) error {
 	return err
 }
 
+// Logout converts echo context to params.
+func (w *ServerInterfaceWrapper) Logout(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Logout(ctx)
+	return err
+}
+
 // OAuthCallback converts echo context to params.
 func (w *ServerInterfaceWrapper) OAuthCallback(ctx echo.Context) error {
 	var err error
@@ -98,6 +347,39 @@ func (w *ServerInterfaceWrapper) OAuthRefresh(ctx echo.Context) error {
 	return err
 }
 
+// ChangePassword converts echo context to params.
+func (w *ServerInterfaceWrapper) ChangePassword(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ChangePassword(ctx)
+	return err
+}
+
+// RefreshToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RefreshToken(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RefreshToken(ctx)
+	return err
+}
+
+// Register converts echo context to params.
+func (w *ServerInterfaceWrapper) Register(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{"admin"})
+
+	ctx.Set(ApikeyAuthScopes, []string{"admin"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Register(ctx)
+	return err
+}
+
 // ProcessBackground converts echo context to params.
 func (w *ServerInterfaceWrapper) ProcessBackground(ctx echo.Context) error {
 	var err error
@@ -115,6 +397,67 @@ func (w *ServerInterfaceWrapper) ProcessBackground(ctx echo.Context) error {
 	return err
 }
 
+// Batch converts echo context to params.
+func (w *ServerInterfaceWrapper) Batch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApikeyAuthScopes, []string{})
+
+	ctx.Set(OAuthScopes, []string{"read", "write"})
+
+	ctx.Set(OpenIDScopes, []string{"read", "write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Batch(ctx)
+	return err
+}
+
+// StreamEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApikeyAuthScopes, []string{})
+
+	ctx.Set(OAuthScopes, []string{"read", "write"})
+
+	ctx.Set(OpenIDScopes, []string{"read", "write"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+
+	// ------------- Required query parameter "resources" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "resources", ctx.QueryParams(), &params.Resources)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resources: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamEvents(ctx, params)
+	return err
+}
+
 // LiveCheck converts echo context to params.
 func (w *ServerInterfaceWrapper) LiveCheck(ctx echo.Context) error {
 	var err error
@@ -178,6 +521,7 @@ func (w *ServerInterfaceWrapper) ListProfiles(ctx echo.Context) error {
 		"filters":  true,
 		"fields":   true,
 		"sort":     true,
+		"format":   true,
 	}
 
 	// ------------- Optional query parameter "before" -------------
@@ -245,6 +589,13 @@ func (w *ServerInterfaceWrapper) ListProfiles(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
 	}
 
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ListProfiles(ctx, params)
 	return err
@@ -267,6 +618,71 @@ func (w *ServerInterfaceWrapper) SaveProfile(ctx echo.Context) error {
 	return err
 }
 
+// CreateProfileImport converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateProfileImport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApikeyAuthScopes, []string{})
+
+	ctx.Set(OAuthScopes, []string{"read", "write"})
+
+	ctx.Set(OpenIDScopes, []string{"read", "write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateProfileImport(ctx)
+	return err
+}
+
+// GetProfileImport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileImport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id ImportId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApikeyAuthScopes, []string{})
+
+	ctx.Set(OAuthScopes, []string{"read", "write"})
+
+	ctx.Set(OpenIDScopes, []string{"read", "write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfileImport(ctx, id)
+	return err
+}
+
+// GetProfileImportErrors converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProfileImportErrors(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id ImportId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApikeyAuthScopes, []string{})
+
+	ctx.Set(OAuthScopes, []string{"read", "write"})
+
+	ctx.Set(OpenIDScopes, []string{"read", "write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProfileImportErrors(ctx, id)
+	return err
+}
+
 // RemoveProfile converts echo context to params.
 func (w *ServerInterfaceWrapper) RemoveProfile(ctx echo.Context) error {
 	var err error
@@ -371,6 +787,38 @@ func (w *ServerInterfaceWrapper) GetTask(ctx echo.Context) error {
 	return err
 }
 
+// StreamTask converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamTask(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name QueueName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", ctx.Param("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id TaskId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApikeyAuthScopes, []string{})
+
+	ctx.Set(OAuthScopes, []string{"read", "write"})
+
+	ctx.Set(OpenIDScopes, []string{"read", "write"})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StreamTask(ctx, name, id)
+	return err
+}
+
 // GetTaskResponse converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTaskResponse(ctx echo.Context) error {
 	var err error
@@ -431,19 +879,35 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.DELETE(baseURL+"/admin/queries", wrapper.ResetQueryStats)
+	router.GET(baseURL+"/admin/queries", wrapper.ListQueryStats)
+	router.GET(baseURL+"/api-keys", wrapper.ListApiKeys)
+	router.POST(baseURL+"/api-keys", wrapper.CreateApiKey)
+	router.DELETE(baseURL+"/api-keys/:id", wrapper.RevokeApiKey)
+	router.POST(baseURL+"/api-keys/:id/rotate", wrapper.RotateApiKey)
 	router.POST(baseURL+"/auth/login", wrapper.Login)
+	router.POST(baseURL+"/auth/logout", wrapper.Logout)
 	router.GET(baseURL+"/auth/oauth/callback", wrapper.OAuthCallback)
 	router.GET(baseURL+"/auth/oauth/login", wrapper.OAuthLogin)
-	router.POST(baseURL+"/auth/refresh", wrapper.OAuthRefresh)
+	router.POST(baseURL+"/auth/oauth/refresh", wrapper.OAuthRefresh)
+	router.POST(baseURL+"/auth/password", wrapper.ChangePassword)
+	router.POST(baseURL+"/auth/refresh", wrapper.RefreshToken)
+	router.POST(baseURL+"/auth/register", wrapper.Register)
 	router.POST(baseURL+"/background/delay", wrapper.ProcessBackground)
+	router.POST(baseURL+"/batch", wrapper.Batch)
+	router.GET(baseURL+"/events", wrapper.StreamEvents)
 	router.GET(baseURL+"/health/live", wrapper.LiveCheck)
 	router.GET(baseURL+"/health/ready", wrapper.ReadyCheck)
 	router.GET(baseURL+"/profiles", wrapper.ListProfiles)
 	router.POST(baseURL+"/profiles", wrapper.SaveProfile)
+	router.POST(baseURL+"/profiles/imports", wrapper.CreateProfileImport)
+	router.GET(baseURL+"/profiles/imports/:id", wrapper.GetProfileImport)
+	router.GET(baseURL+"/profiles/imports/:id/errors", wrapper.GetProfileImportErrors)
 	router.DELETE(baseURL+"/profiles/:id", wrapper.RemoveProfile)
 	router.GET(baseURL+"/profiles/:id", wrapper.GetProfile)
 	router.PATCH(baseURL+"/profiles/:id", wrapper.UpdateProfile)
 	router.GET(baseURL+"/queues/:name/tasks/:id", wrapper.GetTask)
+	router.GET(baseURL+"/queues/:name/tasks/:id/events", wrapper.StreamTask)
 	router.GET(baseURL+"/queues/:name/tasks/:id/response", wrapper.GetTaskResponse)
 
 }
@@ -18,51 +18,136 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xabXPbuPH/Khj8/++OEmXHl/j0qr64l/E1vbiOM9cZj8cDkUsJMQnAAChb9ei7dxbg",
-	"owTKUs/JXPtOIpa7P+wTdpd4pokslBQgrKHTZ7oAloJ2Pz/KhFkuBf5OwSSaK/+Xfrn6SKwkyQKSe5JJ",
-	"TSwz98RYZksTazBlbmlETbKAguHb8MQKlQOd0oW1ykzjuHoyTmQRM8VjZGBintKI2pVCSmM1F3O6Xq8j",
-	"qphmBdgKF8ss6G1Q1wsgSamN1A6SBqs5LLmYE7sAIuDJEsXmMKYR5Uj/UIJe0YgKVqA8z7WLehNIRGeQ",
-	"SQ2HilYallyWZqf4ivVu+RmHPDXb8t/LomAjA6gnCynJubFEZsTTo6k02FILwoVDpMEoKQyMyW/SEo6W",
-	"KEDgmyuwQwgr4WG78jRyZFEQdV6brg/7LE05/mQ5qWic+pxcLubDQDy/LhLWsLrUUoG2HExAhw08OfsK",
-	"iXXwuEjyMoXd+DTkLhbMgiun0OqtIYwN0y5IbqEwfb0pLTOeO7ohqExrtnJIc15wG/a+gj3xoiyIKIsZ",
-	"aLS9E9aafgio5xk06tGkwcCFhTlo6oNxPhACuFIj8IIxDAZ15BiFJYcFe11dpGHpF+e4bR9wjrCRq5hd",
-	"dEyDSUbDQ8k1pHRqdQlDIDKpC2Y9jLcnNIjKbyqIKIWMlbn1Dk2aJDakj4eB2PoqF+IvnYQZDLKHEkr4",
-	"zTHahPIPXCIoZEAjVeTuoRNabSkIwUht/5PcZBQkPFs50yEPInUKeky+GCA/EKnJiDBDGObRjD8dlLMc",
-	"pPBOfkCSaBQ8ciKK59HLjoZUf9DL6ORdcvITzGYjgJ9ORyeT2Wx0+naWjn46/jGD46PsBE7fBSEuQc+k",
-	"Cdj7l5zNUa8g2CwHUtG1SX9AVzW/IEyPvwIxkzIHJvzZXLN1ie2LgCcFiYX0r1pLd0gnUlgQzjGYUjn3",
-	"NUX81fjCopX1/xoyOqX/F7cFSexXTey5OXkbKVqQspFJAMmITJJSa0jH3is9C5RwVtrFFTyUYBwc1Tsp",
-	"FDPmUep0KLX51drypfFx3MnkFcXR8RvayRwN200TRvRpJJnio0SmMAcxgier2ciyuYOzZDlPmcUXGi/C",
-	"7aBgEQzz6woWrg7DxGTyOljWXf++aYFF7Z5vt87biJ5DzlaDVkhxdXtv7iWSltoXpN0N/WiC0dGF5pmG",
-	"wDReuonCMp5Degf1eii5e5rK6ToE49Bhng8W08isXq3t5ngG+RRgzOAB7KFUJMHXfY1+h3YOs/AEBAle",
-	"ArOh5RpZX0hI63+XKeTbWk804AlxxwZqHLeOSrK8AGNZoWqEBTIM7pcPxHMp+EMJhKcgLM+4L5l6rA6p",
-	"AzB+5nJUJdKLc5RcqnTnbnJmLPFE+29oQ+XukOmorSc1pPlLNuei8UMp4FNGpze7c2/7znvX4tB1tO8L",
-	"l+gP69ue4IoJHgd5fpD4a9wNCu+7DXZ2d0nDdWdn1usEUdm+WTVB38G+7RDGvT5vN/MNO3a30Je7bcS+",
-	"Ni+rXPBKunQHp7B3wzV+RdGt9ceBwjiiBXvawabuV15koyGROjV3CvQOdm3bU9ETBbrpt7e5WmlZflfR",
-	"hlk6km3G43AX0LVmT4kdRWyKDWzuJXM7syFcjnALfOqds2BKoWd5GzqP3TOa64Zuv1iOaodZ+V7Dw20f",
-	"99rubpnbePfuSHCrwczle7r9fd0fMi/mKs+2rke8uv2zjzxUoDQ9fPNjD/bb3bzTeicV7xe12weAA9Fj",
-	"tkN5g1UXFIznAyUFLhGWphqM2WiwiXwUofpynErY3bBGNOPa2LvhStatk24tu0Por6GiFosutlOGO4L3",
-	"FHEu4UXn7eypKzuq9BuyzDUz9wF7hMvSumrb2qevRbsDr1ZCp2Dcjd5xb+lDaLGwC+mynf7iZkVZID8F",
-	"IvXNhS6FqH6BdS2nKZMEIAWsXzJXRqO8VtvtG9tTMWbu74JlHaJoC7qe9d6ydxlj796MspSdjE5Ojk5H",
-	"s9Pjt6PTH7Pjdydv37Cj46OXc1MludbDkD3fV3XqVdUZbxsyP3Cy7qs609vS4ZP0P4fumr0H1SfvQWzr",
-	"y9aPt2P419+viVt22mKlXeAmvIiXCy/P+DY0FjaQlJrb1WfMwh7GmeL3sDor7cIdAAjBfy5phyj/HJ1d",
-	"Xoz+BqtWNFMc/68j+jMwDbp+f+b+/VK3Fr/+fl2PXvz3AFxtuaC5kcen+vUsl4/+ICpUzhM/GMb9S83/",
-	"5bb/Red0SmOJD+OUs1zOnQSp/HY0sJRO6QfNhDUE/xGWJGDQzx41d/1+tej+1quu9a8VhsyPHTAF4uLc",
-	"tRX4K30vhYDEViDGj5Dno3shH0WM6zwdJVJkfN629DXH7tteFheZ3Lb+53vIydnlBRmRc5mUBQjr28O6",
-	"Hq8JkDe3zpc7j5agjWd0NJ6MJ7gFFM0Up1P6ZjwZH7tz1S6crmKnxFzOuXdP6Y9RdFIn9AI1+dEtew8D",
-	"Y3+W6erVJmDd2dXG7MXqEjYHcceTyauJ9kEZGL59Lp1DZGVOvGa6cUOnNxhXboR040ZvbWDSW6T0OvXu",
-	"mbA8n7HEnYVzCOjW+f37miq822+GrrH7MLTW9t8Fl4ZMg1kM++In7zCe6kBYNfMDgaFl5lqWIo2bMV4Y",
-	"3KWWKOvn5oVvFDS9WeNeUXP8elETqgQCQVTV5i65KgupS1/1qMsXd/6Ty4C8ZgPx5vDdma8sCqZXdEod",
-	"FiCMCHgkzj7kq5wR3WgnovECWI7uzpfQ8faN7zmuNOG+YGZKEW6w9tMInYmUVKUbMY0/5Ss8iDdSJV+C",
-	"40T7X/cHmrqWJK4/U6xv9/Hq6xZkXVP+YZX2IqKj4GHF0DZyPvsy+barbzx6V/srfMEMQbQ5oM654Jaz",
-	"vDrznQm4P81XWEl6tyKJP0+5FGbbGFdI/L2t4fb8PW2xQyVD9mm+zg+l/o/c2Mv2E/5huquufAyNKDqU",
-	"/m7KHoTKD2lepPOf/vYg9NcD9iBsLjzsQVtf4NiL1F062YPSfeodcsNXyejdudDuaqhxklfM31fVbQrC",
-	"mk/o3csjlfvWA6fbdTRw9n5mS6ipvs2puzlT2+fcPXpt6buO2uqLSe+IotHQ1beQsIo0bugqaa9/UqvG",
-	"VNsm7qao+Jmn6+oLKvghzWaSL2Tf8j0DnGxn61pdnuOGul5vu+eOvbvf4eXNVuTifMCng1n4A9jBfU2+",
-	"h2N949D/AHZP9Rx2ALUXqjBvKmaTxbZyv7jZ058vY/wvGNbrdi/bYqy7O14mfhasgHU16KvDPlg6fppZ",
-	"xgVh3fu5W9XfB7BuCv0tJwjI/3upt9adE3p4ULQX6fYoOqprYu6z0aB5Grx72qkhH7DUVbt+UFv/367i",
-	"fr3/3Bun3twiq+6A1j+pxqU3fthZzzX9UjWw3FrDTgL0st7M5k2kJeRSFSAs8VQ0oqUbci6sVdM4fl5I",
-	"Y9fTZyW1XcdMcRPPJVMqXh7RiC6Z5mxW9RSLqkJrjOGm47l77Ao4vbF8OplMMJBu1/8OAAD//wfJ+US4",
-	"LwAA",
+	"H4sIAAAAAAAC/+x9a3MbN7LoX0HN3apzcnco0ZLs2Dq1tdexlayyTqyV5LMvpSRwpkkiGgITAKNHXPrv",
+	"t7oBzIPEkENb1uac2i+JzMEAje5Gv9Dd8zHJ1KJUEqQ1yeHHZA48B01/Hp3zGf4/B5NpUVqhZHKYnM+B",
+	"gbTC3jPLZ0xNmZ0DyyqtQVqmodRgQFpOw9PEZHNYcJwG7viiLCA5TC6SZ6Pnvz5X1y/59fgiSdLE3pf4",
+	"wFgt5Cx5eEiTd9zYH1QupgLyOBAFN5ZZsQACQINRlc6A3XLDFuHF+Po/KJmy8R77nku2N97bZ+P9w/HB",
+	"4fg5++6H8zg0Ql6vQoG/GmYVATAV2tiUlRpuhKpMyiTcWcZl7gAt+QwM+8/Tb9+wl3svX37Vh5pqPN7P",
+	"5taW5nB31/++k6nFLi/FbqnVVBRg/sinFvQfskobpekV+C+mofjDRYKr9qFUZY4qKxv5cPoOt5HNIbtm",
+	"U6WZ5eaaGcttZXY1mKqwPfD2QYoTmF2RRwE5V5YXb1QlbZyyFp8zWS0moJHBNGRK5yZlShb3DLmL3Qo7",
+	"J7QjXvE/Qq7huIO9GgwhLcxAJw8ISMk1X4D1/E5IjUPkME2o0WC1gBshZ7Q+URmB2EnSROD4XyrQ90ma",
+	"SL7ABd2sbbBWEcJL8We4P+5h9OO34ZS9Pjlm13BfL1VyO29WImxr+KUSGs+M1RVEsfEsTaZKL7h16Hhx",
+	"kESwkyYTmCoN2+Ij8P9anPip1yNlKqDIzer6b9RiwUcGkHgWclYIYxFBbjzysQZbacmEDJKhVNLADvtR",
+	"WSYQBwuQ+OY92D4I/eJxphd5SsPSKNRF4Kcu2K/zXOCfvGB+DKGP1hVy1g+Im68NCa+nOtGqBG0FmAgO",
+	"a/DU5GfIrAPPEz5GVPcs8FpAW8rUDWgtcjCOB7MMSsucnthh7yXgGz8blKkyd//PzA1Tmi3MrOTZde/W",
+	"HCxxHGfmJopfsSiVtpuPiheVzI1/mhMjZFZUOawnvoaCJJWZi5K41b/Vh6V60jZkwsLCdBEWdMMq1uof",
+	"uNb8niAtxEL0cMGC34lFtWiJX1qsOVd9gLo54/gbR/GFIiIOBD4JEFgVZEwvjmiiPsrFFna4GsxFT8M+",
+	"blNRiHKY8qqwTlqwWm314eOXnkP1s5rL/9dS1dET9ksFFfxIEy2D8hd8xHCRHozQ/wbhJPFbioJglLaf",
+	"IvhNCZmY3hPpcA6mNAmpDwbY71EijRg3jKOSmoq7rRQCgRTfye9xSDrqMXbQEtrMaDjqM7ksGX+dHbyC",
+	"yWQE8Orl6GA8mYxevpjko1d7z6ew92x6AC+/joJ4A3qiTITe3xZ8hngFyScFMD+u0ag9uArzRcF08Hsg",
+	"JkoVwKWzxsK0JNh+VBvM/66nwXKRy/+wLJtzOQNmhMyga48gBsEgDSMuzu80TJPD5P/sNs7Qrh+2S2MI",
+	"wBMNmZJOkn/LReFAy5S04ExZXpaFcEb2LmpC/K1BQWwR99TsHmmtvFEa22rEt2nt8Qa0QRyQZayc1XM8",
+	"Hf3AbTZHrj+ejj7I8N7ojN4LSHhIkw8S7krILOQOii++p9eSVfWaDHAYUxm5kPmOEwBuClzhNdnGBElR",
+	"vJ8mh/9cv+oPKocieUg/JmXHOIK7Umgwl876qeVxzi2M0IuM6U303C4rA/lln83UdUKv4Z5ohK+krCpz",
+	"klPcsoVCUYV4L0GzhZCVpdMzDAzphXFbeJaFuo+NVbcSdHcwSv3YUCcF4/syluvaFLyG+5T2ROZKDtKi",
+	"kBUkK5tlzPXlM7432c8O8ufwYhpbUcONuq6xOWzzJlOlo2DE6NHA80EGTyM//xmUlMNUjYZ6pZ9W7Oaf",
+	"HlLPhm80IEmHc6N7LcKO19Cj6r2PlzJhWcZRpk2gNoByxmdcyLWIv3z5/i/yr/uTv/28v3j234vr6dHB",
+	"n//xy4vqu+sXf//T/vXxwd9vXv1jzE/3/mH/HlUHbVwhmOsQ8k4YomV3czWl6j8G4WiJbGnS8us3zHHS",
+	"jFzegoOhM9nqjsJ+Tr2WOFwvPlbJhkffWFUadqv0NXrD5PgzOxeGBAQRNFdgkKRuNiamDBalvd9eFCwJ",
+	"VLLJKBIFRRHOrGG85HrplNaSY8Hv3oGc2XlyuPf8+TpJsrrZUguZiZLjUugx3krTCAr8I+NFAbrZH3uP",
+	"kRsuGc8XQiJfMwMYHVN2DprRUl04vdDaCGUjHiJggl4Ig6rRsJnmZOL5aF2IovSKlNbCLw4i6y743bF7",
+	"eX9viLyJslxl570MV3JjbpXO+xwk9zSI6MosIzCMeLa33+auetrlPaXJ3UjxUowylcMM5AjurOYjy2cE",
+	"zg0vBHJmctjsDTeKC8eZ8tyDhU/7wYwqp0+CZQnrNWBps+cYEb5BK+l9CbqWM106TFQekdTfn73/keGj",
+	"sDMVZkiJuzVMQYPMOgYoxlFNe/cfE1hwUXg07OQKlrwzCipfOvwm3ztUFbz56a0CokLLpB0cHOqNUPjJ",
+	"IjuDndlObVkmEWQuwM5VhGV9lOi7o/OUnbw/w/9+wP+8Pn/zJ7RQ3x69Ozo/6jAGDotaLegcrSxwwu3c",
+	"h1ZuIBzyCTfAMLbdhE+3oU6y2x9TWWI2v28PXS+b9R52tLeFrOBSyUsIRnjwkA+nvDCwTLA/A5RMV1KG",
+	"yGtNJ+NVD2dTLopKgw+ca1UUOHjCs2t6Y0o+DFMSzE6y6pOlSTPlYGW+dJw6kvLZeJwmCyHDPzfIzdbq",
+	"azDqfMYYShcLYW3UfdQV6V7SVOQw1sw+wUnZLWhght9AHkVL4JUev9RBhDPCDej79gGSVVG4CxYkmATD",
+	"zLUoS8iXSZakW6D7lODZaPc2GGm2sA6xxHsfE4SZTxrXfYh4RESsiMhWRJm0Pi5sGNfgHFeKzDTn/FNE",
+	"2spW3BVWR8HvjeMxwTam/Gsx5LyFgvdbiTk+XUUHvcTySoc7qhqa5Plm4eImjQFT++vLUFg62W1RshpR",
+	"dGO8+90asBP1hHvvDskL9k8DpWnO6DwLMKY36utA8UOirzvCXKJZ0Ou32sowHLAJmBUR7iDrLhLF+o0P",
+	"jSwJHOcbbuXcinzrQHFLKtO7slog+Mc/nh2dosL8cPL2NanSWqeeHp0dnSc/RZYPgaXhNwnhjctPgHzZ",
+	"L0M51EJae2MxtP8JeGHnb/COOoZ8YUXGi5ZYaAnsnnNwCtw0TNtVlmaubukKMQQ9FyqP82TBLcjs/tIJ",
+	"64buqkKhWb/gbjN6wjnccjRW+lm+TeqqTNIkV7cyQtN4qMPPkTZo6oDdj+1TKH0ofgndSIXhRkGbdBE3",
+	"38wra4WcXdKmohRssLBkWN6A5kXhz33KcphpnkPOFsCl8e4p0lMqOQq7d4kOxts/SNQuav0cg7Fco7e7",
+	"kTSgKYZf1I4nZEYPRWL9Rse6WsZlZNQK9aZaLXpcmhOFx1UHC9rHnemGJZyThboBymvJVNkybqLiQpVt",
+	"xuW5Mz1wBvqjLHiGf/kfcEKcBYxFnLV98jByoDvQ2Qsl3bx4NX72VdhWHUOnjXVt/pbLFVnuhhdVj+qh",
+	"RwFJPM9T5sEmZOGmushq1vyeS4hYv+vciXdqpirba4domGow80urrkHG0ytWpnSB8w1qbXXb9Bx1P+o4",
+	"Y/mibBglh2KnX+2tzlVJ8UsFIcQsQK9MtY3CwSjCTI28q3z8Flf2Mfn18Xw3aPiGNiq21qoxYp50wpxK",
+	"woDAcvPOG8rFoRDzsBdO0MyhGO7KJIPj2s2b57ib1fg25kVdZvWsa1OIOnlULuGLvJOdnluLm20m7iQk",
+	"rZ98WXm2ttBdNx4RX8Lw4+HSJ1de9udL+BHtvImdqO244Hdrpgm5Hxun8Rl5lyXoNdOtZPDR7VfZNe5b",
+	"s1Le36Ufu1Vq4M5mc7ODxBYilpeNbG4TuYlsCK5AcBf4q2POBS9L5CxHQ+LYgac5JMcMO8u1U37v8jYc",
+	"uA/Lvrr1cLayrMJq608CPY1LLhdZfUNRlP7wVo37Jqb96ZFqNKHhdsNkB89f9EzWiuw/23tJAanw75eb",
+	"ELGykSVQojhy7tSn3GCvlRpu2oBzx5Lut+NFsNo/88q8P3ygY65TiKndzlWdedcysleoOK1zKNbKDnXr",
+	"DflMVUXub0Td7HjNXghjKYrZePtMQ8j6G+BPI8r6rzA61xdloci7CElhq07zTjRxEReRwszXWB6URHA7",
+	"BxkSzzIwBsO1IHPIO1tZG1IYnuG5uhtvp7stuGTOaNwgIH8T6QJSmLeGmNIhK2IgbTweBnJJPXrg7H1e",
+	"5XmXAm5YQFuTURrwVYLM3bWVj8eTL4OM4YKtns9jmCTdM2hv4aiJAlJ2LTE6oZokpzas2ppB++/zYuvz",
+	"kDYpug7ONj1aXBDZYUdROi54jFQBP9W/OlfAg/ED6BnUTnzkuNWJie6Wwd2N05W0nLnwP9d4F10SOy0J",
+	"X3czuJzIE7kkjMia5taw/f73PYlABY8Ox8vFdIjnuKSKDj/2bWUVRfQIvWUNxiznbvekBWyPghhptLEd",
+	"2b5m0UF46/ElBy7Rh+s2c3YiE83aqcdvjFH/UoG+P7M8ZpPxouhGLPvlJCnVoYPRsh4cC8Uw3fDR5avn",
+	"wwej1IwQhhyITFXS1qLV5bU79cSnU0pL3EKDUPZwjwzHGQrxK+Ts7C/vWD26S/yzo3dHb87Z/2Xfnr7/",
+	"gV3UtsRFwv76p6PTI/afF4nILxL2B/bHr9i74x+Oz9kfkz5tMhRDEfHvdpJ63qjp7nHZmr8hXE2UmvBr",
+	"+fAxlEA9WTSWLGQGQyws3K8wVmTG6UyXGzQBlqmiWGWBNTbXMiIJgnB/G8PGqYvOfU70rr1gd3h8wZkw",
+	"FvS/MNloK6/r0dKKlrK4WovubyLj0Pyhc24i91EQv5kNF5eRZBy8jm3fareTauo70w3eKc7ejI9BW2o1",
+	"02A2njHc1UkYG+RchBhNnegGe1iDpRIBU2UZQL5kNTaEa95YlW7cXF+KvAeKJmjcYYQX/Osp51/vj6Y5",
+	"PxgdHDx7OZq83Hsxevl8uvf1wYt9/mzv2eb4h1854KGPD974WHh/VkqxZQ2u85a61wXb19z+NnBX770P",
+	"fSct5owGkCJYk8KS3YhZt6ztdNXbeD4epMl7MxPeNv9arnY3FspkC68Of2ZVG+TOlcZ4vL3XFjATFo3i",
+	"NuiR7VKau/ry+7+eM9IwPnnZDA9JrOizlXIQVXK8+HHzU6WB820VUxPLhWQSbt3T+KVAz8znHbjxUPHK",
+	"zpHXHSduvgNwEy9vIW3jLobxDwb058ffyMYv1EzIrZJK2rpzUxVIn9KLefKoBiCrtLD3Zwg5hPqca7jH",
+	"VGb8F5WDudStph7sb6PXJ8cjzPGvZ+V1zv83wDXo8P6E/vVt2Of3fz0PVWSubhyfNrOgJMQ53ofXp4U3",
+	"+rGqT2SuxhVprrT4lUj+QRfJYbKr8MfdXPBCzZJ2Fjmlfx8m32kurWH4L8azDIxJ0uRWCwvNQ/pnePrQ",
+	"9oxx8j0CrAR5/BbnVfhX/kZJCZn1QOzcQlGMKJazi89FPsqUnIpZkygWZmy/7dYScqpWOf7sGgoqIRmx",
+	"tyqrFnV9XLgOCwMu5IXE44FptnRB7XJxfZWcoW2VFlP2r45zWJSKskWQiFd17fdyumPdBiNMw4RhxioN",
+	"+YXENehC/B6DttS/oTO5HZ36p4fM6grCMmkjglqzaihdRLFuBGH4wqX1u325+gcNrKkXndz7uoQd9hqf",
+	"Mw2VCVNwlospZQU30M8AEcEO9vZSQhH3yzINGYgb3NXtHB36no2LoqgzdMNc41c77Az0DWgXqiYgMabt",
+	"8JQyozp7xXTlCVxIVwEU36/bcUM6DQx3zKgi2228Jfcg92hAdzcrBO74+MTt0CWs1j+yOSVlzsVsDtpN",
+	"dyEpFM8Lo5wXbdrgImF+dmV9E1eGuyRw2RGtUHNNqG7HsRfy6pRbeIfLjOi/Vylr/XSKcQ5E5vLPBuwV",
+	"gd/69UQVIqt51bjttSG9kOoG3JmgjSGFiNivAkNcnYLV96PXUwu6ZvoLOpXCOsfdH6aEamiNO4LPdsY7",
+	"Y5+nJ3kpksNkf2e8s+fTOUjK7FIdzC7WzTaZoxCzsE+ddDY+qwSrZ0hY7bTz5bC+OCE81L6xu8hsFdTu",
+	"jQ8i4qJxgl1aTs7IQDdmWhXFvfNMfBZ6XIfVa+wul5G29QWpwLakx2SghZDJTw9pV4U0D35CZ2Gx4Po+",
+	"bI5w4ErgG+8dyUF1If9MXocpkxnYGCpdEd2y++8lV0jCY54qDO4gq2pWFoYJaSyXGaRsplVVuietEE8d",
+	"QnHSzlB0nN4mww/19Q7rJeiFXCEpRks6FG03rOkxKpohu8TXCSJyiRPGj1bj2w3sRGp9z2p2arKffkts",
+	"1WKJAZz1kCboao1QuyDoUT7rqB+JIrWOMPrkUjsHoesuBO/9T+6l4GI4EY1TuAvOlFWyAGOYsCSWSWAO",
+	"4B9X17g98/gePQ/pxpGuw9GAgaVLVtg4jggxZKBn8M0Dg5oZMjZ03Bk0lLoEDRhplB4EqLfwv+iZbVXu",
+	"Dj2wnXKM0AxtXdMEGvOQJn8bkdM7qrt9rXup1Rfs4eHLyYhV4dAnFXjdY8RXZXdkAqGR1E2pzJqyYGHc",
+	"qW+uGaTTJ62CGHzO8VzPG5t5h7VnuJWNURWEg8xdifiFnEBd4ep8GZcx0RocSo/n/AYismSTKnKV737P",
+	"zmkEY7/x1T+PyJd1QkvXN7W6goeVQ/HskRcP9f2xphWOA+pUhieykzYyqwOZcQqReCBjXNpWXbsfRf6w",
+	"zuqMVrSnTNj/MHRdjV6KsIZVGDJz5dzk7UzQ4M+FbTEvabRWmXft8ExVJfP0QnpWbLHqQJY8pW4SLZbc",
+	"ZO0GEvo2FL8ZErqNoDu8hn7b6u+6pyDlYHRJv6tViOd/8qR9Uu81MaKXWjOQoCN+ugGqkzBpN0V3heX6",
+	"uehCdtiIfToXESr6uGj89ALG0WaVOw/29p6m3VAABDvZ+KPyrz0doYpio3jDmB7FS4mze5Uyz9z9vzBY",
+	"uYiSgELDzAVzfN8OpdiCy3uW4aayiurKfcE0LWFCLINbC4uSZguyL2aKE1hfSHG2+kgMUpuPx9XuamG9",
+	"Gekoghz8iPq6l4OPJbWHaN0R6/oCO2U+CrrCActM+lOLxToRrCVWU5Xt5zUn101YkeQT3UTUBdFEM2yp",
+	"QLFFumLwQ9AKBEkWnHR6l0Ju/jz6ACqF6y5keMNUyMpaLRpBS7jv4UeE/cswZLc66WG1u1tUPb9Tsxla",
+	"x5V9Os3ckTTv1Iw5pGymvbtAQFWD7RRasYAumulm4k0YFT+Imw7OJ3Cm6orCftAasfSEcHlObx+cCGg+",
+	"S2Zb4MLknwJeOw2mz7ppWDM0G6SDWUkUJKYtXVZDtK5M4qTJivkShy9ekzFILxzEGqu42fxmI2bJUwj1",
+	"bo2Th0jgFZySs6cTEA6lvu94NyeqcMILtc4g+RE5A6tb7qoEdLMoUDCB5o489Z6fkuDuxVyMAXueUhOy",
+	"pTmofZzXIuZz9Ic/nef1/fjjM/JSmtxv0LKpZc0THoMVM0E4gyf1eRmUxVqb7StCsGHmo7sgv5bmRGO4",
+	"ybcYyM0uwXA9O1fGu/91EGXSNIVL60w+tNrqKEHGDYwMSCPQAl9zfRLhUA/Tl+LObk7lE8erKMMkwiL4",
+	"Owvk+A1f5zkAvfAaIjXRgMJrN5nv1l124tbDiatR+aZ+4QtxQKcV0CDyP54DH802jLCDL83w6Rze0w0l",
+	"+5/PD/EoJNGH/awmwcNJPAV9pUy/hGj3MNPAcmFKfAlyJqRr5s3sXKtqNm8autWRpUwD5THyYqmdl4fC",
+	"5QCYOdd4c8ZQPxbArObS8MxlJ7hsZ0pNyeveVVw2cC21j7NLGTC8iWWhQkbQLuTV79xXXYTM4Y7+hB33",
+	"C90fuV+ufHe9q7rp3O7vxjsiv8JkFZet1epm4vIyGjhcRgyGSeo7gvphfiGpNUXKrgGwHJkit/aewmEX",
+	"8nV7dxjgMFjGKaw3JQzjPsEX93cwHlORxETdgMOWq/V0eEYoVIEREjysoSKTEmMuZN1ep0nE8Okcy1T3",
+	"LdHqmN7VSne8K+ce2/9y7nG4Uw3N3C5kqy4V8uX5WzDusB+BqkdpA2BCEgzldmrgC9P04C19R2TuBseM",
+	"om98X8QvIW06DQSf2BbqttrrMUx86zzeKsNMfTrzVd167opZRdWLy/33Wi33Hk8qnVaSGaAWQW0mEJLF",
+	"jn9LAzlCOsVD7NB/239GfBLrJei5APK6gbth3Pjsr9EZMhm1EsNIIs/m7Mq9f+UZMOOa0lDoor/VQzBM",
+	"RrzafEEg+Gfu21xoKGUuXRBPWC0jr/C7WiNadnT8NmQ1+cQ8v5bbMNUpttvMS4qeGZA5yoQr7dKuHKyO",
+	"mPfBaOM3XFDPQMbl/UJpaCU51Li4kLwo1G0rVayx5kjI+D/RHMzrbsrNBFj5fQ3sKsjLq9RJD6JHPAjr",
+	"aOWQvpoQMexrEw0trWK3JAlcPnBBtR2+V2fsswj1m2u/57Dlp13WtNNME2Pvi/ClHco+WPcJCipXdOQM",
+	"+Y31fTMlBNafe1jO7+3wVOerD5uz2TcnOli4s+4Qjhxtt3Ck8K2ny0qq5c46mdDwQBA2DkonbObUKW23",
+	"EDfQK3Goj1qQn7wsmWhK2CjL1qectk3/nUhu0A3QTFtnBoXPe/TRLnLh4oD0gD2mG7IUnulFTAvdZ765",
+	"ZxvfGnh+PxzhaH7VtSZMSIFGp08wJxIIlzp+jzLCZ1IHaaykWSXGKQ7+MtR4FAOg0w2wR/8HIuNWUmbU",
+	"ArpN93IoQeYgM1RqC+7CWLJWFcgVz8f7Twbya7YKGd1Zt2Cqr4s8L/n2foza+z0lF69hpj7OrpXGujTF",
+	"2nfxnUGEYRJmyorunX3nm28BKf6V5mNU5A+8OftvOgE/vsUefBcyrGCC4+KTLUnNpT79uvUZTXfH776W",
+	"WbcG9p4EJpXFiw/IH7qQt8CvGX6vJ2U8mF8hm3uBqhqJdzwd/agk+M/j+D359Pz98QGNxzsodP/6kipP",
+	"Go3876zKf2dVtlqT+LTKtDOT/xDi40x2N/KdebadjWYiawob/HTeXq4K+4Ss0OGf0kqfLoN0f3zQ90oj",
+	"nNufGqNI/ouniuQH/7AlfRVefpe+sc4jesKrKa1tr8IrkMAo7ZzWJQ+K30AY9YWuL5f6mj1tWL05Kr2h",
+	"1GgCaDdBulXkvZbFw7iHh8ejdCcSW9akWiVx20jYdb2cTH909syqcOviAsoyr+M8lEfsv7bbhOlTH2eW",
+	"s7rtV/ORYLcs+pfUvMapc/ztQrqKNzb7laKAO2RRuNGLyrj8ZUyVdnq7NlFompQ1PXLqT2+7f2WqqBbS",
+	"9Kc2d3vnrePtRVVYUXJtSa+MsG6oy2BL3ZZ9879YE6ICmh3UHeTaddUTIbm+/7w+b65lWA7WFebVF7y0",
+	"PNxZkPT1wPbHqAZ2gltpUFTEKoef9makS8dYghg96d6INA3UfkMn2QNaHxYiHKfzoLQ3sNlWx7vO+44m",
+	"BH0HdvUQfFlrqZ9KXzhC852vJgz9UMLlTfuj0X2KcSuDv/5etUvDjlJkt+mvNYgwR3VbpgGRs+1tPWQw",
+	"10CzEU6FkJCGbm2y/mjnlAFGrv09i1a3j0iit+pWYoNKWj+0QFymkbsTc+t/UXoNqZioQfPOrhub+/u0",
+	"hb9i2uLrrCm+ajD0Gs3AwYLdtjG2MZfLwxfgWknlera3mWiRj+A+Is0JshaVJ/fs+G0PYTdHNUIJAPod",
+	"xLYUqQ7GPvPfkVPaDI8XKH0hj6f1HB16bRNFaM70E4jZJ/Do2ojdqC25aXlcn+qnPaImGMZv2wmS5hvz",
+	"VCsT7xL6uvWVLH+Bj4nehbfPqH3o0qfomX+Hmo8y6j7qvnDx9f6rF1+hQKHHrQcvXo33vrqQtIgwjLip",
+	"+RJmSKv0qwlpLPBWoXJ4UH8zw98J0Y4YD7e2dRsK//GEIAPDaqKzi0cXhh9o1Sf2TNOVyUaEld9vN2/z",
+	"KZjlKRdI40+ac7VD7ROnDPxPkTyfofOerCDLHbWMS6koE2XpBJdNU+RHkonuNA0Si2gk/VJBhSYSOtoP",
+	"vg3dkqex9NUm19SLux53df/AFQ1JvRW/ZI4vzv9UzkfAHS26vT4hJNPnHQYEvBGvtQ3bR54tMltqOtWB",
+	"H+c1hdvtnrQW90U+mgUWwhp2RXP4Zj1hlqs65QR/pvyS0IHHfaulzohR0vqUsRqoVg29poZSPkGsnZfi",
+	"RvoPEfSmhQxjtg3ZCJ8WSP/fx1q61QFzgAioh/cIgdPm+VbFQP/TUbx15Wz6MTTD+2f4krnrWuce+XZ0",
+	"K8/wAprObywT6i3cQKHKBR4oNypJk0oXvgHf4e7ux7ky9uHwY6m0fcAicbM7U7wsd2+wP+gN1wJv04li",
+	"8zq67IlBbUEL+hmxqvTS45fj8RgP0k8P/38A72VpsauSAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
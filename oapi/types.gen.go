@@ -5,8 +5,10 @@ package oapi
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
@@ -16,15 +18,136 @@ const (
 	OpenIDScopes     = "OpenID.Scopes"
 )
 
+// Defines values for EventOperation.
+const (
+	DELETE EventOperation = "DELETE"
+	INSERT EventOperation = "INSERT"
+	RESET  EventOperation = "RESET"
+	UPDATE EventOperation = "UPDATE"
+)
+
+// Defines values for HealthCheckStatus.
+const (
+	HealthCheckStatusDown HealthCheckStatus = "down"
+	HealthCheckStatusUp   HealthCheckStatus = "up"
+)
+
+// Defines values for HealthReportStatus.
+const (
+	HealthReportStatusDegraded HealthReportStatus = "degraded"
+	HealthReportStatusDown     HealthReportStatus = "down"
+	HealthReportStatusUp       HealthReportStatus = "up"
+)
+
+// Defines values for JSONPatchOperationOp.
+const (
+	Add     JSONPatchOperationOp = "add"
+	Copy    JSONPatchOperationOp = "copy"
+	Move    JSONPatchOperationOp = "move"
+	Remove  JSONPatchOperationOp = "remove"
+	Replace JSONPatchOperationOp = "replace"
+	Test    JSONPatchOperationOp = "test"
+)
+
+// Defines values for ProfileImportFormat.
+const (
+	ProfileImportFormatCsv    ProfileImportFormat = "csv"
+	ProfileImportFormatNdjson ProfileImportFormat = "ndjson"
+)
+
+// Defines values for ProfileImportStatus.
+const (
+	ProfileImportStatusCompleted ProfileImportStatus = "completed"
+	ProfileImportStatusFailed    ProfileImportStatus = "failed"
+	ProfileImportStatusPending   ProfileImportStatus = "pending"
+	ProfileImportStatusRunning   ProfileImportStatus = "running"
+)
+
 // Defines values for TaskState.
 const (
-	Failed    TaskState = "failed"
-	Pending   TaskState = "pending"
-	Retry     TaskState = "retry"
-	Running   TaskState = "running"
-	Succeeded TaskState = "succeeded"
+	TaskStateFailed    TaskState = "failed"
+	TaskStatePending   TaskState = "pending"
+	TaskStateRetry     TaskState = "retry"
+	TaskStateRunning   TaskState = "running"
+	TaskStateSucceeded TaskState = "succeeded"
 )
 
+// Defines values for CreateProfileImportMultipartBodyFormat.
+const (
+	CreateProfileImportMultipartBodyFormatCsv    CreateProfileImportMultipartBodyFormat = "csv"
+	CreateProfileImportMultipartBodyFormatNdjson CreateProfileImportMultipartBodyFormat = "ndjson"
+)
+
+// ApiKey defines model for ApiKey.
+type ApiKey struct {
+	// CreatedAt The creation timestamp of the model.
+	CreatedAt string     `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// ID The unique identifier of the model.
+	ID int64 `json:"id"`
+
+	// LastUsedAt The last time the key was used, updated at most once per minute.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+	Owner      string     `json:"owner"`
+
+	// Prefix The start of the key, used to identify it.
+	Prefix    string     `json:"prefix"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	Scopes    []string   `json:"scopes"`
+
+	// UpdatedAt The last update timestamp of the model.
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ApiKeyCreated defines model for ApiKeyCreated.
+type ApiKeyCreated struct {
+	// CreatedAt The creation timestamp of the model.
+	CreatedAt string     `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// ID The unique identifier of the model.
+	ID int64 `json:"id"`
+
+	// Key The API key, it can't be retrieved again.
+	Key string `json:"key"`
+
+	// LastUsedAt The last time the key was used, updated at most once per minute.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+	Owner      string     `json:"owner"`
+
+	// Prefix The start of the key, used to identify it.
+	Prefix    string     `json:"prefix"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	Scopes    []string   `json:"scopes"`
+
+	// UpdatedAt The last update timestamp of the model.
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ApiKeyList defines model for ApiKeyList.
+type ApiKeyList struct {
+	Items      []ApiKey   `json:"items"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// ApiKeyRequest defines model for ApiKeyRequest.
+type ApiKeyRequest struct {
+	// ExpiresAt The key stops working after this time, it doesn't expire if empty.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Name A name to tell the keys apart.
+	Name string `json:"name"`
+
+	// Owner The principal that owns the key, the caller if empty. Only an admin can set another owner.
+	Owner *string `json:"owner,omitempty"`
+
+	// Scopes The permissions granted to the key.
+	Scopes *[]string `json:"scopes,omitempty"`
+}
+
 // AuthRequest defines model for AuthRequest.
 type AuthRequest struct {
 	// Password The password of the user.
@@ -34,6 +157,45 @@ type AuthRequest struct {
 	Username string `json:"username" validate:"required"`
 }
 
+// BatchOperation defines model for BatchOperation.
+type BatchOperation struct {
+	// Body JSON body of the operation, can reference previous results
+	Body *interface{} `json:"body,omitempty"`
+
+	// Headers Additional headers of the operation, e.g. If-Match
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Method One of GET, POST, PUT, PATCH or DELETE
+	Method string `json:"method"`
+
+	// Path Path relative to the base URL of the API, can reference previous results
+	Path string `json:"path"`
+}
+
+// BatchRequest defines model for BatchRequest.
+type BatchRequest struct {
+	// ContinueOnError Keep running the operations after a failure, only rolling back the failed ones.
+	ContinueOnError *bool            `json:"continue_on_error,omitempty"`
+	Operations      []BatchOperation `json:"operations"`
+}
+
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	// Committed True if the changes of the batch were saved
+	Committed bool `json:"committed"`
+
+	// Results The response of every operation, null for the ones skipped after a failure
+	Results []BatchResult `json:"results"`
+}
+
+// BatchResult defines model for BatchResult.
+type BatchResult struct {
+	// Body The JSON body of the response, other types are sent as a string
+	Body    *interface{}       `json:"body,omitempty"`
+	Headers *map[string]string `json:"headers,omitempty"`
+	Status  int                `json:"status"`
+}
+
 // DelayRequest defines model for DelayRequest.
 type DelayRequest struct {
 	// Delay Delay duration
@@ -55,6 +217,68 @@ type Error struct {
 	StatusCode string `json:"status_code"`
 }
 
+// Event defines model for Event.
+type Event struct {
+	CreatedAt  time.Time      `json:"created_at"`
+	Id         int64          `json:"id"`
+	Operation  EventOperation `json:"operation"`
+	Resource   *string        `json:"resource,omitempty"`
+	ResourceId *int64         `json:"resource_id,omitempty"`
+}
+
+// EventOperation defines model for Event.Operation.
+type EventOperation string
+
+// HealthCheck defines model for HealthCheck.
+type HealthCheck struct {
+	Critical bool `json:"critical"`
+
+	// Error Reason of the failure, only shown in verbose mode.
+	Error     *string           `json:"error,omitempty"`
+	LatencyMs float64           `json:"latency_ms"`
+	Name      string            `json:"name"`
+	Status    HealthCheckStatus `json:"status"`
+}
+
+// HealthCheckStatus defines model for HealthCheck.Status.
+type HealthCheckStatus string
+
+// HealthReport defines model for HealthReport.
+type HealthReport struct {
+	Checks       []HealthCheck `json:"checks"`
+	ShuttingDown bool          `json:"shutting_down"`
+
+	// Status Overall status, degraded means that only non-critical checks failed.
+	Status HealthReportStatus `json:"status"`
+}
+
+// HealthReportStatus Overall status, degraded means that only non-critical checks failed.
+type HealthReportStatus string
+
+// JSONPatch defines model for JSONPatch.
+type JSONPatch = []JSONPatchOperation
+
+// JSONPatchOperation defines model for JSONPatchOperation.
+type JSONPatchOperation struct {
+	// From JSON Pointer to the source field of the move and copy operations
+	From *string              `json:"from,omitempty"`
+	Op   JSONPatchOperationOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) to the modified field
+	Path string `json:"path"`
+
+	// Value The value of the add, replace and test operations
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// JSONPatchOperationOp defines model for JSONPatchOperation.Op.
+type JSONPatchOperationOp string
+
+// LogoutRequest defines model for LogoutRequest.
+type LogoutRequest struct {
+	RefreshToken *string `json:"refresh_token,omitempty"`
+}
+
 // Model defines model for Model.
 type Model struct {
 	// CreatedAt The creation timestamp of the model.
@@ -103,6 +327,12 @@ type PaginationType struct {
 	Type string `json:"type"`
 }
 
+// PasswordChangeRequest defines model for PasswordChangeRequest.
+type PasswordChangeRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
 // Profile defines model for Profile.
 type Profile struct {
 	// CreatedAt The creation timestamp of the model.
@@ -124,12 +354,64 @@ type Profile struct {
 	UpdatedAt string `json:"updated_at"`
 }
 
+// ProfileImport defines model for ProfileImport.
+type ProfileImport struct {
+	// CreatedAt The creation timestamp of the model.
+	CreatedAt string `json:"created_at"`
+
+	// Error The reason of the failure if the whole import failed.
+	Error *string `json:"error,omitempty"`
+
+	// Failed The number of rows that couldn't be imported, listed on the error report.
+	Failed int64 `json:"failed"`
+
+	// Filename The name of the uploaded file.
+	Filename string `json:"filename"`
+
+	// FinishedAt The time when the processing ended.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// Format The format of the uploaded file.
+	Format ProfileImportFormat `json:"format"`
+
+	// ID The unique identifier of the model.
+	ID int64 `json:"id"`
+
+	// Imported The number of profiles created or updated.
+	Imported int64 `json:"imported"`
+
+	// Processed The number of rows processed.
+	Processed int64 `json:"processed"`
+
+	// Status The processing status of the import.
+	Status ProfileImportStatus `json:"status"`
+
+	// Total The number of rows of the file, known once the processing starts.
+	Total int64 `json:"total"`
+
+	// UpdatedAt The last update timestamp of the model.
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ProfileImportFormat The format of the uploaded file.
+type ProfileImportFormat string
+
+// ProfileImportStatus The processing status of the import.
+type ProfileImportStatus string
+
 // ProfileList defines model for ProfileList.
 type ProfileList struct {
 	Items      []Profile  `json:"items"`
 	Pagination Pagination `json:"pagination"`
 }
 
+// ProfileMergePatch The fields to change, the missing ones are kept.
+type ProfileMergePatch struct {
+	Email     *string `json:"email,omitempty"`
+	FirstName *string `json:"first_name,omitempty"`
+	LastName  *string `json:"last_name,omitempty"`
+}
+
 // ProfileRequest defines model for ProfileRequest.
 type ProfileRequest struct {
 	// Email The email address of the profile owner.
@@ -142,6 +424,44 @@ type ProfileRequest struct {
 	LastName string `json:"last_name"`
 }
 
+// QueryStat defines model for QueryStat.
+type QueryStat struct {
+	Calls  int64   `json:"calls"`
+	Errors int64   `json:"errors"`
+	MaxMs  float64 `json:"max_ms"`
+	MeanMs float64 `json:"mean_ms"`
+	P95Ms  float64 `json:"p95_ms"`
+
+	// Rows Total count of rows returned or affected.
+	Rows int64 `json:"rows"`
+
+	// Statement The normalized SQL statement.
+	Statement string  `json:"statement"`
+	TotalMs   float64 `json:"total_ms"`
+}
+
+// QueryStatList defines model for QueryStatList.
+type QueryStatList struct {
+	Items []QueryStat `json:"items"`
+
+	// Since The time when the statistics started to be collected.
+	Since time.Time `json:"since"`
+}
+
+// RefreshRequest defines model for RefreshRequest.
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RegisterRequest defines model for RegisterRequest.
+type RegisterRequest struct {
+	// Password The password of the user.
+	Password string `json:"password"`
+
+	// Username The username of the user.
+	Username string `json:"username"`
+}
+
 // Task defines model for Task.
 type Task struct {
 	Error *struct {
@@ -149,6 +469,7 @@ type Task struct {
 		Details *map[string]interface{} `json:"details,omitempty"`
 		Message string                  `json:"message"`
 	} `json:"error,omitempty"`
+	Progress *TaskProgress `json:"progress,omitempty"`
 
 	// State Task status
 	State TaskState `json:"state"`
@@ -169,15 +490,50 @@ type TaskCreationResponse struct {
 	TaskId string `json:"task_id"`
 }
 
+// TaskProgress defines model for TaskProgress.
+type TaskProgress struct {
+	// Current Units of work completed
+	Current int64 `json:"current"`
+
+	// Message Description of the current step
+	Message *string `json:"message,omitempty"`
+
+	// Total Total units of work
+	Total int64 `json:"total"`
+}
+
 // Token defines model for Token.
 type Token struct {
+	// ExpiresAt The time when the JWT token expires.
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// RefreshToken An opaque token used once to obtain new tokens.
+	RefreshToken string `json:"refresh_token"`
+
 	// Token The JWT token for authentication.
 	Token string `json:"token"`
 }
 
+// User defines model for User.
+type User struct {
+	// CreatedAt The creation timestamp of the model.
+	CreatedAt string `json:"created_at"`
+
+	// ID The unique identifier of the model.
+	ID          int64      `json:"id"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+
+	// UpdatedAt The last update timestamp of the model.
+	UpdatedAt string `json:"updated_at"`
+	Username  string `json:"username"`
+}
+
 // After defines model for after.
 type After = string
 
+// ApiKeyId defines model for apiKeyId.
+type ApiKeyId = int64
+
 // Before defines model for before.
 type Before = string
 
@@ -187,6 +543,12 @@ type Fields = string
 // Filters defines model for filters.
 type Filters map[string]string
 
+// Format defines model for format.
+type Format = string
+
+// ImportId defines model for importId.
+type ImportId = int64
+
 // Includes defines model for includes.
 type Includes = []string
 
@@ -214,9 +576,60 @@ type TaskId = string
 // Verbose defines model for verbose.
 type Verbose = bool
 
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = Error
+
 // UnexpectedError defines model for UnexpectedError.
 type UnexpectedError = Error
 
+// ListQueryStatsParams defines parameters for ListQueryStats.
+type ListQueryStatsParams struct {
+	// Limit The maximum number of items to return.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListApiKeysParams defines parameters for ListApiKeys.
+type ListApiKeysParams struct {
+	// Before The cursor for retrieving the previous page.
+	Before *Before `form:"before,omitempty" json:"before,omitempty"`
+
+	// After The cursor for retrieving the next page.
+	After *After `form:"after,omitempty" json:"after,omitempty"`
+
+	// Page The page number to retrieve.
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// Q The default query parameter.
+	Q *Query `form:"q,omitempty" json:"q,omitempty"`
+
+	// Limit The maximum number of items to return.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Includes Additional relationships to include.
+	Includes *Includes `form:"includes,omitempty" json:"includes,omitempty"`
+
+	// Filters Additional filters for querying.
+	Filters *Filters `form:"filters,omitempty" json:"filters,omitempty"`
+
+	// Fields Comma-separated list of fields to return in the response. Not implemented yet.
+	Fields *Fields `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Sort Comma-separated list of fields to specify the sort order. Use + or - as a prefix. Not implemented yet.
+	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Format The format of the response, overrides the Accept header. One of json, ndjson, csv or msgpack.
+	Format *Format `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	// Resources Comma-separated list of resources to watch.
+	Resources []string `form:"resources" json:"resources"`
+
+	// LastEventID The ID of the last event received by the client.
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
 // LiveCheckParams defines parameters for LiveCheck.
 type LiveCheckParams struct {
 	// Verbose Flag to enable verbose response.
@@ -257,20 +670,62 @@ type ListProfilesParams struct {
 
 	// Sort Comma-separated list of fields to specify the sort order. Use + or - as a prefix. Not implemented yet.
 	Sort *Sort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Format The format of the response, overrides the Accept header. One of json, ndjson, csv or msgpack.
+	Format *Format `form:"format,omitempty" json:"format,omitempty"`
 }
 
+// CreateProfileImportMultipartBody defines parameters for CreateProfileImport.
+type CreateProfileImportMultipartBody struct {
+	// File The file with the profiles.
+	File openapi_types.File `json:"file"`
+
+	// Format The format of the file, detected from the file extension if empty.
+	Format *CreateProfileImportMultipartBodyFormat `json:"format,omitempty"`
+}
+
+// CreateProfileImportMultipartBodyFormat defines parameters for CreateProfileImport.
+type CreateProfileImportMultipartBodyFormat string
+
+// CreateApiKeyJSONRequestBody defines body for CreateApiKey for application/json ContentType.
+type CreateApiKeyJSONRequestBody = ApiKeyRequest
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = AuthRequest
 
+// LogoutJSONRequestBody defines body for Logout for application/json ContentType.
+type LogoutJSONRequestBody = LogoutRequest
+
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = PasswordChangeRequest
+
+// RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
+type RefreshTokenJSONRequestBody = RefreshRequest
+
+// RegisterJSONRequestBody defines body for Register for application/json ContentType.
+type RegisterJSONRequestBody = RegisterRequest
+
 // ProcessBackgroundJSONRequestBody defines body for ProcessBackground for application/json ContentType.
 type ProcessBackgroundJSONRequestBody = DelayRequest
 
+// BatchJSONRequestBody defines body for Batch for application/json ContentType.
+type BatchJSONRequestBody = BatchRequest
+
 // SaveProfileJSONRequestBody defines body for SaveProfile for application/json ContentType.
 type SaveProfileJSONRequestBody = ProfileRequest
 
+// CreateProfileImportMultipartRequestBody defines body for CreateProfileImport for multipart/form-data ContentType.
+type CreateProfileImportMultipartRequestBody CreateProfileImportMultipartBody
+
 // UpdateProfileJSONRequestBody defines body for UpdateProfile for application/json ContentType.
 type UpdateProfileJSONRequestBody = ProfileRequest
 
+// UpdateProfileApplicationJSONPatchPlusJSONRequestBody defines body for UpdateProfile for application/json-patch+json ContentType.
+type UpdateProfileApplicationJSONPatchPlusJSONRequestBody = JSONPatch
+
+// UpdateProfileApplicationMergePatchPlusJSONRequestBody defines body for UpdateProfile for application/merge-patch+json ContentType.
+type UpdateProfileApplicationMergePatchPlusJSONRequestBody = ProfileMergePatch
+
 // AsPaginationCursor returns the union data inside the Pagination as a PaginationCursor
 func (t Pagination) AsPaginationCursor() (PaginationCursor, error) {
 	var body PaginationCursor
@@ -0,0 +1,64 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+// Package apikey generates the API keys, in the form sk_<prefix>_<secret>. The prefix identifies the key on the
+// database and can be shown to the users, only a hash of the whole key is stored.
+package apikey
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.megpoid.dev/go-skel/pkg/crypto"
+)
+
+const (
+	keyType    = "sk"
+	prefixSize = 6
+	secretSize = 32
+)
+
+var ErrInvalidKey = errors.New("invalid API key")
+
+// Key is a generated API key, the value must be shown only once to the owner.
+type Key struct {
+	// Prefix identifies the key, it includes the key type
+	Prefix string
+	Value  string
+}
+
+// Generate returns a new random key.
+func Generate() (*Key, error) {
+	id, err := crypto.GenerateRandomKey(prefixSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key prefix: %w", err)
+	}
+
+	secret, err := crypto.GenerateRandomKey(secretSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key secret: %w", err)
+	}
+
+	prefix := keyType + "_" + hex.EncodeToString(id)
+	return &Key{
+		Prefix: prefix,
+		Value:  prefix + "_" + base64.RawURLEncoding.EncodeToString(secret),
+	}, nil
+}
+
+// Prefix returns the prefix of the key, without checking the secret.
+func Prefix(key string) (string, error) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != keyType || len(parts[1]) != prefixSize*2 || parts[2] == "" {
+		return "", ErrInvalidKey
+	}
+	if _, err := hex.DecodeString(parts[1]); err != nil {
+		return "", ErrInvalidKey
+	}
+
+	return parts[0] + "_" + parts[1], nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package apikey
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	key, err := Generate()
+	require.NoError(t, err)
+	assert.Regexp(t, "^sk_[0-9a-f]{12}$", key.Prefix)
+	assert.True(t, strings.HasPrefix(key.Value, key.Prefix+"_"))
+
+	prefix, err := Prefix(key.Value)
+	require.NoError(t, err)
+	assert.Equal(t, key.Prefix, prefix)
+
+	other, err := Generate()
+	require.NoError(t, err)
+	assert.NotEqual(t, key.Value, other.Value)
+}
+
+func TestPrefixInvalid(t *testing.T) {
+	for _, key := range []string{"", "secret", "sk_", "sk_0123456789ab", "sk_0123456789ab_", "pk_0123456789ab_secret",
+		"sk_0123_secret", "sk_0123456789zz_secret"} {
+		_, err := Prefix(key)
+		assert.ErrorIs(t, err, ErrInvalidKey, key)
+	}
+
+	prefix, err := Prefix("sk_0123456789ab_sec_ret")
+	require.NoError(t, err)
+	assert.Equal(t, "sk_0123456789ab", prefix)
+}
@@ -52,6 +52,7 @@ func createAppError(message string, err error, skip int) *Error {
 		var authnErr *authnError
 		var authzErr *authzError
 		var validationErr *validationError
+		var unprocessableErr *unprocessableError
 
 		appErr.DetailedError = err.Error()
 		switch {
@@ -79,6 +80,9 @@ func createAppError(message string, err error, skip int) *Error {
 		case errors.As(err, &authzErr):
 			appErr.StatusCode = http.StatusForbidden
 			appErr.DetailedError = authzErr.Error()
+		case errors.As(err, &unprocessableErr):
+			appErr.StatusCode = http.StatusUnprocessableEntity
+			appErr.DetailedError = unprocessableErr.Error()
 		default:
 			appErr.StatusCode = http.StatusInternalServerError
 		}
@@ -137,3 +141,20 @@ func (e *authzError) Error() string {
 func NewAuthzError(message string, err error) *Error {
 	return createAppError(message, &authzError{err: err}, 4)
 }
+
+type unprocessableError struct {
+	err error
+}
+
+func (e *unprocessableError) Error() string {
+	if e.err != nil {
+		return e.err.Error()
+	}
+	return ""
+}
+
+// NewUnprocessableError is used when the request is well-formed but can't be applied to the current state of the
+// resource, e.g. a patch that references a missing field.
+func NewUnprocessableError(message string, err error) *Error {
+	return createAppError(message, &unprocessableError{err: err}, 4)
+}
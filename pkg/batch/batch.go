@@ -0,0 +1,309 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+// Package batch runs a list of sub-requests through an HTTP handler, the later ones can reference the responses
+// of the earlier ones.
+package batch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidOperation = errors.New("invalid operation")
+	ErrInvalidReference = errors.New("invalid reference")
+)
+
+// referencePattern matches a reference to a field of a previous response, e.g. $0.id or $1.items.0.email.
+var referencePattern = regexp.MustCompile(`\$(\d+)((?:\.[A-Za-z0-9_-]+)+)`)
+
+// forwardedHeaders are copied from the batch request to every operation, so they share the credentials and
+// the language.
+var forwardedHeaders = []string{"Authorization", "X-API-Key", "Cookie", "Accept-Language"}
+
+// clientHeaders identify the client behind a proxy, they are always the ones of the batch request so an operation
+// can't pick its client IP.
+var clientHeaders = []string{"X-Forwarded-For", "X-Real-IP"}
+
+// Operation is a sub-request of the batch, the path is relative to the base URL of the API.
+type Operation struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// Result is the response of an operation, the bodies that aren't JSON are sent as a string.
+type Result struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// Failed returns true if the operation didn't succeed, or wasn't run.
+func (r *Result) Failed() bool {
+	return r == nil || r.Status >= http.StatusBadRequest
+}
+
+// Resolve replaces the references to the previous results on the path and the body of the operation. A JSON
+// string that only has a reference is replaced by the referenced value, keeping its type.
+func Resolve(op Operation, results []*Result) (Operation, error) {
+	var resolveErr error
+	lookup := func(match string) (any, bool) {
+		groups := referencePattern.FindStringSubmatch(match)
+		value, err := reference(results, groups[1], strings.Split(groups[2][1:], "."))
+		if err != nil {
+			resolveErr = err
+			return nil, false
+		}
+		return value, true
+	}
+
+	op.Path = referencePattern.ReplaceAllStringFunc(op.Path, func(match string) string {
+		value, ok := lookup(match)
+		if !ok {
+			return match
+		}
+		return url.PathEscape(format(value))
+	})
+	if resolveErr != nil {
+		return op, resolveErr
+	}
+
+	if len(op.Body) == 0 || !referencePattern.Match(op.Body) {
+		return op, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(op.Body))
+	decoder.UseNumber()
+	var body any
+	if err := decoder.Decode(&body); err != nil {
+		return op, fmt.Errorf("%w: %w", ErrInvalidOperation, err)
+	}
+
+	var replace func(value any) any
+	replace = func(value any) any {
+		switch v := value.(type) {
+		case map[string]any:
+			for key, item := range v {
+				v[key] = replace(item)
+			}
+		case []any:
+			for i, item := range v {
+				v[i] = replace(item)
+			}
+		case string:
+			if referencePattern.FindString(v) == v {
+				if resolved, ok := lookup(v); ok {
+					return resolved
+				}
+				return v
+			}
+			return referencePattern.ReplaceAllStringFunc(v, func(match string) string {
+				if resolved, ok := lookup(match); ok {
+					return format(resolved)
+				}
+				return match
+			})
+		}
+		return value
+	}
+
+	body = replace(body)
+	if resolveErr != nil {
+		return op, resolveErr
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return op, err
+	}
+	op.Body = data
+
+	return op, nil
+}
+
+// reference returns the field of the body of a previous result.
+func reference(results []*Result, index string, fields []string) (any, error) {
+	i, err := strconv.Atoi(index)
+	if err != nil || i >= len(results) {
+		return nil, fmt.Errorf("%w: $%s references an operation that didn't run yet", ErrInvalidReference, index)
+	}
+	if results[i].Failed() || len(results[i].Body) == 0 {
+		return nil, fmt.Errorf("%w: $%s references an operation without result", ErrInvalidReference, index)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(results[i].Body))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("%w: $%s: %w", ErrInvalidReference, index, err)
+	}
+
+	for _, field := range fields {
+		switch v := value.(type) {
+		case map[string]any:
+			var ok bool
+			if value, ok = v[field]; !ok {
+				return nil, fmt.Errorf("%w: $%s has no field %q", ErrInvalidReference, index, field)
+			}
+		case []any:
+			n, err := strconv.Atoi(field)
+			if err != nil || n < 0 || n >= len(v) {
+				return nil, fmt.Errorf("%w: $%s has no item %q", ErrInvalidReference, index, field)
+			}
+			value = v[n]
+		default:
+			return nil, fmt.Errorf("%w: $%s has no field %q", ErrInvalidReference, index, field)
+		}
+	}
+
+	return value, nil
+}
+
+// format returns the text of a referenced value, used when it's part of a string.
+func format(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+// Dispatcher runs the operations through the handler, usually the router of the server so the operations go
+// through the same middlewares as the other requests.
+type Dispatcher struct {
+	handler http.Handler
+	baseURL string
+}
+
+func NewDispatcher(handler http.Handler, baseURL string) *Dispatcher {
+	return &Dispatcher{handler: handler, baseURL: baseURL}
+}
+
+// Request builds the sub-request of the operation, with the credentials of the parent request.
+func (d *Dispatcher) Request(ctx context.Context, parent *http.Request, op Operation) (*http.Request, error) {
+	method := strings.ToUpper(op.Method)
+	switch method {
+	case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return nil, fmt.Errorf("%w: unsupported method %q", ErrInvalidOperation, op.Method)
+	}
+
+	target, err := url.Parse(op.Path)
+	if err != nil || !strings.HasPrefix(op.Path, "/") || target.Host != "" {
+		return nil, fmt.Errorf("%w: invalid path %q", ErrInvalidOperation, op.Path)
+	}
+
+	var body io.Reader = http.NoBody
+	hasBody := len(op.Body) > 0 && !bytes.Equal(op.Body, []byte("null"))
+	if hasBody {
+		body = bytes.NewReader(op.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, d.baseURL+op.Path, body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOperation, err)
+	}
+
+	req.Host = parent.Host
+	req.RemoteAddr = parent.RemoteAddr
+	for _, key := range forwardedHeaders {
+		for _, value := range parent.Header.Values(key) {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Accept", "application/json")
+	if hasBody {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range op.Headers {
+		req.Header.Set(key, value)
+	}
+	for _, key := range clientHeaders {
+		req.Header.Del(key)
+		for _, value := range parent.Header.Values(key) {
+			req.Header.Add(key, value)
+		}
+	}
+
+	return req, nil
+}
+
+// Dispatch runs the request and returns its response.
+func (d *Dispatcher) Dispatch(req *http.Request) *Result {
+	rec := newRecorder()
+	d.handler.ServeHTTP(rec, req)
+
+	result := &Result{
+		Status:  rec.status,
+		Headers: make(map[string]string, len(rec.header)),
+	}
+	for key := range rec.header {
+		result.Headers[key] = rec.header.Get(key)
+	}
+
+	data := bytes.TrimSpace(rec.body.Bytes())
+	switch {
+	case len(data) == 0:
+	case isJSON(rec.header.Get("Content-Type")) && json.Valid(data):
+		result.Body = data
+	default:
+		result.Body, _ = json.Marshal(string(data))
+	}
+
+	return result
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// recorder keeps the response of an operation in memory.
+type recorder struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func newRecorder() *recorder {
+	return &recorder{header: http.Header{}, status: http.StatusOK}
+}
+
+func (r *recorder) Header() http.Header {
+	return r.header
+}
+
+func (r *recorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+	r.status = status
+	r.wroteHeader = true
+}
+
+func (r *recorder) Write(data []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(data)
+}
+
+// Flush is required by the handlers that stream the response.
+func (r *recorder) Flush() {}
@@ -0,0 +1,126 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	results := []*Result{
+		{Status: http.StatusCreated, Body: json.RawMessage(`{"id":42,"email":"john@example.com","tags":["a","b"]}`)},
+		{Status: http.StatusNotFound, Body: json.RawMessage(`{"message":"not found"}`)},
+	}
+
+	op, err := Resolve(Operation{
+		Method: http.MethodPatch,
+		Path:   "/profiles/$0.id",
+		Body:   json.RawMessage(`{"owner_id":"$0.id","note":"created by $0.email","tag":"$0.tags.1","price":"$5"}`),
+	}, results)
+	require.NoError(t, err)
+	assert.Equal(t, "/profiles/42", op.Path)
+	assert.JSONEq(t, `{"owner_id":42,"note":"created by john@example.com","tag":"b","price":"$5"}`, string(op.Body))
+
+	invalid := []string{"/profiles/$1.id", "/profiles/$2.id", "/profiles/$0.name", "/profiles/$0.tags.5"}
+	for _, path := range invalid {
+		_, err := Resolve(Operation{Path: path}, results)
+		assert.ErrorIs(t, err, ErrInvalidReference, path)
+	}
+
+	_, err = Resolve(Operation{Path: "/profiles", Body: json.RawMessage(`{"id":"$3.id"}`)}, results)
+	assert.ErrorIs(t, err, ErrInvalidReference)
+}
+
+func TestDispatch(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "/api/profiles", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, `"1-1"`, r.Header.Get("If-Match"))
+		assert.Empty(t, r.Header.Get("Accept-Encoding"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.JSONEq(t, `{"name":"John"}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Location", "/api/profiles/1")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":1}` + "\n"))
+	})
+
+	parent := httptest.NewRequest(http.MethodPost, "/api/batch", nil)
+	parent.Header.Set("Authorization", "Bearer token")
+	parent.Header.Set("Accept-Encoding", "gzip")
+
+	d := NewDispatcher(handler, "/api")
+	req, err := d.Request(context.Background(), parent, Operation{
+		Method:  "post",
+		Path:    "/profiles",
+		Headers: map[string]string{"If-Match": `"1-1"`},
+		Body:    json.RawMessage(`{"name":"John"}`),
+	})
+	require.NoError(t, err)
+
+	result := d.Dispatch(req)
+	assert.Equal(t, http.StatusCreated, result.Status)
+	assert.False(t, result.Failed())
+	assert.Equal(t, "/api/profiles/1", result.Headers["Location"])
+	assert.JSONEq(t, `{"id":1}`, string(result.Body))
+}
+
+func TestDispatchText(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		_, _ = w.Write([]byte("id\n1\n"))
+	})
+
+	d := NewDispatcher(handler, "")
+	req, err := d.Request(context.Background(), httptest.NewRequest(http.MethodPost, "/batch", nil), Operation{
+		Method: http.MethodGet,
+		Path:   "/profiles",
+	})
+	require.NoError(t, err)
+
+	result := d.Dispatch(req)
+	assert.Equal(t, http.StatusOK, result.Status)
+	assert.JSONEq(t, `"id\n1"`, string(result.Body))
+}
+
+func TestRequestInvalid(t *testing.T) {
+	parent := httptest.NewRequest(http.MethodPost, "/batch", nil)
+	d := NewDispatcher(http.NotFoundHandler(), "")
+
+	for _, op := range []Operation{
+		{Method: "TRACE", Path: "/profiles"},
+		{Method: http.MethodGet, Path: "profiles"},
+		{Method: http.MethodGet, Path: "//example.com/profiles"},
+	} {
+		_, err := d.Request(context.Background(), parent, op)
+		assert.ErrorIs(t, err, ErrInvalidOperation, op.Path)
+	}
+}
+
+func TestRequestClientHeaders(t *testing.T) {
+	parent := httptest.NewRequest(http.MethodPost, "/batch", nil)
+	parent.Header.Set("X-Forwarded-For", "198.51.100.7")
+	d := NewDispatcher(http.NotFoundHandler(), "")
+
+	// the operation can't replace the client IP of the batch request
+	req, err := d.Request(context.Background(), parent, Operation{
+		Method:  http.MethodGet,
+		Path:    "/profiles",
+		Headers: map[string]string{"X-Forwarded-For": "203.0.113.1", "X-Real-IP": "203.0.113.2"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"198.51.100.7"}, req.Header.Values("X-Forwarded-For"))
+	assert.Empty(t, req.Header.Values("X-Real-IP"))
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+// Package conditional implements the validators and conditional requests of RFC 9110, section 13.
+package conditional
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderETag              = "ETag"
+	HeaderIfMatch           = "If-Match"
+	HeaderIfNoneMatch       = "If-None-Match"
+	HeaderIfModifiedSince   = "If-Modified-Since"
+	HeaderIfUnmodifiedSince = "If-Unmodified-Since"
+)
+
+// ErrPreconditionFailed is returned when the If-Match or If-Unmodified-Since headers don't match the resource.
+var ErrPreconditionFailed = echo.NewHTTPError(http.StatusPreconditionFailed, "the resource was modified")
+
+// ETag returns a strong entity tag for the version of a resource. The time is truncated to the precision of the
+// database, so the tag is the same before and after a round trip.
+func ETag(id int64, updatedAt time.Time) string {
+	version := updatedAt.UTC().Truncate(time.Microsecond).UnixMicro()
+	return `"` + strconv.FormatInt(id, 36) + "-" + strconv.FormatInt(version, 36) + `"`
+}
+
+// WeakETag returns a weak entity tag from the hash of the representation.
+func WeakETag(data ...[]byte) string {
+	hash := sha256.New()
+	for _, d := range data {
+		hash.Write(d)
+	}
+	return `W/"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+// SetValidators adds the ETag and Last-Modified headers to the response, the empty ones are skipped.
+func SetValidators(ctx echo.Context, etag string, lastModified time.Time) {
+	header := ctx.Response().Header()
+	if etag != "" {
+		header.Set(HeaderETag, etag)
+	}
+	if !lastModified.IsZero() {
+		header.Set(echo.HeaderLastModified, lastModified.UTC().Format(http.TimeFormat))
+	}
+}
+
+// NotModified sets the validators of the response and returns true if the client already has the current
+// representation. If-None-Match takes precedence over If-Modified-Since.
+func NotModified(ctx echo.Context, etag string, lastModified time.Time) bool {
+	SetValidators(ctx, etag, lastModified)
+
+	req := ctx.Request()
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+
+	if inm := req.Header.Get(HeaderIfNoneMatch); inm != "" {
+		return etag != "" && matchAny(inm, etag, false)
+	}
+
+	if ims := req.Header.Get(HeaderIfModifiedSince); ims != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ims)
+		return err == nil && !lastModified.Truncate(time.Second).After(since)
+	}
+
+	return false
+}
+
+// HasPreconditions returns true if the request has a precondition that must be checked before modifying the
+// resource.
+func HasPreconditions(req *http.Request) bool {
+	return req.Header.Get(HeaderIfMatch) != "" || req.Header.Get(HeaderIfUnmodifiedSince) != ""
+}
+
+// CheckPreconditions returns ErrPreconditionFailed if the If-Match or If-Unmodified-Since headers don't match
+// the current version of the resource. If-Match takes precedence over If-Unmodified-Since.
+func CheckPreconditions(req *http.Request, etag string, lastModified time.Time) error {
+	if im := req.Header.Get(HeaderIfMatch); im != "" {
+		if !matchAny(im, etag, true) {
+			return ErrPreconditionFailed
+		}
+		return nil
+	}
+
+	if ius := req.Header.Get(HeaderIfUnmodifiedSince); ius != "" {
+		since, err := http.ParseTime(ius)
+		// an invalid date is ignored
+		if err == nil && lastModified.Truncate(time.Second).After(since) {
+			return ErrPreconditionFailed
+		}
+	}
+
+	return nil
+}
+
+// matchAny checks the entity tag against the list of the header. The strong comparison is used by If-Match,
+// so weak tags never match.
+func matchAny(header, etag string, strong bool) bool {
+	if strings.TrimSpace(header) == "*" {
+		return true
+	}
+
+	if strong && isWeak(etag) {
+		return false
+	}
+
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if strong && isWeak(candidate) {
+			continue
+		}
+		if opaqueTag(candidate) == opaqueTag(etag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isWeak(etag string) bool {
+	return strings.HasPrefix(etag, "W/")
+}
+
+func opaqueTag(etag string) string {
+	return strings.TrimPrefix(etag, "W/")
+}
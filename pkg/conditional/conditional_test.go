@@ -0,0 +1,95 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package conditional
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var modified = time.Date(2023, 1, 2, 3, 4, 5, 123456789, time.UTC)
+
+func newContext(method string, headers map[string]string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/", nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	rec := httptest.NewRecorder()
+	return echo.New().NewContext(req, rec), rec
+}
+
+func TestETag(t *testing.T) {
+	etag := ETag(1, modified)
+	assert.Equal(t, etag, ETag(1, modified.Truncate(time.Microsecond)))
+	assert.NotEqual(t, etag, ETag(2, modified))
+	assert.NotEqual(t, etag, ETag(1, modified.Add(time.Microsecond)))
+
+	assert.Equal(t, WeakETag([]byte("a")), WeakETag([]byte("a")))
+	assert.NotEqual(t, WeakETag([]byte("a")), WeakETag([]byte("b")))
+	assert.Regexp(t, `^W/".+"$`, WeakETag([]byte("a")))
+}
+
+func TestNotModified(t *testing.T) {
+	etag := ETag(1, modified)
+
+	tests := []struct {
+		headers     map[string]string
+		notModified bool
+	}{
+		{nil, false},
+		{map[string]string{HeaderIfNoneMatch: etag}, true},
+		{map[string]string{HeaderIfNoneMatch: `"other", W/` + etag}, true},
+		{map[string]string{HeaderIfNoneMatch: `"other"`}, false},
+		{map[string]string{HeaderIfNoneMatch: "*"}, true},
+		{map[string]string{HeaderIfModifiedSince: modified.Format(http.TimeFormat)}, true},
+		{map[string]string{HeaderIfModifiedSince: modified.Add(-time.Second).Format(http.TimeFormat)}, false},
+		// If-None-Match takes precedence
+		{map[string]string{HeaderIfNoneMatch: `"other"`, HeaderIfModifiedSince: modified.Format(http.TimeFormat)}, false},
+	}
+
+	for _, test := range tests {
+		ctx, rec := newContext(http.MethodGet, test.headers)
+		assert.Equal(t, test.notModified, NotModified(ctx, etag, modified), test.headers)
+		assert.Equal(t, etag, rec.Header().Get(HeaderETag))
+		assert.Equal(t, "Mon, 02 Jan 2023 03:04:05 GMT", rec.Header().Get(echo.HeaderLastModified))
+	}
+
+	ctx, _ := newContext(http.MethodPost, map[string]string{HeaderIfNoneMatch: etag})
+	assert.False(t, NotModified(ctx, etag, modified))
+}
+
+func TestCheckPreconditions(t *testing.T) {
+	etag := ETag(1, modified)
+
+	tests := []struct {
+		headers map[string]string
+		err     error
+	}{
+		{nil, nil},
+		{map[string]string{HeaderIfMatch: etag}, nil},
+		{map[string]string{HeaderIfMatch: `"other", ` + etag}, nil},
+		{map[string]string{HeaderIfMatch: "*"}, nil},
+		{map[string]string{HeaderIfMatch: `"other"`}, ErrPreconditionFailed},
+		// weak tags never match on If-Match
+		{map[string]string{HeaderIfMatch: "W/" + etag}, ErrPreconditionFailed},
+		{map[string]string{HeaderIfUnmodifiedSince: modified.Format(http.TimeFormat)}, nil},
+		{map[string]string{HeaderIfUnmodifiedSince: modified.Add(-time.Second).Format(http.TimeFormat)}, ErrPreconditionFailed},
+		{map[string]string{HeaderIfUnmodifiedSince: "invalid"}, nil},
+	}
+
+	for _, test := range tests {
+		req := httptest.NewRequest(http.MethodPatch, "/", nil)
+		for key, value := range test.headers {
+			req.Header.Set(key, value)
+		}
+		assert.Equal(t, len(test.headers) > 0, HasPreconditions(req))
+		assert.ErrorIs(t, CheckPreconditions(req, etag, modified), test.err, test.headers)
+	}
+}
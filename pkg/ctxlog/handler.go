@@ -7,6 +7,8 @@ package ctxlog
 import (
 	"context"
 	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 var _ slog.Handler = Handler{}
@@ -25,6 +27,15 @@ func (h Handler) Enabled(ctx context.Context, level slog.Level) bool {
 
 func (h Handler) Handle(ctx context.Context, record slog.Record) error {
 	record.AddAttrs(GetContextAttrs(ctx)...)
+
+	// correlate the record with the active span, if any
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", span.TraceID().String()),
+			slog.String("span_id", span.SpanID().String()),
+		)
+	}
+
 	return h.handler.Handle(ctx, record)
 }
 
@@ -33,5 +44,5 @@ func (h Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
 }
 
 func (h Handler) WithGroup(name string) slog.Handler {
-	return h.handler.WithGroup(name)
+	return Handler{h.handler.WithGroup(name)}
 }
@@ -10,6 +10,8 @@ import (
 	"encoding/json"
 	"log/slog"
 	"testing"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestNewContextHandler(t *testing.T) {
@@ -57,3 +59,24 @@ func TestNewContextHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlerTraceContext(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID})
+
+	buf := bytes.Buffer{}
+	log := slog.New(NewHandler(slog.NewJSONHandler(&buf, nil)))
+	log.InfoContext(trace.ContextWithSpanContext(context.Background(), spanCtx), "message")
+
+	actual := make(map[string]any)
+	if err := json.Unmarshal(buf.Bytes(), &actual); err != nil {
+		t.Fatalf("failed to unmarshal json: %v", err)
+	}
+	if actual["trace_id"] != traceID.String() {
+		t.Errorf("expected trace_id to be %q, got %v", traceID.String(), actual["trace_id"])
+	}
+	if actual["span_id"] != spanID.String() {
+		t.Errorf("expected span_id to be %q, got %v", spanID.String(), actual["span_id"])
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultTimeout is used on the checks registered without a timeout.
+const DefaultTimeout = 5 * time.Second
+
+// ErrShuttingDown is reported when the readiness is checked after the shutdown started.
+var ErrShuttingDown = errors.New("shutting down")
+
+type Status string
+
+const (
+	// StatusUp means that all the checks passed.
+	StatusUp Status = "up"
+	// StatusDegraded means that only non-critical checks failed.
+	StatusDegraded Status = "degraded"
+	// StatusDown means that a critical check failed or the app is shutting down.
+	StatusDown Status = "down"
+)
+
+// CheckFunc returns an error if the dependency isn't available.
+type CheckFunc func(ctx context.Context) error
+
+// Check represents a dependency health check.
+type Check struct {
+	Name     string        // Name of the check shown on the report
+	Check    CheckFunc     // Function that checks the dependency
+	Timeout  time.Duration // Max time allowed for the check, uses DefaultTimeout if zero
+	Critical bool          // The app isn't ready if a critical check fails
+}
+
+// Result is the outcome of a single check.
+type Result struct {
+	Name     string
+	Status   Status
+	Critical bool
+	Latency  time.Duration
+	Err      error
+}
+
+// Report is the outcome of all the registered checks.
+type Report struct {
+	Status       Status
+	ShuttingDown bool
+	Checks       []Result
+}
+
+// Ready returns true if the app can accept requests.
+func (r *Report) Ready() bool {
+	return r.Status != StatusDown
+}
+
+// Registry holds the health checks of the app components.
+type Registry struct {
+	mu           sync.RWMutex
+	checks       []Check
+	shuttingDown atomic.Bool
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds a check to the registry. The checks are reported in registration order.
+func (r *Registry) Register(check Check) {
+	if check.Timeout <= 0 {
+		check.Timeout = DefaultTimeout
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks = append(r.checks, check)
+}
+
+// Shutdown marks the app as shutting down, so the following reports are always down.
+func (r *Registry) Shutdown() {
+	r.shuttingDown.Store(true)
+}
+
+// Run executes all the checks concurrently and returns the report.
+func (r *Registry) Run(ctx context.Context) *Report {
+	r.mu.RLock()
+	checks := make([]Check, len(r.checks))
+	copy(checks, r.checks)
+	r.mu.RUnlock()
+
+	report := &Report{
+		Status: StatusUp,
+		Checks: make([]Result, len(checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Checks[i] = run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	for _, result := range report.Checks {
+		if result.Status == StatusUp {
+			continue
+		}
+		if result.Critical {
+			report.Status = StatusDown
+		} else if report.Status == StatusUp {
+			report.Status = StatusDegraded
+		}
+	}
+
+	if r.shuttingDown.Load() {
+		report.Status = StatusDown
+		report.ShuttingDown = true
+	}
+
+	return report
+}
+
+// run executes the check and gives up after the timeout, even if the check ignores the context.
+func run(ctx context.Context, check Check) Result {
+	ctx, cancel := context.WithTimeout(ctx, check.Timeout)
+	defer cancel()
+
+	result := Result{
+		Name:     check.Name,
+		Critical: check.Critical,
+	}
+
+	done := make(chan error, 1)
+	start := time.Now()
+
+	go func() {
+		defer func() {
+			if err := recover(); err != nil {
+				done <- fmt.Errorf("check panicked: %v", err)
+			}
+		}()
+		done <- check.Check(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		result.Err = err
+	case <-ctx.Done():
+		result.Err = fmt.Errorf("check timed out after %s", check.Timeout)
+	}
+
+	result.Latency = time.Since(start)
+	if result.Err != nil {
+		result.Status = StatusDown
+	} else {
+		result.Status = StatusUp
+	}
+
+	return result
+}
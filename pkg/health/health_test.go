@@ -0,0 +1,86 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(Check{
+		Name:     "database",
+		Check:    func(ctx context.Context) error { return nil },
+		Critical: true,
+	})
+
+	report := registry.Run(context.Background())
+	assert.Equal(t, StatusUp, report.Status)
+	assert.True(t, report.Ready())
+	assert.Len(t, report.Checks, 1)
+	assert.Equal(t, "database", report.Checks[0].Name)
+	assert.Equal(t, StatusUp, report.Checks[0].Status)
+
+	registry.Register(Check{
+		Name:  "storage",
+		Check: func(ctx context.Context) error { return errors.New("unavailable") },
+	})
+
+	report = registry.Run(context.Background())
+	assert.Equal(t, StatusDegraded, report.Status)
+	assert.True(t, report.Ready())
+	assert.Equal(t, StatusDown, report.Checks[1].Status)
+	assert.EqualError(t, report.Checks[1].Err, "unavailable")
+
+	registry.Register(Check{
+		Name: "redis",
+		Check: func(ctx context.Context) error {
+			time.Sleep(time.Second)
+			return nil
+		},
+		Timeout:  10 * time.Millisecond,
+		Critical: true,
+	})
+
+	report = registry.Run(context.Background())
+	assert.Equal(t, StatusDown, report.Status)
+	assert.False(t, report.Ready())
+	assert.Equal(t, StatusDown, report.Checks[2].Status)
+	assert.Less(t, report.Checks[2].Latency, time.Second)
+}
+
+func TestRegistryShutdown(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register(Check{
+		Name:     "database",
+		Check:    func(ctx context.Context) error { return nil },
+		Critical: true,
+	})
+	registry.Shutdown()
+
+	report := registry.Run(context.Background())
+	assert.Equal(t, StatusDown, report.Status)
+	assert.True(t, report.ShuttingDown)
+	assert.Equal(t, StatusUp, report.Checks[0].Status)
+}
+
+func TestHTTPCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ok" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	assert.NoError(t, HTTPCheck(server.Client(), server.URL+"/ok")(context.Background()))
+	assert.Error(t, HTTPCheck(server.Client(), server.URL+"/missing")(context.Background()))
+}
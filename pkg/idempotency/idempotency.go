@@ -0,0 +1,224 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+// Package idempotency replays the stored response of the requests retried with the same Idempotency-Key.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"go.megpoid.dev/go-skel/pkg/principal"
+)
+
+const (
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderReplayed is set on the responses replayed from the store
+	HeaderReplayed = "Idempotent-Replayed"
+	// DefaultLease is how long a request holds its key before a retry can take it over
+	DefaultLease = time.Minute
+	// maxKeyLength limits the size of the keys sent by the clients
+	maxKeyLength = 255
+)
+
+var (
+	ErrKeyTooLong      = echo.NewHTTPError(http.StatusBadRequest, "the Idempotency-Key header is too long")
+	ErrKeyReused       = echo.NewHTTPError(http.StatusUnprocessableEntity, "the Idempotency-Key was used with a different request")
+	ErrRequestInFlight = echo.NewHTTPError(http.StatusConflict, "a request with the same Idempotency-Key is being processed")
+	defaultMethods     = []string{http.MethodPost, http.MethodPatch}
+	// the response is captured before the compression, so the encoding headers don't apply to the stored body
+	skippedReplyHeaders = []string{echo.HeaderContentLength, echo.HeaderContentEncoding, echo.HeaderVary, "Date", echo.HeaderXRequestID}
+)
+
+// Record is the state of a key, the response is only set once the request is completed.
+type Record struct {
+	Fingerprint string
+	Completed   bool
+	// LockedUntil is the end of the lease of the request that acquired the key, it also identifies that request
+	LockedUntil time.Time
+	Status      int
+	Header      http.Header
+	Body        []byte
+}
+
+// Store keeps the records of the keys until they expire.
+type Store interface {
+	// Acquire creates the record of the key if it doesn't exist, is expired or its request didn't complete before
+	// the end of the lease. acquired is false if another request already has it, then the current record is
+	// returned.
+	Acquire(ctx context.Context, key, fingerprint string, lease, ttl time.Duration) (record *Record, acquired bool, err error)
+	// Complete saves the response of the request that acquired the key, unless the key was taken over.
+	Complete(ctx context.Context, key string, record *Record) error
+	// Release removes the key acquired with the record, so the request can be retried.
+	Release(ctx context.Context, key string, record *Record) error
+}
+
+type Config struct {
+	Skipper middleware.Skipper
+	Store   Store
+	// TTL is how long the responses are kept
+	TTL time.Duration
+	// Lease is how long a request holds its key, it should be longer than the requests. DefaultLease if zero
+	Lease time.Duration
+	// Methods that honor the header, POST and PATCH by default
+	Methods []string
+}
+
+// Middleware stores the response of the requests with an Idempotency-Key header, and replays it when the request
+// is repeated. A key reused with a different request gets a 422, and a repeat received while the first request
+// is still running gets a 409. The server errors aren't stored, so the request can be retried.
+func Middleware(config Config) echo.MiddlewareFunc {
+	if config.Skipper == nil {
+		config.Skipper = middleware.DefaultSkipper
+	}
+	if len(config.Methods) == 0 {
+		config.Methods = defaultMethods
+	}
+	if config.Lease <= 0 {
+		config.Lease = DefaultLease
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			key := req.Header.Get(HeaderIdempotencyKey)
+			if key == "" || config.Skipper(ctx) || !slices.Contains(config.Methods, req.Method) {
+				return next(ctx)
+			}
+			if len(key) > maxKeyLength {
+				return ErrKeyTooLong
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return err
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			storeKey := scope(ctx) + ":" + key
+			fingerprint := fingerprint(req, body)
+
+			record, acquired, err := config.Store.Acquire(req.Context(), storeKey, fingerprint, config.Lease, config.TTL)
+			if err != nil {
+				return err
+			}
+
+			if !acquired {
+				switch {
+				case record.Fingerprint != fingerprint:
+					return ErrKeyReused
+				case !record.Completed:
+					return ErrRequestInFlight
+				default:
+					return replay(ctx, record)
+				}
+			}
+
+			// keep the store consistent if the client goes away before the response is done
+			storeCtx := context.WithoutCancel(req.Context())
+			completed := false
+			defer func() {
+				// the handler panicked, let the request be retried
+				if !completed {
+					_ = config.Store.Release(storeCtx, storeKey, record)
+				}
+			}()
+
+			status, header, responseBody := capture(ctx, next)
+			completed = true
+			if status >= http.StatusInternalServerError {
+				return config.Store.Release(storeCtx, storeKey, record)
+			}
+
+			return config.Store.Complete(storeCtx, storeKey, &Record{
+				Fingerprint: fingerprint,
+				Completed:   true,
+				LockedUntil: record.LockedUntil,
+				Status:      status,
+				Header:      header,
+				Body:        responseBody,
+			})
+		}
+	}
+}
+
+// scope separates the keys of the clients by their authenticated principal, so the keys survive the renewal of
+// the credentials. The anonymous requests are separated by the client IP, read from the proxy headers only if the
+// server has an IPExtractor that trusts them, so a client can't use the keys of another one.
+func scope(ctx echo.Context) string {
+	if p, ok := principal.FromContext(ctx.Request().Context()); ok {
+		return p.Type + "/" + p.ID
+	}
+	if ctx.Echo().IPExtractor == nil {
+		return "ip/" + echo.ExtractIPDirect()(ctx.Request())
+	}
+	return "ip/" + ctx.RealIP()
+}
+
+// fingerprint identifies the request sent with the key.
+func fingerprint(req *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(req.Method + " " + req.URL.RequestURI() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func replay(ctx echo.Context, record *Record) error {
+	header := ctx.Response().Header()
+	for key, values := range record.Header {
+		header[key] = values
+	}
+	header.Set(HeaderReplayed, "true")
+
+	ctx.Response().WriteHeader(record.Status)
+	_, err := ctx.Response().Write(record.Body)
+	return err
+}
+
+// capture runs the handler and returns the final response. The errors are rendered here, so the stored response
+// is the one sent to the client.
+func capture(ctx echo.Context, next echo.HandlerFunc) (int, http.Header, []byte) {
+	res := ctx.Response()
+	writer := &captureWriter{ResponseWriter: res.Writer}
+	res.Writer = writer
+
+	if err := next(ctx); err != nil {
+		ctx.Error(err)
+	}
+
+	header := res.Header().Clone()
+	for _, key := range skippedReplyHeaders {
+		header.Del(key)
+	}
+
+	return res.Status, header, writer.body.Bytes()
+}
+
+type captureWriter struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *captureWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *captureWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *captureWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
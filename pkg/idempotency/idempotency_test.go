@@ -0,0 +1,269 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.megpoid.dev/go-skel/pkg/principal"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: map[string]*Record{}}
+}
+
+func (s *memoryStore) Acquire(_ context.Context, key, fingerprint string, lease, _ time.Duration) (*Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if record, ok := s.records[key]; ok && (record.Completed || time.Now().Before(record.LockedUntil)) {
+		return record, false, nil
+	}
+	s.records[key] = &Record{Fingerprint: fingerprint, LockedUntil: time.Now().Add(lease)}
+	return s.records[key], true, nil
+}
+
+// held returns true if the key is still held by the request that acquired the record.
+func (s *memoryStore) held(key string, record *Record) bool {
+	current, ok := s.records[key]
+	return ok && current.LockedUntil.Equal(record.LockedUntil)
+}
+
+func (s *memoryStore) Complete(_ context.Context, key string, record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.held(key, record) {
+		s.records[key] = record
+	}
+	return nil
+}
+
+func (s *memoryStore) Release(_ context.Context, key string, record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.held(key, record) {
+		delete(s.records, key)
+	}
+	return nil
+}
+
+func newServer(store Store, handler echo.HandlerFunc, middlewares ...echo.MiddlewareFunc) *echo.Echo {
+	return newServerWithConfig(Config{Store: store, TTL: time.Hour}, handler, middlewares...)
+}
+
+// newServerWithConfig runs the middlewares before the idempotency one.
+func newServerWithConfig(config Config, handler echo.HandlerFunc, middlewares ...echo.MiddlewareFunc) *echo.Echo {
+	e := echo.New()
+	e.Use(middlewares...)
+	e.Use(Middleware(config))
+	e.POST("/profiles", handler)
+	e.GET("/profiles", handler)
+	return e
+}
+
+func send(e *echo.Echo, method, key, body string, headers ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/profiles", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if key != "" {
+		req.Header.Set(HeaderIdempotencyKey, key)
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestReplay(t *testing.T) {
+	calls := 0
+	e := newServer(newMemoryStore(), func(ctx echo.Context) error {
+		calls++
+		if ctx.Request().Method == http.MethodPost {
+			body, err := io.ReadAll(ctx.Request().Body)
+			require.NoError(t, err)
+			assert.Equal(t, `{"name":"John"}`, string(body))
+		}
+		ctx.Response().Header().Set(echo.HeaderLocation, "/profiles/1")
+		return ctx.JSON(http.StatusCreated, map[string]any{"id": calls})
+	})
+
+	first := send(e, http.MethodPost, "key", `{"name":"John"}`)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Empty(t, first.Header().Get(HeaderReplayed))
+
+	second := send(e, http.MethodPost, "key", `{"name":"John"}`)
+	assert.Equal(t, http.StatusCreated, second.Code)
+	assert.Equal(t, "true", second.Header().Get(HeaderReplayed))
+	assert.Equal(t, "/profiles/1", second.Header().Get(echo.HeaderLocation))
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, 1, calls)
+
+	// without a key, or with a method that doesn't honor it, the handler always runs
+	send(e, http.MethodPost, "", `{"name":"John"}`)
+	send(e, http.MethodGet, "key", "")
+	assert.Equal(t, 3, calls)
+}
+
+func TestKeyReused(t *testing.T) {
+	e := newServer(newMemoryStore(), func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusCreated)
+	})
+
+	assert.Equal(t, http.StatusCreated, send(e, http.MethodPost, "key", `{"name":"John"}`).Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, send(e, http.MethodPost, "key", `{"name":"Jane"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, send(e, http.MethodPost, strings.Repeat("k", maxKeyLength+1), "").Code)
+}
+
+func TestRequestInFlight(t *testing.T) {
+	started := make(chan struct{})
+	done := make(chan struct{})
+	e := newServer(newMemoryStore(), func(ctx echo.Context) error {
+		close(started)
+		<-done
+		return ctx.NoContent(http.StatusCreated)
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.Equal(t, http.StatusCreated, send(e, http.MethodPost, "key", `{}`).Code)
+	}()
+
+	<-started
+	assert.Equal(t, http.StatusConflict, send(e, http.MethodPost, "key", `{}`).Code)
+	close(done)
+	wg.Wait()
+}
+
+func TestLeaseExpired(t *testing.T) {
+	started := make(chan struct{})
+	done := make(chan struct{})
+	calls := 0
+	e := newServerWithConfig(Config{Store: newMemoryStore(), TTL: time.Hour, Lease: 50 * time.Millisecond}, func(ctx echo.Context) error {
+		calls++
+		if calls == 1 {
+			close(started)
+			<-done
+		}
+		return ctx.JSON(http.StatusCreated, map[string]any{"id": calls})
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		send(e, http.MethodPost, "key", `{}`)
+	}()
+
+	<-started
+	assert.Equal(t, http.StatusConflict, send(e, http.MethodPost, "key", `{}`).Code)
+
+	// the retry takes over the key once the lease ends, the stalled request doesn't replace its response
+	time.Sleep(100 * time.Millisecond)
+	retry := send(e, http.MethodPost, "key", `{}`)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	close(done)
+	wg.Wait()
+
+	replayed := send(e, http.MethodPost, "key", `{}`)
+	assert.Equal(t, "true", replayed.Header().Get(HeaderReplayed))
+	assert.Equal(t, retry.Body.String(), replayed.Body.String())
+	assert.Equal(t, 2, calls)
+}
+
+func TestScope(t *testing.T) {
+	calls := 0
+	authenticate := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if id := ctx.Request().Header.Get("X-User"); id != "" {
+				p := &principal.Principal{Type: principal.TypeUser, ID: id}
+				ctx.SetRequest(ctx.Request().WithContext(principal.NewContext(ctx.Request().Context(), p)))
+			}
+			return next(ctx)
+		}
+	}
+	e := newServer(newMemoryStore(), func(ctx echo.Context) error {
+		calls++
+		return ctx.NoContent(http.StatusCreated)
+	}, authenticate)
+
+	send(e, http.MethodPost, "key", `{}`, "X-User", "1", echo.HeaderAuthorization, "Bearer first")
+	// a new token of the same user replays the response
+	rec := send(e, http.MethodPost, "key", `{}`, "X-User", "1", echo.HeaderAuthorization, "Bearer second")
+	assert.Equal(t, "true", rec.Header().Get(HeaderReplayed))
+	assert.Equal(t, 1, calls)
+
+	// other users and the anonymous clients have their own keys
+	send(e, http.MethodPost, "key", `{}`, "X-User", "2")
+	send(e, http.MethodPost, "key", `{}`)
+	assert.Equal(t, 3, calls)
+
+	// the anonymous clients can't pick the IP of another client
+	rec = send(e, http.MethodPost, "key", `{}`, echo.HeaderXForwardedFor, "198.51.100.7")
+	assert.Equal(t, "true", rec.Header().Get(HeaderReplayed))
+	assert.Equal(t, 3, calls)
+}
+
+func TestReplayCompressed(t *testing.T) {
+	e := newServer(newMemoryStore(), func(ctx echo.Context) error {
+		return ctx.JSON(http.StatusCreated, map[string]any{"id": 1})
+	}, middleware.Gzip())
+
+	first := send(e, http.MethodPost, "key", `{}`, echo.HeaderAcceptEncoding, "gzip")
+	assert.Equal(t, "gzip", first.Header().Get(echo.HeaderContentEncoding))
+
+	// the stored response isn't compressed, so it's replayed with the encoding accepted by the client
+	second := send(e, http.MethodPost, "key", `{}`)
+	assert.Equal(t, "true", second.Header().Get(HeaderReplayed))
+	assert.Empty(t, second.Header().Get(echo.HeaderContentEncoding))
+	assert.JSONEq(t, `{"id":1}`, second.Body.String())
+}
+
+func TestServerErrorReleased(t *testing.T) {
+	calls := 0
+	e := newServer(newMemoryStore(), func(ctx echo.Context) error {
+		calls++
+		if calls == 1 {
+			return errors.New("connection refused")
+		}
+		return ctx.NoContent(http.StatusCreated)
+	})
+
+	assert.Equal(t, http.StatusInternalServerError, send(e, http.MethodPost, "key", `{}`).Code)
+	assert.Equal(t, http.StatusCreated, send(e, http.MethodPost, "key", `{}`).Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestClientErrorStored(t *testing.T) {
+	calls := 0
+	e := newServer(newMemoryStore(), func(ctx echo.Context) error {
+		calls++
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid")
+	})
+
+	assert.Equal(t, http.StatusBadRequest, send(e, http.MethodPost, "key", `{}`).Code)
+	rec := send(e, http.MethodPost, "key", `{}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "true", rec.Header().Get(HeaderReplayed))
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package jwks
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CacheMaxAge is how long the clients can cache the key set, the new keys must be published for longer before they
+// sign the tokens.
+const CacheMaxAge = time.Minute
+
+// JWK is a public key as described in RFC 7517, the fields are encoded as base64url without padding.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC and OKP
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+	Y     string `json:"y,omitempty"`
+}
+
+// Set is the document served on /.well-known/jwks.json.
+type Set struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NewJWK returns the public key of the key.
+func NewJWK(key *Key) (*JWK, error) {
+	jwk := &JWK{
+		Use:       "sig",
+		Algorithm: key.Algorithm,
+		KeyID:     key.ID,
+	}
+
+	switch publicKey := key.PrivateKey.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = encode(publicKey.N.Bytes())
+		jwk.E = encode(big.NewInt(int64(publicKey.E)).Bytes())
+	case *ecdsa.PublicKey:
+		point, err := publicKey.ECDH()
+		if err != nil {
+			return nil, fmt.Errorf("error converting public key: %w", err)
+		}
+		// uncompressed form, 0x04 || x || y
+		data := point.Bytes()[1:]
+		jwk.KeyType = "EC"
+		jwk.Curve = publicKey.Curve.Params().Name
+		jwk.X = encode(data[:len(data)/2])
+		jwk.Y = encode(data[len(data)/2:])
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = encode(publicKey)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAlgorithm, key.Algorithm)
+	}
+
+	return jwk, nil
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Handler serves the public keys of the ring.
+func Handler(ring *KeyRing) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		set, err := ring.Set()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "cannot encode the keys").SetInternal(err)
+		}
+
+		c.Response().Header().Set(echo.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(CacheMaxAge.Seconds())))
+		return c.JSON(http.StatusOK, set)
+	}
+}
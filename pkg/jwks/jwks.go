@@ -0,0 +1,161 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package jwks
+
+import (
+	"crypto"
+	goecdsa "crypto/ecdsa"
+	goed25519 "crypto/ed25519"
+	gorsa "crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	pkgcrypto "go.megpoid.dev/go-skel/pkg/crypto"
+	"go.megpoid.dev/go-skel/pkg/crypto/ecdsa"
+	"go.megpoid.dev/go-skel/pkg/crypto/ed25519"
+	"go.megpoid.dev/go-skel/pkg/crypto/rsa"
+)
+
+// Algorithms of the signed tokens, as used on the alg header.
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// keyIDSize is the number of random bytes of the key IDs
+const keyIDSize = 16
+
+var (
+	ErrUnknownAlgorithm = errors.New("unknown signing algorithm")
+	ErrUnknownKey       = errors.New("unknown signing key")
+	ErrKeyExpired       = errors.New("signing key expired")
+	ErrNoSigningKey     = errors.New("no signing key")
+)
+
+// Keys sign the tokens and find the key to verify them.
+type Keys interface {
+	Sign(claims jwt.Claims) (string, error)
+	Keyfunc(token *jwt.Token) (any, error)
+}
+
+// Asymmetric returns true if the algorithm signs with a private key, so the tokens can be verified with the
+// published public keys.
+func Asymmetric(algorithm string) bool {
+	switch algorithm {
+	case AlgorithmRS256, AlgorithmES256, AlgorithmEdDSA:
+		return true
+	}
+
+	return false
+}
+
+// Key is a private key of the ring, identified by the kid header of the tokens signed with it.
+type Key struct {
+	ID         string
+	Algorithm  string
+	PrivateKey crypto.Signer
+	CreatedAt  time.Time
+	// ExpiresAt is the time after which the tokens signed with the key are rejected, zero if the key is active.
+	ExpiresAt time.Time
+}
+
+// Expired returns true if the tokens signed with the key can't be verified anymore.
+func (k *Key) Expired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)
+}
+
+// GenerateKey generates a key for the algorithm with a random ID.
+func GenerateKey(algorithm string, now time.Time) (*Key, error) {
+	var privateKey crypto.Signer
+	var err error
+
+	switch algorithm {
+	case AlgorithmRS256:
+		privateKey, err = rsa.GenerateKey()
+	case AlgorithmES256:
+		privateKey, err = ecdsa.GenerateKey()
+	case AlgorithmEdDSA:
+		_, privateKey, err = ed25519.GenerateKey()
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAlgorithm, algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := pkgcrypto.GenerateRandomKey(keyIDSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		ID:         base64.RawURLEncoding.EncodeToString(id),
+		Algorithm:  algorithm,
+		PrivateKey: privateKey,
+		CreatedAt:  now,
+	}, nil
+}
+
+// MarshalPrivateKey marshals the private key of the key, in the form used by the package of its algorithm.
+func MarshalPrivateKey(key *Key) ([]byte, error) {
+	switch privateKey := key.PrivateKey.(type) {
+	case *gorsa.PrivateKey:
+		return rsa.MarshalPrivateKey(privateKey), nil
+	case *goecdsa.PrivateKey:
+		return ecdsa.MarshalPrivateKey(privateKey)
+	case goed25519.PrivateKey:
+		return ed25519.MarshalPrivateKey(privateKey)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownAlgorithm, key.Algorithm)
+}
+
+// UnmarshalPrivateKey unmarshal a private key of the algorithm.
+func UnmarshalPrivateKey(algorithm string, data []byte) (crypto.Signer, error) {
+	switch algorithm {
+	case AlgorithmRS256:
+		return rsa.UnmarshalPrivateKey(data)
+	case AlgorithmES256:
+		return ecdsa.UnmarshalPrivateKey(data)
+	case AlgorithmEdDSA:
+		if len(data) != goed25519.PrivateKeySize {
+			return nil, errors.New("invalid ed25519 private key size")
+		}
+		return ed25519.UnmarshalPrivateKey(data)
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownAlgorithm, algorithm)
+}
+
+// signingMethod returns the method of the jwt package for the algorithm.
+func signingMethod(algorithm string) (jwt.SigningMethod, error) {
+	method := jwt.GetSigningMethod(algorithm)
+	if method == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAlgorithm, algorithm)
+	}
+
+	return method, nil
+}
+
+// Secret signs and verifies the tokens with a shared HS256 key. The tokens can only be verified by the holders of
+// the secret, so it isn't published.
+type Secret []byte
+
+func (s Secret) Sign(claims jwt.Claims) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s))
+}
+
+func (s Secret) Keyfunc(token *jwt.Token) (any, error) {
+	if token.Method.Alg() != AlgorithmHS256 {
+		return nil, fmt.Errorf("unexpected jwt signing method=%v", token.Header["alg"])
+	}
+
+	return []byte(s), nil
+}
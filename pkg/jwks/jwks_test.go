@@ -0,0 +1,188 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package jwks
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClaims(now time.Time) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   "1",
+		ExpiresAt: jwt.NewNumericDate(now.Add(2 * time.Hour)),
+	}
+}
+
+func TestKeyRingSignVerify(t *testing.T) {
+	now := time.Now()
+
+	for _, algorithm := range []string{AlgorithmRS256, AlgorithmES256, AlgorithmEdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			key, err := GenerateKey(algorithm, now)
+			require.NoError(t, err)
+
+			// the keys survive the round trip to the database
+			data, err := MarshalPrivateKey(key)
+			require.NoError(t, err)
+			key.PrivateKey, err = UnmarshalPrivateKey(algorithm, data)
+			require.NoError(t, err)
+
+			ring := NewKeyRing()
+			ring.Replace([]*Key{key})
+
+			signed, err := ring.Sign(newClaims(now))
+			require.NoError(t, err)
+
+			token, err := jwt.ParseWithClaims(signed, &jwt.RegisteredClaims{}, ring.Keyfunc)
+			require.NoError(t, err)
+			assert.Equal(t, key.ID, token.Header["kid"])
+			assert.Equal(t, algorithm, token.Method.Alg())
+		})
+	}
+}
+
+func TestKeyRingRotation(t *testing.T) {
+	now := time.Now()
+	ring := NewKeyRing(WithPublishDelay(time.Minute), WithTime(func() time.Time { return now }))
+
+	retired, err := GenerateKey(AlgorithmES256, now.Add(-time.Hour))
+	require.NoError(t, err)
+	active, err := GenerateKey(AlgorithmEdDSA, now.Add(-time.Second))
+	require.NoError(t, err)
+	retired.ExpiresAt = now.Add(time.Hour)
+
+	ring.Replace([]*Key{retired, active})
+
+	// the new key is published but doesn't sign yet
+	key, err := ring.SigningKey()
+	require.NoError(t, err)
+	assert.Equal(t, retired.ID, key.ID)
+
+	set, err := ring.Set()
+	require.NoError(t, err)
+	assert.Len(t, set.Keys, 2)
+
+	oldToken, err := ring.Sign(newClaims(now))
+	require.NoError(t, err)
+
+	now = now.Add(time.Minute)
+	key, err = ring.SigningKey()
+	require.NoError(t, err)
+	assert.Equal(t, active.ID, key.ID)
+
+	// the tokens of the retired key are accepted until it expires
+	_, err = jwt.ParseWithClaims(oldToken, &jwt.RegisteredClaims{}, ring.Keyfunc, jwt.WithTimeFunc(func() time.Time { return now }))
+	require.NoError(t, err)
+
+	now = now.Add(time.Hour)
+	_, err = jwt.ParseWithClaims(oldToken, &jwt.RegisteredClaims{}, ring.Keyfunc, jwt.WithTimeFunc(func() time.Time { return now }))
+	assert.ErrorIs(t, err, ErrKeyExpired)
+
+	set, err = ring.Set()
+	require.NoError(t, err)
+	require.Len(t, set.Keys, 1)
+	assert.Equal(t, active.ID, set.Keys[0].KeyID)
+}
+
+func TestKeyRingRejectsTokens(t *testing.T) {
+	now := time.Now()
+	key, err := GenerateKey(AlgorithmRS256, now)
+	require.NoError(t, err)
+
+	ring := NewKeyRing()
+	ring.Replace([]*Key{key})
+
+	// signed with the secret but with the kid of the key
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, newClaims(now))
+	token.Header["kid"] = key.ID
+	signed, err := token.SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	_, err = jwt.ParseWithClaims(signed, &jwt.RegisteredClaims{}, ring.Keyfunc)
+	assert.Error(t, err)
+
+	other, err := GenerateKey(AlgorithmRS256, now)
+	require.NoError(t, err)
+	otherRing := NewKeyRing()
+	otherRing.Replace([]*Key{other})
+
+	signed, err = otherRing.Sign(newClaims(now))
+	require.NoError(t, err)
+
+	_, err = jwt.ParseWithClaims(signed, &jwt.RegisteredClaims{}, ring.Keyfunc)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+
+	_, err = NewKeyRing().Sign(newClaims(now))
+	assert.ErrorIs(t, err, ErrNoSigningKey)
+}
+
+func TestHandler(t *testing.T) {
+	now := time.Now()
+	ring := NewKeyRing()
+
+	var keys []*Key
+	for _, algorithm := range []string{AlgorithmRS256, AlgorithmES256, AlgorithmEdDSA} {
+		key, err := GenerateKey(algorithm, now)
+		require.NoError(t, err)
+		keys = append(keys, key)
+	}
+	ring.Replace(keys)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	rec := httptest.NewRecorder()
+	require.NoError(t, Handler(ring)(e.NewContext(req, rec)))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "public, max-age=60", rec.Header().Get(echo.HeaderCacheControl))
+
+	var set Set
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &set))
+	require.Len(t, set.Keys, 3)
+
+	byType := map[string]JWK{}
+	for _, jwk := range set.Keys {
+		assert.Equal(t, "sig", jwk.Use)
+		byType[jwk.KeyType] = jwk
+	}
+
+	assert.Equal(t, "AQAB", byType["RSA"].E)
+	assert.NotEmpty(t, byType["RSA"].N)
+	assert.Equal(t, "P-256", byType["EC"].Curve)
+	assert.Len(t, byType["EC"].X, 43)
+	assert.Len(t, byType["EC"].Y, 43)
+	assert.Equal(t, "Ed25519", byType["OKP"].Curve)
+	assert.Len(t, byType["OKP"].X, 43)
+}
+
+func TestSecret(t *testing.T) {
+	secret := Secret("01234567890123456789012345678901")
+
+	signed, err := secret.Sign(newClaims(time.Now()))
+	require.NoError(t, err)
+
+	_, err = jwt.ParseWithClaims(signed, &jwt.RegisteredClaims{}, secret.Keyfunc)
+	require.NoError(t, err)
+
+	key, err := GenerateKey(AlgorithmEdDSA, time.Now())
+	require.NoError(t, err)
+	ring := NewKeyRing()
+	ring.Replace([]*Key{key})
+
+	signed, err = ring.Sign(newClaims(time.Now()))
+	require.NoError(t, err)
+
+	_, err = jwt.ParseWithClaims(signed, &jwt.RegisteredClaims{}, secret.Keyfunc)
+	assert.Error(t, err)
+}
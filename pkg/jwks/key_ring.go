@@ -0,0 +1,179 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package jwks
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// used to validate that the implementation matches the interface
+var _ Keys = &KeyRing{}
+
+// KeyRing holds the active key that signs the tokens and the retired keys that still verify them until they expire.
+// The key of a token is selected by its kid header.
+type KeyRing struct {
+	mu sync.RWMutex
+	// sorted by creation, the newest first
+	keys         []*Key
+	publishDelay time.Duration
+	timeNow      func() time.Time
+}
+
+type Option func(r *KeyRing)
+
+// WithPublishDelay sets how long a new key is published before it signs the tokens, so the verifiers that cache the
+// key set learn about it before they receive a token signed with it. The previous key keeps signing meanwhile.
+func WithPublishDelay(delay time.Duration) Option {
+	return func(r *KeyRing) {
+		r.publishDelay = delay
+	}
+}
+
+func WithTime(timeFn func() time.Time) Option {
+	return func(r *KeyRing) {
+		r.timeNow = timeFn
+	}
+}
+
+func NewKeyRing(opts ...Option) *KeyRing {
+	r := &KeyRing{}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.timeNow == nil {
+		r.timeNow = time.Now
+	}
+
+	return r
+}
+
+// Replace sets the keys of the ring, the expired ones are ignored.
+func (r *KeyRing) Replace(keys []*Key) {
+	now := r.timeNow()
+
+	valid := make([]*Key, 0, len(keys))
+	for _, key := range keys {
+		if !key.Expired(now) {
+			valid = append(valid, key)
+		}
+	}
+
+	slices.SortFunc(valid, func(a, b *Key) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keys = valid
+}
+
+// SigningKey returns the newest key that was published for the delay, or the newest key if none was published for
+// long enough since nobody can hold a token signed by an older key.
+func (r *KeyRing) SigningKey() (*Key, error) {
+	now := r.timeNow()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var newest *Key
+	for _, key := range r.keys {
+		if key.Expired(now) {
+			continue
+		}
+		if newest == nil {
+			newest = key
+		}
+		if !key.CreatedAt.Add(r.publishDelay).After(now) {
+			return key, nil
+		}
+	}
+
+	if newest == nil {
+		return nil, ErrNoSigningKey
+	}
+
+	return newest, nil
+}
+
+// Sign signs the claims with the signing key, setting its ID on the kid header.
+func (r *KeyRing) Sign(claims jwt.Claims) (string, error) {
+	key, err := r.SigningKey()
+	if err != nil {
+		return "", err
+	}
+
+	method, err := signingMethod(key.Algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString(key.PrivateKey)
+}
+
+// Keyfunc returns the public key of the kid header of the token, if the key didn't expire and the token was signed
+// with its algorithm.
+func (r *KeyRing) Keyfunc(token *jwt.Token) (any, error) {
+	id, _ := token.Header["kid"].(string)
+
+	key := r.find(id)
+	if key == nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, id)
+	}
+
+	if key.Expired(r.timeNow()) {
+		return nil, fmt.Errorf("%w: %q", ErrKeyExpired, id)
+	}
+
+	if token.Method.Alg() != key.Algorithm {
+		return nil, fmt.Errorf("unexpected jwt signing method=%v", token.Header["alg"])
+	}
+
+	return key.PrivateKey.Public(), nil
+}
+
+// Set returns the public keys that can verify the tokens, including the keys that don't sign yet.
+func (r *KeyRing) Set() (*Set, error) {
+	now := r.timeNow()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	set := &Set{Keys: make([]JWK, 0, len(r.keys))}
+	for _, key := range r.keys {
+		if key.Expired(now) {
+			continue
+		}
+
+		jwk, err := NewJWK(key)
+		if err != nil {
+			return nil, err
+		}
+		set.Keys = append(set.Keys, *jwk)
+	}
+
+	return set, nil
+}
+
+func (r *KeyRing) find(id string) *Key {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, key := range r.keys {
+		if key.ID == id {
+			return key
+		}
+	}
+
+	return nil
+}
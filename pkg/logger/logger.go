@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/spf13/viper"
+	"go.megpoid.dev/go-skel/pkg/ctxlog"
 	"golang.org/x/term"
 )
 
@@ -23,24 +24,33 @@ type Config struct {
 	Format string
 }
 
-// InitLoggerWithConfig initializes the logger with the specified configuration.
+// InitLoggerWithConfig initializes the logger with the specified configuration. The records include the
+// attributes stored on the context with ctxlog, like the request and trace IDs.
 func InitLoggerWithConfig(cfg Config) {
 	isTerminal := term.IsTerminal(int(os.Stdout.Fd()))
 
+	opts := &slog.HandlerOptions{Level: slog.LevelInfo}
+	if cfg.Debug {
+		opts.Level = slog.LevelDebug
+	}
+
+	var handler slog.Handler
+
 	switch cfg.Format {
 	case "json":
-		slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+		handler = slog.NewJSONHandler(os.Stdout, opts)
 	case "logfmt":
+		handler = slog.NewTextHandler(os.Stdout, opts)
 	case "":
-		if !isTerminal {
-			slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+		if isTerminal {
+			handler = slog.NewTextHandler(os.Stdout, opts)
+		} else {
+			handler = slog.NewJSONHandler(os.Stdout, opts)
 		}
 	default:
 		slog.Error("Invalid log format specified")
 		os.Exit(1)
 	}
 
-	if cfg.Debug {
-		slog.SetLogLoggerLevel(slog.LevelDebug)
-	}
+	slog.SetDefault(slog.New(ctxlog.NewHandler(handler)))
 }
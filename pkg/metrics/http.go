@@ -0,0 +1,71 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// unmatchedRoute is used as route label for the requests that don't match any route, so unknown paths
+// don't create new series.
+const unmatchedRoute = "unmatched"
+
+// HTTPMiddleware counts the requests and observes their latency, labelled by method, route template and status.
+func HTTPMiddleware(registerer prometheus.Registerer, skipper middleware.Skipper) echo.MiddlewareFunc {
+	if skipper == nil {
+		skipper = middleware.DefaultSkipper
+	}
+
+	labels := []string{"method", "route", "status"}
+
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Count of HTTP requests processed.",
+	}, labels)
+
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the HTTP requests.",
+		Buckets:   prometheus.DefBuckets,
+	}, labels)
+
+	registerer.MustRegister(requests, duration)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if skipper(ctx) {
+				return next(ctx)
+			}
+
+			start := time.Now()
+
+			err := next(ctx)
+			if err != nil {
+				// let the error handler write the response, so the final status is known
+				ctx.Error(err)
+			}
+
+			route := ctx.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+
+			values := []string{ctx.Request().Method, route, strconv.Itoa(ctx.Response().Status)}
+			requests.WithLabelValues(values...).Inc()
+			duration.WithLabelValues(values...).Observe(time.Since(start).Seconds())
+
+			return err
+		}
+	}
+}
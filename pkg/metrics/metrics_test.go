@@ -0,0 +1,97 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.megpoid.dev/go-skel/pkg/repo"
+)
+
+func TestHTTPMiddleware(t *testing.T) {
+	registry := prometheus.NewRegistry()
+
+	e := echo.New()
+	e.Use(HTTPMiddleware(registry, nil))
+	e.GET("/profiles/:id", func(ctx echo.Context) error {
+		if ctx.Param("id") == "0" {
+			return echo.NewHTTPError(http.StatusBadRequest)
+		}
+		return ctx.NoContent(http.StatusOK)
+	})
+
+	for _, path := range []string{"/profiles/1", "/profiles/2", "/profiles/0", "/unknown"} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	expected := `
+# HELP goapp_http_requests_total Count of HTTP requests processed.
+# TYPE goapp_http_requests_total counter
+goapp_http_requests_total{method="GET",route="/profiles/:id",status="200"} 2
+goapp_http_requests_total{method="GET",route="/profiles/:id",status="400"} 1
+goapp_http_requests_total{method="GET",route="unmatched",status="404"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "goapp_http_requests_total"))
+}
+
+func TestRepoErrorCollector(t *testing.T) {
+	_ = repo.NewRepoError(repo.ErrDuplicated, nil)
+
+	collector := NewRepoErrorCollector()
+	assert.GreaterOrEqual(t, testutil.CollectAndCount(collector), 1)
+}
+
+type fakeInspector struct {
+	err error
+}
+
+func (f *fakeInspector) Queues() ([]string, error) {
+	return []string{"default"}, f.err
+}
+
+func (f *fakeInspector) GetQueueInfo(queue string) (*asynq.QueueInfo, error) {
+	return &asynq.QueueInfo{Queue: queue, Pending: 3, Latency: 2 * time.Second, ProcessedTotal: 10, FailedTotal: 1}, nil
+}
+
+func TestQueueCollector(t *testing.T) {
+	expected := `
+# HELP goapp_queue_failed_total Count of failed tasks.
+# TYPE goapp_queue_failed_total counter
+goapp_queue_failed_total{queue="default"} 1
+# HELP goapp_queue_latency_seconds Time since the oldest pending task was enqueued.
+# TYPE goapp_queue_latency_seconds gauge
+goapp_queue_latency_seconds{queue="default"} 2
+# HELP goapp_queue_up Whether the queue backend could be inspected.
+# TYPE goapp_queue_up gauge
+goapp_queue_up 1
+`
+	collector := NewQueueCollector(&fakeInspector{})
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"goapp_queue_failed_total", "goapp_queue_latency_seconds", "goapp_queue_up"))
+
+	collector = NewQueueCollector(&fakeInspector{err: errors.New("unavailable")})
+	assert.Equal(t, 1, testutil.CollectAndCount(collector))
+}
+
+func TestTaskMiddleware(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	handler := TaskMiddleware(registry)(asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+		return errors.New("failed")
+	}))
+
+	assert.Error(t, handler.ProcessTask(context.Background(), asynq.NewTask("test:task", nil)))
+	assert.Equal(t, 1, testutil.CollectAndCount(registry, "goapp_task_duration_seconds"))
+}
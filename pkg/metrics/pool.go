@@ -0,0 +1,98 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.megpoid.dev/go-skel/pkg/repo"
+)
+
+// PoolStater returns the statistics of a connection pool, implemented by pgxpool.Pool.
+type PoolStater interface {
+	Stat() *pgxpool.Stat
+}
+
+// PoolCollector exports the statistics of a pgx connection pool.
+type PoolCollector struct {
+	pool PoolStater
+
+	acquiredConns   *prometheus.Desc
+	idleConns       *prometheus.Desc
+	totalConns      *prometheus.Desc
+	maxConns        *prometheus.Desc
+	acquireCount    *prometheus.Desc
+	acquireDuration *prometheus.Desc
+	emptyAcquire    *prometheus.Desc
+	emptyWait       *prometheus.Desc
+	canceledAcquire *prometheus.Desc
+}
+
+func NewPoolCollector(pool PoolStater) *PoolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	return &PoolCollector{
+		pool:            pool,
+		acquiredConns:   desc("acquired_connections", "Count of connections currently in use."),
+		idleConns:       desc("idle_connections", "Count of idle connections in the pool."),
+		totalConns:      desc("total_connections", "Count of connections in the pool."),
+		maxConns:        desc("max_connections", "Maximum size of the pool."),
+		acquireCount:    desc("acquires_total", "Count of successful connection acquires."),
+		acquireDuration: desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		emptyAcquire:    desc("empty_acquires_total", "Count of acquires that had to wait for a connection."),
+		emptyWait:       desc("empty_acquire_wait_seconds_total", "Total time spent waiting for a connection on an empty pool."),
+		canceledAcquire: desc("canceled_acquires_total", "Count of acquires canceled by the context."),
+	}
+}
+
+func (c *PoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquire
+	ch <- c.emptyWait
+	ch <- c.canceledAcquire
+}
+
+func (c *PoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquire, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyWait, prometheus.CounterValue, stat.EmptyAcquireWaitTime().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquire, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+}
+
+// RepoErrorCollector exports the count of repository errors by kind.
+type RepoErrorCollector struct {
+	errors *prometheus.Desc
+}
+
+func NewRepoErrorCollector() *RepoErrorCollector {
+	return &RepoErrorCollector{
+		errors: prometheus.NewDesc(prometheus.BuildFQName(namespace, "repo", "errors_total"),
+			"Count of repository errors by kind.", []string{"kind"}, nil),
+	}
+}
+
+func (c *RepoErrorCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.errors
+}
+
+func (c *RepoErrorCollector) Collect(ch chan<- prometheus.Metric) {
+	for kind, count := range repo.ErrorCounts() {
+		ch <- prometheus.MustNewConstMetric(c.errors, prometheus.CounterValue, float64(count), kind)
+	}
+}
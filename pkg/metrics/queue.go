@@ -0,0 +1,129 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// QueueInspector returns the state of the queues, implemented by asynq.Inspector.
+type QueueInspector interface {
+	Queues() ([]string, error)
+	GetQueueInfo(queue string) (*asynq.QueueInfo, error)
+}
+
+// QueueCollector exports the size, latency and processed counts of the asynq queues.
+type QueueCollector struct {
+	inspector QueueInspector
+
+	size      *prometheus.Desc
+	latency   *prometheus.Desc
+	processed *prometheus.Desc
+	failed    *prometheus.Desc
+	paused    *prometheus.Desc
+	up        *prometheus.Desc
+}
+
+func NewQueueCollector(inspector QueueInspector) *QueueCollector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "queue", name), help, labels, nil)
+	}
+
+	return &QueueCollector{
+		inspector: inspector,
+		size:      desc("tasks", "Count of tasks in the queue by state.", "queue", "state"),
+		latency:   desc("latency_seconds", "Time since the oldest pending task was enqueued.", "queue"),
+		processed: desc("processed_total", "Count of processed tasks, both succeeded and failed.", "queue"),
+		failed:    desc("failed_total", "Count of failed tasks.", "queue"),
+		paused:    desc("paused", "Whether the queue is paused.", "queue"),
+		up:        desc("up", "Whether the queue backend could be inspected."),
+	}
+}
+
+func (c *QueueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.size
+	ch <- c.latency
+	ch <- c.processed
+	ch <- c.failed
+	ch <- c.paused
+	ch <- c.up
+}
+
+func (c *QueueCollector) Collect(ch chan<- prometheus.Metric) {
+	queues, err := c.inspector.Queues()
+	if err != nil {
+		slog.Error("Failed to list the queues", slog.String("error", err.Error()))
+		ch <- prometheus.MustNewConstMetric(c.up, prometheus.GaugeValue, 0)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.up, prometheus.GaugeValue, 1)
+
+	for _, queue := range queues {
+		info, err := c.inspector.GetQueueInfo(queue)
+		if err != nil {
+			slog.Error("Failed to inspect the queue", slog.String("queue", queue), slog.String("error", err.Error()))
+			continue
+		}
+
+		states := map[string]int{
+			"pending":     info.Pending,
+			"active":      info.Active,
+			"scheduled":   info.Scheduled,
+			"retry":       info.Retry,
+			"archived":    info.Archived,
+			"completed":   info.Completed,
+			"aggregating": info.Aggregating,
+		}
+		for state, count := range states {
+			ch <- prometheus.MustNewConstMetric(c.size, prometheus.GaugeValue, float64(count), queue, state)
+		}
+
+		paused := 0.0
+		if info.Paused {
+			paused = 1
+		}
+
+		ch <- prometheus.MustNewConstMetric(c.latency, prometheus.GaugeValue, info.Latency.Seconds(), queue)
+		ch <- prometheus.MustNewConstMetric(c.processed, prometheus.CounterValue, float64(info.ProcessedTotal), queue)
+		ch <- prometheus.MustNewConstMetric(c.failed, prometheus.CounterValue, float64(info.FailedTotal), queue)
+		ch <- prometheus.MustNewConstMetric(c.paused, prometheus.GaugeValue, paused, queue)
+	}
+}
+
+// TaskMiddleware observes the duration of the tasks processed by the worker, labelled by type, queue and status.
+func TaskMiddleware(registerer prometheus.Registerer) asynq.MiddlewareFunc {
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "task",
+		Name:      "duration_seconds",
+		Help:      "Duration of the tasks processed by the worker.",
+		Buckets:   []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 300, 900},
+	}, []string{"type", "queue", "status"})
+
+	registerer.MustRegister(duration)
+
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+			start := time.Now()
+			err := next.ProcessTask(ctx, t)
+
+			status := "succeeded"
+			if err != nil {
+				status = "failed"
+			}
+
+			queue, _ := asynq.GetQueueName(ctx)
+			duration.WithLabelValues(t.Type(), queue, status).Observe(time.Since(start).Seconds())
+
+			return err
+		})
+	}
+}
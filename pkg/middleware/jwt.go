@@ -0,0 +1,73 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/golang-jwt/jwt/v5"
+	echojwt "github.com/labstack/echo-jwt/v4"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrTokenRevoked   = echo.NewHTTPError(http.StatusUnauthorized, "token was revoked")
+	ErrTokenWithoutID = echo.NewHTTPError(http.StatusUnauthorized, "token without ID")
+)
+
+// TokenRevoked returns true if the token with the ID (the jti claim) can't be used anymore.
+type TokenRevoked func(ctx context.Context, id string) (bool, error)
+
+// RevocableJWT validates the token like echojwt.WithConfig, then rejects the tokens without an ID or revoked. If
+// revoked is nil then the tokens aren't checked.
+func RevocableJWT(config echojwt.Config, revoked TokenRevoked) echo.MiddlewareFunc {
+	jwtMiddleware := echojwt.WithConfig(config)
+	if revoked == nil {
+		return jwtMiddleware
+	}
+
+	contextKey := config.ContextKey
+	if contextKey == "" {
+		contextKey = "user"
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return jwtMiddleware(func(ctx echo.Context) error {
+			token, ok := ctx.Get(contextKey).(*jwt.Token)
+			if !ok {
+				return next(ctx)
+			}
+
+			id := tokenID(token.Claims)
+			if id == "" {
+				return ErrTokenWithoutID
+			}
+
+			isRevoked, err := revoked(ctx.Request().Context(), id)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusServiceUnavailable, "cannot check the token").SetInternal(err)
+			}
+			if isRevoked {
+				return ErrTokenRevoked
+			}
+
+			return next(ctx)
+		})
+	}
+}
+
+// tokenID returns the jti claim, the custom claims must implement GetTokenID.
+func tokenID(claims jwt.Claims) string {
+	switch c := claims.(type) {
+	case jwt.MapClaims:
+		id, _ := c["jti"].(string)
+		return id
+	case interface{ GetTokenID() string }:
+		return c.GetTokenID()
+	}
+
+	return ""
+}
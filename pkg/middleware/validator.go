@@ -7,6 +7,7 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
@@ -14,6 +15,8 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	oapimw "github.com/oapi-codegen/echo-middleware"
+	"go.megpoid.dev/go-skel/pkg/patch"
+	"go.megpoid.dev/go-skel/pkg/principal"
 )
 
 type ValidatorOption func(*Validator)
@@ -42,15 +45,16 @@ func MultiErrorHandler(fn oapimw.MultiErrorHandler) ValidatorOption {
 	}
 }
 
-func JWTAuth(signingKey any) ValidatorOption {
+// JWTAuth validates the tokens signed with the key, rejecting the revoked ones if revoked isn't nil.
+func JWTAuth(signingKey any, revoked TokenRevoked) ValidatorOption {
 	return func(o *Validator) {
-		o.jwt = echojwt.JWT(signingKey)
+		o.jwt = RevocableJWT(echojwt.Config{SigningKey: signingKey}, revoked)
 	}
 }
 
-func JWTAuthWithConfig(config echojwt.Config) ValidatorOption {
+func JWTAuthWithConfig(config echojwt.Config, revoked TokenRevoked) ValidatorOption {
 	return func(o *Validator) {
-		o.jwt = echojwt.WithConfig(config)
+		o.jwt = RevocableJWT(config, revoked)
 	}
 }
 
@@ -91,6 +95,9 @@ func CustomAuth(fn echo.MiddlewareFunc) ValidatorOption {
 }
 
 func OapiValidator(spec *openapi3.T, opts ...ValidatorOption) echo.MiddlewareFunc {
+	// the merge patch bodies are plain JSON documents, kin-openapi only registers the JSON Patch ones
+	openapi3filter.RegisterBodyDecoder(patch.MIMEApplicationMergePatch, openapi3filter.JSONBodyDecoder)
+
 	options := Validator{}
 
 	for _, opt := range opts {
@@ -128,6 +135,29 @@ func (v *Validator) next(c echo.Context) error {
 	return nil
 }
 
+// ErrInsufficientScope is returned when the principal wasn't granted the scopes required by the operation.
+var ErrInsufficientScope = echo.NewHTTPError(http.StatusForbidden, "insufficient scope")
+
+// authenticate runs the authentication middleware, then checks that the principal that it set on the request has
+// the scopes required by the operation. The requests without a principal are rejected if any scope is required.
+func (v *Validator) authenticate(ctx context.Context, mw echo.MiddlewareFunc, scopes []string) error {
+	echoCtx := oapimw.GetEchoContext(ctx)
+	if err := mw(v.next)(echoCtx); err != nil {
+		return err
+	}
+
+	if len(scopes) == 0 {
+		return nil
+	}
+
+	p, ok := principal.FromContext(echoCtx.Request().Context())
+	if !ok || !p.HasScopes(scopes) {
+		return ErrInsufficientScope
+	}
+
+	return nil
+}
+
 func (v *Validator) AuthenticatorFunc() openapi3filter.AuthenticationFunc {
 	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
 		switch input.SecurityScheme.Type {
@@ -135,20 +165,17 @@ func (v *Validator) AuthenticatorFunc() openapi3filter.AuthenticationFunc {
 			switch input.SecurityScheme.Scheme {
 			case "basic":
 				if v.basic != nil {
-					echoCtx := oapimw.GetEchoContext(ctx)
-					return v.basic(v.next)(echoCtx)
+					return v.authenticate(ctx, v.basic, input.Scopes)
 				}
 			case "bearer":
 				switch input.SecurityScheme.BearerFormat {
 				case "JWT", "jwt":
 					if v.jwt != nil {
-						echoCtx := oapimw.GetEchoContext(ctx)
-						return v.jwt(v.next)(echoCtx)
+						return v.authenticate(ctx, v.jwt, input.Scopes)
 					}
 				default:
 					if v.apiKey != nil {
-						echoCtx := oapimw.GetEchoContext(ctx)
-						return v.apiKey(v.next)(echoCtx)
+						return v.authenticate(ctx, v.apiKey, input.Scopes)
 					}
 				}
 
@@ -157,9 +184,9 @@ func (v *Validator) AuthenticatorFunc() openapi3filter.AuthenticationFunc {
 			}
 		case "apiKey":
 			if v.apiKey != nil {
-				echoCtx := oapimw.GetEchoContext(ctx)
-				return v.apiKey(v.next)(echoCtx)
+				return v.authenticate(ctx, v.apiKey, input.Scopes)
 			}
+		// the scopes of the OAuth flows are checked by their provider
 		case "oauth2":
 			if v.oauth2 != nil {
 				echoCtx := oapimw.GetEchoContext(ctx)
@@ -172,8 +199,7 @@ func (v *Validator) AuthenticatorFunc() openapi3filter.AuthenticationFunc {
 			}
 		case "custom":
 			if v.custom != nil {
-				echoCtx := oapimw.GetEchoContext(ctx)
-				return v.custom(v.next)(echoCtx)
+				return v.authenticate(ctx, v.custom, input.Scopes)
 			}
 		default:
 			return fmt.Errorf("unknown security scheme type: %s", input.SecurityScheme.Type)
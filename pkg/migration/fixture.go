@@ -0,0 +1,317 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package migration
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.megpoid.dev/go-skel/pkg/model"
+	"go.megpoid.dev/go-skel/pkg/sql"
+	"gopkg.in/yaml.v3"
+)
+
+// seedEnvironmentsDirective sets the environments of an SQL seed, e.g. "-- +seed environments: dev, test".
+const seedEnvironmentsDirective = "-- +seed environments:"
+
+// fixtureFile is the layout of the YAML and JSON seeds. The fixtures are grouped by model name, then by
+// fixture key, and each fixture maps the columns to their values. A column can reference another fixture
+// with {ref: Model.key}, that is replaced by the ID of the referenced row.
+type fixtureFile struct {
+	Environments []string                             `json:"environments" yaml:"environments"`
+	Fixtures     map[string]map[string]map[string]any `json:"fixtures" yaml:"fixtures"`
+}
+
+type fixture struct {
+	table  string
+	key    string
+	values map[string]any
+}
+
+// ref returns the reference of the fixture, used as key on the resolved IDs.
+func (f *fixture) ref() string {
+	return f.table + "." + f.key
+}
+
+// resolve returns the values with the references replaced by their IDs. The missing references are returned
+// if some aren't resolved yet.
+func (f *fixture) resolve(ids map[string]any) (map[string]any, []string) {
+	values := make(map[string]any, len(f.values))
+	var missing []string
+
+	for column, value := range f.values {
+		ref, ok := fixtureRef(value)
+		if !ok {
+			values[column] = value
+			continue
+		}
+
+		id, ok := ids[ref]
+		if !ok {
+			missing = append(missing, ref)
+			continue
+		}
+		values[column] = id
+	}
+
+	return values, missing
+}
+
+// fixtureRef returns the normalized reference if the value is one.
+func fixtureRef(value any) (string, bool) {
+	fields, ok := value.(map[string]any)
+	if !ok || len(fields) != 1 {
+		return "", false
+	}
+
+	ref, ok := fields["ref"].(string)
+	if !ok {
+		return "", false
+	}
+
+	name, key, ok := strings.Cut(ref, ".")
+	if !ok {
+		return "", false
+	}
+
+	return model.TableNameFor(name) + "." + key, true
+}
+
+type seed struct {
+	id           string
+	environments []string
+	sql          string
+	fixtures     []*fixture
+}
+
+// appliesTo returns true if the seed has no environments or one of them matches.
+func (s *seed) appliesTo(environment string) bool {
+	return len(s.environments) == 0 || slices.Contains(s.environments, environment)
+}
+
+// parseSeed reads an SQL, YAML or JSON seed. Other files are ignored and return nil.
+func parseSeed(name string, data []byte) (*seed, error) {
+	s := &seed{id: name}
+
+	switch path.Ext(name) {
+	case ".sql":
+		s.sql = string(data)
+		s.environments = sqlEnvironments(data)
+		return s, nil
+	case ".yaml", ".yml":
+		var file fixtureFile
+		if err := yaml.Unmarshal(data, &file); err != nil {
+			return nil, fmt.Errorf("failed to parse seed %s: %w", name, err)
+		}
+		s.environments = file.Environments
+		s.fixtures = flattenFixtures(file.Fixtures)
+	case ".json":
+		var file fixtureFile
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&file); err != nil {
+			return nil, fmt.Errorf("failed to parse seed %s: %w", name, err)
+		}
+		s.environments = file.Environments
+		s.fixtures = flattenFixtures(file.Fixtures)
+	default:
+		return nil, nil
+	}
+
+	return s, nil
+}
+
+// sqlEnvironments reads the environments directive from the leading comments of the SQL seed.
+func sqlEnvironments(data []byte) []string {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, "--") {
+			break
+		}
+
+		if list, ok := strings.CutPrefix(line, seedEnvironmentsDirective); ok {
+			var environments []string
+			for _, env := range strings.Split(list, ",") {
+				if env = strings.TrimSpace(env); env != "" {
+					environments = append(environments, env)
+				}
+			}
+			return environments
+		}
+	}
+
+	return nil
+}
+
+// flattenFixtures returns the fixtures sorted by table and key, so they are inserted in a stable order.
+func flattenFixtures(models map[string]map[string]map[string]any) []*fixture {
+	var fixtures []*fixture
+	for _, name := range slices.Sorted(maps.Keys(models)) {
+		table := model.TableNameFor(name)
+		for _, key := range slices.Sorted(maps.Keys(models[name])) {
+			fixtures = append(fixtures, &fixture{table: table, key: key, values: models[name][key]})
+		}
+	}
+
+	return fixtures
+}
+
+// orderFixtures returns the fixtures sorted so the referenced ones come first. The already known IDs can be
+// referenced too.
+func orderFixtures(fixtures []*fixture, known map[string]any) ([]*fixture, error) {
+	resolved := make(map[string]any, len(known)+len(fixtures))
+	maps.Copy(resolved, known)
+
+	ordered := make([]*fixture, 0, len(fixtures))
+	pending := fixtures
+
+	for len(pending) > 0 {
+		var next []*fixture
+		var missing []string
+
+		for _, f := range pending {
+			if _, refs := f.resolve(resolved); len(refs) > 0 {
+				next = append(next, f)
+				missing = append(missing, fmt.Sprintf("%s -> %s", f.ref(), strings.Join(refs, ", ")))
+				continue
+			}
+			ordered = append(ordered, f)
+			resolved[f.ref()] = true
+		}
+
+		if len(next) == len(pending) {
+			return nil, fmt.Errorf("unresolved fixture references: %s", strings.Join(missing, "; "))
+		}
+		pending = next
+	}
+
+	return ordered, nil
+}
+
+// fixtureInserter inserts the fixtures of a seed and keeps the IDs for the references.
+type fixtureInserter struct {
+	conn    sql.Executor
+	table   string
+	ids     map[string]any
+	columns map[string][]string
+	now     time.Time
+}
+
+func (i *fixtureInserter) insert(ctx context.Context, fixtures []*fixture) error {
+	ordered, err := orderFixtures(fixtures, i.ids)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range ordered {
+		values, missing := f.resolve(i.ids)
+		if len(missing) > 0 {
+			return fmt.Errorf("fixture %s references rows without ID: %s", f.ref(), strings.Join(missing, ", "))
+		}
+
+		if err := i.addTimestamps(ctx, f.table, values); err != nil {
+			return err
+		}
+
+		columns := slices.Sorted(maps.Keys(values))
+		names := make([]string, len(columns))
+		placeholders := make([]string, len(columns))
+		args := make([]any, len(columns))
+		for n, column := range columns {
+			names[n] = pgx.Identifier{column}.Sanitize()
+			placeholders[n] = fmt.Sprintf("$%d", n+1)
+			args[n] = fixtureValue(values[column])
+		}
+
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s) RETURNING *",
+			pgx.Identifier{f.table}.Sanitize(), strings.Join(names, ", "), strings.Join(placeholders, ", "))
+
+		var row map[string]any
+		if err := i.conn.Get(ctx, &row, query, args...); err != nil {
+			return fmt.Errorf("failed to insert fixture %s: %w", f.ref(), err)
+		}
+
+		id, ok := row["id"]
+		if !ok {
+			// rows without ID can't be referenced
+			continue
+		}
+		i.ids[f.ref()] = id
+
+		_, err := i.conn.Exec(ctx, "INSERT INTO "+pgx.Identifier{i.table}.Sanitize()+" (table_name, key, id) VALUES ($1, $2, $3)",
+			f.table, f.key, formatID(id))
+		if err != nil {
+			return fmt.Errorf("failed to record fixture %s: %w", f.ref(), err)
+		}
+	}
+
+	return nil
+}
+
+// addTimestamps sets the created_at and updated_at columns, if the table has them and aren't set.
+func (i *fixtureInserter) addTimestamps(ctx context.Context, table string, values map[string]any) error {
+	columns, ok := i.columns[table]
+	if !ok {
+		err := i.conn.Select(ctx, &columns,
+			"SELECT column_name FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1", table)
+		if err != nil {
+			return fmt.Errorf("failed to read columns of %s: %w", table, err)
+		}
+		i.columns[table] = columns
+	}
+
+	for _, column := range []string{"created_at", "updated_at"} {
+		if _, ok := values[column]; !ok && slices.Contains(columns, column) {
+			values[column] = i.now
+		}
+	}
+
+	return nil
+}
+
+// fixtureValue converts the decoded values to types that can be sent to the database.
+func fixtureValue(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	case map[string]any, []any:
+		// stored on json columns
+		data, err := json.Marshal(v)
+		if err != nil {
+			return value
+		}
+		return string(data)
+	default:
+		return value
+	}
+}
+
+// formatID returns the text representation of the row ID, stored to resolve the references of later seeds.
+func formatID(id any) string {
+	if uuid, ok := id.([16]byte); ok {
+		return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:16])
+	}
+
+	return fmt.Sprint(id)
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const unlockTimeout = 5 * time.Second
+
+// LockKey returns the advisory lock key used for the migrations tracked on the table.
+func LockKey(tableName string) int64 {
+	if tableName == "" {
+		tableName = DefaultTableName
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte("migrations:" + tableName))
+	return int64(h.Sum64())
+}
+
+// Lock takes a session advisory lock so only one process can migrate the database at the same time, waiting
+// until the lock is released by the other process or the context is cancelled. The returned function
+// releases the lock.
+func Lock(ctx context.Context, pool *pgxpool.Pool, tableName string) (func(), error) {
+	key := LockKey(tableName)
+
+	// advisory locks belong to the session, so the same connection must be used to release it
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+
+	var locked bool
+	if err := conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&locked); err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("failed to take migration lock: %w", err)
+	}
+
+	if !locked {
+		slog.Info("Waiting for the migration lock held by another process")
+		if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", key); err != nil {
+			conn.Release()
+			return nil, fmt.Errorf("failed to take migration lock: %w", err)
+		}
+	}
+
+	return func() {
+		// the context may be already cancelled at this point
+		ctx, cancel := context.WithTimeout(context.Background(), unlockTimeout)
+		defer cancel()
+
+		if _, err := conn.Exec(ctx, "SELECT pg_advisory_unlock($1)", key); err != nil {
+			slog.Error("Failed to release migration lock", slog.String("error", err.Error()))
+			// don't return a connection that still holds the lock to the pool
+			_ = conn.Conn().Close(ctx)
+		}
+		conn.Release()
+	}, nil
+}
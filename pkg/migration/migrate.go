@@ -9,38 +9,72 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"os"
 	"path"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/jackc/pgx/v5/stdlib"
-	migrate "github.com/rubenv/sql-migrate"
 	"go.megpoid.dev/go-skel/pkg/sql"
 )
 
+// DefaultTableName is the table used to track the applied migrations.
+const DefaultTableName = "app_migrations"
+
 type Options struct {
-	TableName      string
-	Redo           bool
-	Reset          bool
-	Rollback       bool
-	Step           int
+	TableName string
+	Redo      bool
+	Reset     bool
+	Rollback  bool
+	Step      int
+	// Target is the ID, or version prefix, of the migration to migrate up to (inclusive) or to roll back to
+	// (the target stays applied)
+	Target string
+	// DryRun prints the SQL of the planned migrations instead of running them
+	DryRun bool
+	// Output is where the dry run SQL is written to, os.Stdout if nil
+	Output         io.Writer
 	MigrationAsset AssetOptions
 }
 
 type AssetOptions struct {
 	FS   embed.FS
 	Root string
+	// GoMigrations are applied along with the SQL files, sorted by ID
+	GoMigrations []GoMigration
 }
 
+// RunMigrations applies or reverts the SQL and Go migrations. The mutating runs hold an advisory lock, so only
+// one process can run the migrations at the same time.
 func RunMigrations(ctx context.Context, pool *pgxpool.Pool, opts Options) error {
-	migrations := migrate.EmbedFileSystemMigrationSource{
-		FileSystem: opts.MigrationAsset.FS,
-		Root:       opts.MigrationAsset.Root,
+	if opts.Target != "" && (opts.Reset || opts.Redo) {
+		return errors.New("a target migration can't be used with reset or redo")
+	}
+
+	if opts.TableName == "" {
+		opts.TableName = DefaultTableName
 	}
 
-	migration := migrate.MigrationSet{
-		TableName: opts.TableName,
+	if opts.Output == nil {
+		opts.Output = os.Stdout
+	}
+
+	migrations, err := loadMigrations(opts.MigrationAsset)
+	if err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		if opts.Reset {
+			return printReset(opts.Output, migrations)
+		}
+	} else {
+		unlock, err := Lock(ctx, pool, opts.TableName)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 	}
 
 	if opts.Reset {
@@ -56,17 +90,39 @@ func RunMigrations(ctx context.Context, pool *pgxpool.Pool, opts Options) error
 		slog.Info("Recreated 'public' schema")
 	}
 
+	// the dry runs don't change the database, a missing table has no applied migrations
+	if !opts.DryRun {
+		if err := ensureTable(ctx, pool, opts.TableName); err != nil {
+			return err
+		}
+	}
+
+	m := &migrator{
+		pool:       pool,
+		tableName:  opts.TableName,
+		migrations: migrations,
+		dryRun:     opts.DryRun,
+		output:     opts.Output,
+	}
+
 	step := 0
 
-	db := stdlib.OpenDBFromPool(pool)
+	// the migrations to apply can't be planned before reverting them, so they are derived from the reverted ones
+	if opts.DryRun && opts.Redo {
+		return m.printRedo(ctx, opts.Step)
+	}
 
 	if !opts.Reset && (opts.Rollback || opts.Redo) {
 		step = opts.Step
-		n, err := migration.ExecMaxContext(ctx, db, "postgres", migrations, migrate.Down, step)
+		n, err := m.execute(ctx, down, step, opts.Target)
 		if err != nil {
 			return fmt.Errorf("failed to revert migrations: %w", err)
 		}
-		slog.Info("Reverted migrations", slog.Int("count", n))
+		if opts.DryRun {
+			slog.Info("Planned migrations to revert", slog.Int("count", n))
+		} else {
+			slog.Info("Reverted migrations", slog.Int("count", n))
+		}
 	}
 
 	if opts.Reset || !opts.Rollback || opts.Redo {
@@ -74,11 +130,15 @@ func RunMigrations(ctx context.Context, pool *pgxpool.Pool, opts Options) error
 			step = opts.Step
 		}
 
-		n, err := migration.ExecMaxContext(ctx, db, "postgres", migrations, migrate.Up, step)
+		n, err := m.execute(ctx, up, step, opts.Target)
 		if err != nil {
 			return fmt.Errorf("failed to apply migrations: %w", err)
 		}
-		slog.Info("Applied migrations", slog.Int("count", n))
+		if opts.DryRun {
+			slog.Info("Planned migrations to apply", slog.Int("count", n))
+		} else {
+			slog.Info("Applied migrations", slog.Int("count", n))
+		}
 	}
 
 	return nil
@@ -0,0 +1,80 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package migration
+
+import (
+	"context"
+	"embed"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+//go:embed testdata/migrations
+var testAssets embed.FS
+
+func testMigrations() AssetOptions {
+	noop := func(ctx context.Context, tx sql.Tx) error { return nil }
+
+	return AssetOptions{
+		FS:   testAssets,
+		Root: "testdata/migrations",
+		GoMigrations: []GoMigration{
+			{ID: "20220201000000_rehash_passwords", Up: noop},
+		},
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := loadMigrations(testMigrations())
+	require.NoError(t, err)
+	require.Len(t, migrations, 3)
+
+	assert.Equal(t, "20220101000000_create_users.sql", migrations[0].id)
+	assert.NotNil(t, migrations[0].sql)
+	assert.Equal(t, "20220201000000_rehash_passwords", migrations[1].id)
+	assert.NotNil(t, migrations[1].code)
+	assert.Equal(t, "20220301000000_create_index.sql", migrations[2].id)
+	assert.False(t, transactional(migrations[2], up))
+	assert.True(t, transactional(migrations[2], down))
+
+	assets := testMigrations()
+	assets.GoMigrations = append(assets.GoMigrations, GoMigration{ID: "20220101000000_create_users.sql", Up: assets.GoMigrations[0].Up})
+	_, err = loadMigrations(assets)
+	assert.Error(t, err)
+}
+
+func TestMatchesTarget(t *testing.T) {
+	m := &migration{id: "20220627133900_create_tables.sql"}
+
+	assert.True(t, m.matches("20220627133900_create_tables.sql"))
+	assert.True(t, m.matches("20220627133900_create_tables"))
+	assert.True(t, m.matches("20220627133900"))
+	assert.False(t, m.matches("2022062713"))
+	assert.False(t, m.matches("create_tables"))
+}
+
+func TestPrintReset(t *testing.T) {
+	migrations, err := loadMigrations(testMigrations())
+	require.NoError(t, err)
+
+	var out strings.Builder
+	require.NoError(t, printReset(&out, migrations))
+
+	result := out.String()
+	assert.True(t, strings.HasPrefix(result, "DROP SCHEMA IF EXISTS public CASCADE;"))
+	assert.Contains(t, result, "-- 20220101000000_create_users.sql (up)\nCREATE TABLE users")
+	assert.Contains(t, result, "-- 20220201000000_rehash_passwords (up)\n-- Go migration")
+	assert.Contains(t, result, "-- runs without a transaction\nCREATE INDEX CONCURRENTLY")
+	assert.Less(t, strings.Index(result, "20220201000000"), strings.Index(result, "20220301000000"))
+}
+
+func TestLockKey(t *testing.T) {
+	assert.Equal(t, LockKey(""), LockKey(DefaultTableName))
+	assert.NotEqual(t, LockKey(DefaultTableName), LockKey("other_migrations"))
+}
@@ -0,0 +1,269 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+type direction int
+
+const (
+	up direction = iota
+	down
+)
+
+func (d direction) String() string {
+	if d == down {
+		return "down"
+	}
+	return "up"
+}
+
+type migrator struct {
+	pool       *pgxpool.Pool
+	tableName  string
+	migrations []*migration
+	dryRun     bool
+	output     io.Writer
+}
+
+// plan returns the migrations to run in the given direction, up to max migrations (0 for no limit) or until
+// the target migration if set. Going down, the target itself isn't reverted.
+func (m *migrator) plan(ctx context.Context, dir direction, max int, target string) ([]*migration, error) {
+	applied, err := appliedRecords(ctx, m.pool, m.tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	for id := range applied {
+		if !slices.ContainsFunc(m.migrations, func(mig *migration) bool { return mig.id == id }) {
+			return nil, fmt.Errorf("unknown migration in database: %s", id)
+		}
+	}
+
+	var plan []*migration
+	for _, mig := range m.migrations {
+		_, ok := applied[mig.id]
+		if dir == up && !ok || dir == down && ok {
+			plan = append(plan, mig)
+		}
+	}
+
+	if dir == down {
+		slices.Reverse(plan)
+	}
+
+	if target == "" {
+		if max > 0 && max < len(plan) {
+			plan = plan[:max]
+		}
+		return plan, nil
+	}
+
+	index := slices.IndexFunc(plan, func(mig *migration) bool { return mig.matches(target) })
+	if index < 0 {
+		known := slices.ContainsFunc(m.migrations, func(mig *migration) bool { return mig.matches(target) })
+		switch {
+		case !known:
+			return nil, fmt.Errorf("migration %s not found", target)
+		case dir == up:
+			// already applied, nothing to do
+			return nil, nil
+		default:
+			return nil, fmt.Errorf("migration %s isn't applied", target)
+		}
+	}
+
+	if dir == up {
+		return plan[:index+1], nil
+	}
+
+	return plan[:index], nil
+}
+
+// execute runs the planned migrations and returns the count. On a dry run the SQL is printed instead.
+func (m *migrator) execute(ctx context.Context, dir direction, max int, target string) (int, error) {
+	plan, err := m.plan(ctx, dir, max, target)
+	if err != nil {
+		return 0, err
+	}
+
+	if m.dryRun {
+		return len(plan), printPlan(m.output, plan, dir)
+	}
+
+	for i, mig := range plan {
+		start := time.Now()
+		if err := m.run(ctx, mig, dir); err != nil {
+			return i, fmt.Errorf("migration %s failed: %w", mig.id, err)
+		}
+		slog.Debug("Ran migration",
+			slog.String("id", mig.id),
+			slog.String("direction", dir.String()),
+			slog.Duration("duration", time.Since(start)),
+		)
+	}
+
+	return len(plan), nil
+}
+
+// run executes a migration and updates the migration table in the same transaction, unless it's an SQL
+// migration with the transaction disabled.
+func (m *migrator) run(ctx context.Context, mig *migration, dir direction) error {
+	conn := sql.NewPgxPool(m.pool)
+
+	if mig.sql != nil && !transactional(mig, dir) {
+		for _, query := range queries(mig, dir) {
+			if _, err := conn.Exec(ctx, query); err != nil {
+				return err
+			}
+		}
+		return m.record(ctx, conn, mig, dir)
+	}
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func(tx sql.Tx, ctx context.Context) {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Error("Failed to rollback transaction", slog.String("error", err.Error()))
+		}
+	}(tx, ctx)
+
+	if mig.code != nil {
+		fn := mig.code.Up
+		if dir == down {
+			fn = mig.code.Down
+		}
+		if fn == nil {
+			return errors.New("the migration can't be reverted")
+		}
+		if err := fn(ctx, tx); err != nil {
+			return err
+		}
+	} else {
+		for _, query := range queries(mig, dir) {
+			if _, err := tx.Exec(ctx, query); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := m.record(ctx, tx, mig, dir); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (m *migrator) record(ctx context.Context, conn sql.Executor, mig *migration, dir direction) error {
+	table := pgx.Identifier{m.tableName}.Sanitize()
+
+	var err error
+	if dir == up {
+		_, err = conn.Exec(ctx, "INSERT INTO "+table+" (id, applied_at) VALUES ($1, $2)", mig.id, time.Now())
+	} else {
+		_, err = conn.Exec(ctx, "DELETE FROM "+table+" WHERE id = $1", mig.id)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update migration table: %w", err)
+	}
+
+	return nil
+}
+
+// printRedo prints the statements to revert the last migrations and then apply them again.
+func (m *migrator) printRedo(ctx context.Context, step int) error {
+	reverted, err := m.plan(ctx, down, step, "")
+	if err != nil {
+		return err
+	}
+
+	if err := printPlan(m.output, reverted, down); err != nil {
+		return err
+	}
+
+	slog.Info("Planned migrations to redo", slog.Int("count", len(reverted)))
+
+	reapplied := slices.Clone(reverted)
+	slices.Reverse(reapplied)
+
+	return printPlan(m.output, reapplied, up)
+}
+
+func transactional(mig *migration, dir direction) bool {
+	if mig.sql == nil {
+		return true
+	}
+	if dir == down {
+		return !mig.sql.DisableTransactionDown
+	}
+	return !mig.sql.DisableTransactionUp
+}
+
+func queries(mig *migration, dir direction) []string {
+	if mig.sql == nil {
+		return nil
+	}
+	if dir == down {
+		return mig.sql.Down
+	}
+	return mig.sql.Up
+}
+
+func printPlan(w io.Writer, plan []*migration, dir direction) error {
+	for _, mig := range plan {
+		if _, err := fmt.Fprintf(w, "-- %s (%s)\n", mig.id, dir); err != nil {
+			return err
+		}
+		if mig.code != nil {
+			if _, err := fmt.Fprintln(w, "-- Go migration, the statements are only known when it runs"); err != nil {
+				return err
+			}
+		} else if !transactional(mig, dir) {
+			if _, err := fmt.Fprintln(w, "-- runs without a transaction"); err != nil {
+				return err
+			}
+		}
+		for _, query := range queries(mig, dir) {
+			if _, err := fmt.Fprintln(w, strings.TrimSpace(query)); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// printReset prints the statements of a reset, that is, recreating the schema and applying all the migrations.
+func printReset(w io.Writer, migrations []*migration) error {
+	if _, err := fmt.Fprint(w, "DROP SCHEMA IF EXISTS public CASCADE;\nCREATE SCHEMA public;\n\n"); err != nil {
+		return err
+	}
+
+	return printPlan(w, migrations, up)
+}
@@ -0,0 +1,183 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+const (
+	// DefaultSeedTableName is the table used to track the applied seeds.
+	DefaultSeedTableName = "app_seeds"
+	// DefaultEnvironment is used to filter the seeds if none is set.
+	DefaultEnvironment = "dev"
+)
+
+type SeedOptions struct {
+	// TableName tracks the applied seeds, the fixture IDs are stored on the table with the _fixtures suffix
+	TableName string
+	// Environment selects the seeds to apply, the seeds without environments are applied on all of them
+	Environment string
+	SeedAsset   AssetOptions
+}
+
+// RunSeeds applies the SQL, YAML and JSON seeds that weren't applied before, in file name order. Each seed runs
+// in its own transaction and is recorded, so it only runs once. The fixtures of the YAML and JSON seeds can
+// reference the rows inserted by previous seeds.
+func RunSeeds(ctx context.Context, pool *pgxpool.Pool, opts SeedOptions) (int, error) {
+	if opts.TableName == "" {
+		opts.TableName = DefaultSeedTableName
+	}
+	if opts.Environment == "" {
+		opts.Environment = DefaultEnvironment
+	}
+
+	seeds, err := loadSeeds(opts.SeedAsset)
+	if err != nil {
+		return 0, err
+	}
+
+	unlock, err := Lock(ctx, pool, opts.TableName)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	fixtureTable := opts.TableName + "_fixtures"
+
+	if err := ensureTable(ctx, pool, opts.TableName); err != nil {
+		return 0, err
+	}
+
+	applied, err := appliedRecords(ctx, pool, opts.TableName)
+	if err != nil {
+		return 0, err
+	}
+
+	ids, err := fixtureIDs(ctx, pool, fixtureTable)
+	if err != nil {
+		return 0, err
+	}
+
+	conn := sql.NewPgxPool(pool)
+	count := 0
+
+	for _, s := range seeds {
+		if _, ok := applied[s.id]; ok {
+			continue
+		}
+
+		if !s.appliesTo(opts.Environment) {
+			slog.Debug("Skipping seed of another environment", slog.String("name", s.id))
+			continue
+		}
+
+		slog.Debug("Applying seed", slog.String("name", s.id))
+
+		err := conn.BeginFunc(ctx, func(tx sql.Tx) error {
+			if s.sql != "" {
+				if _, err := tx.Exec(ctx, s.sql); err != nil {
+					return err
+				}
+			}
+
+			inserter := &fixtureInserter{
+				conn:    tx,
+				table:   fixtureTable,
+				ids:     ids,
+				columns: make(map[string][]string),
+				now:     time.Now(),
+			}
+			if err := inserter.insert(ctx, s.fixtures); err != nil {
+				return err
+			}
+
+			_, err := tx.Exec(ctx, "INSERT INTO "+pgx.Identifier{opts.TableName}.Sanitize()+" (id, applied_at) VALUES ($1, $2)",
+				s.id, time.Now())
+			return err
+		})
+		if err != nil {
+			return count, fmt.Errorf("failed to apply seed %s: %w", s.id, err)
+		}
+
+		count++
+	}
+
+	slog.Info("Applied seeds", slog.Int("count", count), slog.String("environment", opts.Environment))
+
+	return count, nil
+}
+
+// loadSeeds reads the seeds sorted by file name.
+func loadSeeds(assets AssetOptions) ([]*seed, error) {
+	entries, err := assets.FS.ReadDir(assets.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	var seeds []*seed
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		data, err := assets.FS.ReadFile(path.Join(assets.Root, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		s, err := parseSeed(entry.Name(), data)
+		if err != nil {
+			return nil, err
+		}
+		if s != nil {
+			seeds = append(seeds, s)
+		}
+	}
+
+	return seeds, nil
+}
+
+// fixtureIDs returns the IDs of the fixtures inserted by the applied seeds.
+func fixtureIDs(ctx context.Context, pool *pgxpool.Pool, tableName string) (map[string]any, error) {
+	table := pgx.Identifier{tableName}.Sanitize()
+
+	_, err := pool.Exec(ctx, "CREATE TABLE IF NOT EXISTS "+table+" (table_name text NOT NULL, key text NOT NULL, id text NOT NULL, PRIMARY KEY (table_name, key))")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fixture table: %w", err)
+	}
+
+	rows, err := pool.Query(ctx, "SELECT table_name, key, id FROM "+table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixtures: %w", err)
+	}
+
+	ids := make(map[string]any)
+
+	var name, key, id string
+	_, err = pgx.ForEachRow(rows, []any{&name, &key, &id}, func() error {
+		// the IDs are stored as text, restore the integer ones
+		if n, err := strconv.ParseInt(id, 10, 64); err == nil {
+			ids[name+"."+key] = n
+		} else {
+			ids[name+"."+key] = id
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixtures: %w", err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package migration
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSeedSQL(t *testing.T) {
+	s, err := parseSeed("01_initial.sql", []byte("-- comment\n-- +seed environments: dev, test\ndelete from profiles;"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev", "test"}, s.environments)
+	assert.True(t, s.appliesTo("test"))
+	assert.False(t, s.appliesTo("staging"))
+
+	s, err = parseSeed("02_other.sql", []byte("delete from profiles;\n-- +seed environments: dev"))
+	require.NoError(t, err)
+	assert.Empty(t, s.environments)
+	assert.True(t, s.appliesTo("staging"))
+
+	s, err = parseSeed("README.md", []byte("# seeds"))
+	require.NoError(t, err)
+	assert.Nil(t, s)
+}
+
+func TestParseSeedFixtures(t *testing.T) {
+	yamlSeed := `
+environments: [staging]
+fixtures:
+  Profile:
+    john:
+      first_name: John
+      company_id: {ref: Company.acme}
+  Company:
+    acme:
+      name: Acme
+      settings: {theme: dark}
+`
+	s, err := parseSeed("01_fixtures.yaml", []byte(yamlSeed))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"staging"}, s.environments)
+	require.Len(t, s.fixtures, 2)
+	assert.Equal(t, "companies.acme", s.fixtures[0].ref())
+	assert.Equal(t, "profiles.john", s.fixtures[1].ref())
+
+	jsonSeed := `{"fixtures": {"profile": {"jane": {"first_name": "Jane", "age": 30, "company_id": {"ref": "company.acme"}}}}}`
+	s, err = parseSeed("02_fixtures.json", []byte(jsonSeed))
+	require.NoError(t, err)
+	require.Len(t, s.fixtures, 1)
+	assert.Equal(t, "profiles.jane", s.fixtures[0].ref())
+	assert.Equal(t, int64(30), fixtureValue(s.fixtures[0].values["age"]))
+
+	ref, ok := fixtureRef(s.fixtures[0].values["company_id"])
+	assert.True(t, ok)
+	assert.Equal(t, "companies.acme", ref)
+}
+
+func TestOrderFixtures(t *testing.T) {
+	profile := &fixture{table: "profiles", key: "john", values: map[string]any{
+		"company_id": map[string]any{"ref": "Company.acme"},
+	}}
+	company := &fixture{table: "companies", key: "acme", values: map[string]any{
+		"name":     "Acme",
+		"settings": map[string]any{"theme": "dark"},
+	}}
+
+	ordered, err := orderFixtures([]*fixture{profile, company}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*fixture{company, profile}, ordered)
+
+	values, missing := profile.resolve(map[string]any{"companies.acme": int64(7)})
+	assert.Empty(t, missing)
+	assert.Equal(t, int64(7), values["company_id"])
+
+	// references to the fixtures of previous seeds
+	ordered, err = orderFixtures([]*fixture{profile}, map[string]any{"companies.acme": int64(7)})
+	require.NoError(t, err)
+	assert.Len(t, ordered, 1)
+
+	_, err = orderFixtures([]*fixture{profile}, nil)
+	assert.ErrorContains(t, err, "profiles.john -> companies.acme")
+
+	settings, ok := fixtureValue(company.values["settings"]).(string)
+	assert.True(t, ok)
+	assert.True(t, json.Valid([]byte(settings)))
+}
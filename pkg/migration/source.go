@@ -0,0 +1,140 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	migrate "github.com/rubenv/sql-migrate"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+// GoMigrationFunc applies or reverts a migration inside the transaction.
+type GoMigrationFunc func(ctx context.Context, tx sql.Tx) error
+
+// GoMigration is a migration written in Go, for the changes that can't be done in plain SQL. The ID must
+// sort alongside the SQL files, so it should use the same timestamp prefix.
+type GoMigration struct {
+	ID   string
+	Up   GoMigrationFunc
+	Down GoMigrationFunc // Optional, the migration can't be reverted if nil
+}
+
+// migration is either an SQL or a Go migration.
+type migration struct {
+	id   string
+	sql  *migrate.Migration
+	code *GoMigration
+}
+
+func (m *migration) less(other *migration) bool {
+	return migrate.Migration{Id: m.id}.Less(&migrate.Migration{Id: other.id})
+}
+
+// matches returns true if the target is the migration ID, with or without extension, or its version.
+func (m *migration) matches(target string) bool {
+	if m.id == target || strings.TrimSuffix(m.id, path.Ext(m.id)) == target {
+		return true
+	}
+
+	prefix := migrate.Migration{Id: m.id}.NumberPrefixMatches()
+	return len(prefix) > 1 && prefix[1] == target
+}
+
+// loadMigrations returns the SQL and Go migrations sorted in the order they are applied.
+func loadMigrations(assets AssetOptions) ([]*migration, error) {
+	source := migrate.EmbedFileSystemMigrationSource{
+		FileSystem: assets.FS,
+		Root:       assets.Root,
+	}
+
+	files, err := source.FindMigrations()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load migrations: %w", err)
+	}
+
+	migrations := make([]*migration, 0, len(files)+len(assets.GoMigrations))
+	ids := make(map[string]struct{}, cap(migrations))
+
+	for _, file := range files {
+		ids[file.Id] = struct{}{}
+		migrations = append(migrations, &migration{id: file.Id, sql: file})
+	}
+
+	for i := range assets.GoMigrations {
+		code := &assets.GoMigrations[i]
+		if code.ID == "" || code.Up == nil {
+			return nil, errors.New("go migrations must have an ID and an up function")
+		}
+		if _, ok := ids[code.ID]; ok {
+			return nil, fmt.Errorf("duplicated migration ID: %s", code.ID)
+		}
+		ids[code.ID] = struct{}{}
+		migrations = append(migrations, &migration{id: code.ID, code: code})
+	}
+
+	sort.SliceStable(migrations, func(i, j int) bool {
+		return migrations[i].less(migrations[j])
+	})
+
+	return migrations, nil
+}
+
+// ensureTable creates the table of the applied migrations, or seeds, if it doesn't exist. It uses the same layout
+// as sql-migrate so the existing databases keep working. Only the runs that change the database call it.
+func ensureTable(ctx context.Context, pool *pgxpool.Pool, tableName string) error {
+	table := pgx.Identifier{tableName}.Sanitize()
+
+	_, err := pool.Exec(ctx, "CREATE TABLE IF NOT EXISTS "+table+" (id text NOT NULL PRIMARY KEY, applied_at timestamp with time zone)")
+	if err != nil {
+		return fmt.Errorf("failed to create tracking table: %w", err)
+	}
+
+	return nil
+}
+
+// appliedRecords returns the applied migrations, or seeds, with their timestamp. It doesn't change the database,
+// nothing is applied if the table doesn't exist yet.
+func appliedRecords(ctx context.Context, pool *pgxpool.Pool, tableName string) (map[string]time.Time, error) {
+	table := pgx.Identifier{tableName}.Sanitize()
+
+	var exists bool
+	if err := pool.QueryRow(ctx, "SELECT to_regclass($1) IS NOT NULL", table).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	if !exists {
+		return map[string]time.Time{}, nil
+	}
+
+	rows, err := pool.Query(ctx, "SELECT id, applied_at FROM "+table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	applied := make(map[string]time.Time)
+
+	var id string
+	var appliedAt *time.Time
+	_, err = pgx.ForEachRow(rows, []any{&id, &appliedAt}, func() error {
+		applied[id] = time.Time{}
+		if appliedAt != nil {
+			applied[id] = *appliedAt
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package migration
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Status represents the state of a migration on the database.
+type Status struct {
+	ID        string
+	Applied   bool
+	AppliedAt time.Time
+	// Go is set for the migrations written in Go
+	Go bool
+	// Unknown is set for applied migrations that aren't on the source, like the ones from a newer release
+	Unknown bool
+}
+
+// Statuses returns the state of the migrations of the source and the ones recorded on the database, sorted
+// in the order they are applied. It only reads the database, so it can run without the privileges to migrate it.
+func Statuses(ctx context.Context, pool *pgxpool.Pool, tableName string, assets AssetOptions) ([]Status, error) {
+	if tableName == "" {
+		tableName = DefaultTableName
+	}
+
+	migrations, err := loadMigrations(assets)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := appliedRecords(ctx, pool, tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]Status, len(migrations)+len(applied))
+
+	for _, m := range migrations {
+		status := Status{ID: m.id, Go: m.code != nil}
+		if appliedAt, ok := applied[m.id]; ok {
+			status.Applied = true
+			status.AppliedAt = appliedAt
+		}
+		result[m.id] = status
+	}
+
+	for id, appliedAt := range applied {
+		if _, ok := result[id]; ok {
+			continue
+		}
+		migrations = append(migrations, &migration{id: id})
+		result[id] = Status{ID: id, Applied: true, AppliedAt: appliedAt, Unknown: true}
+	}
+
+	// uses the same order as the migrations are applied
+	sort.SliceStable(migrations, func(i, j int) bool {
+		return migrations[i].less(migrations[j])
+	})
+
+	statuses := make([]Status, 0, len(migrations))
+	for _, m := range migrations {
+		statuses = append(statuses, result[m.id])
+	}
+
+	return statuses, nil
+}
+
+// PendingMigrations returns the IDs of the migrations that aren't applied to the database yet. Applied
+// migrations unknown to the source, like the ones from a newer release, are ignored.
+func PendingMigrations(ctx context.Context, pool *pgxpool.Pool, tableName string, assets AssetOptions) ([]string, error) {
+	statuses, err := Statuses(ctx, pool, tableName, assets)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []string
+	for _, status := range statuses {
+		if !status.Applied {
+			pending = append(pending, status.ID)
+		}
+	}
+
+	return pending, nil
+}
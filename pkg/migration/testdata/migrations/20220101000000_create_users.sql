@@ -0,0 +1,5 @@
+-- +migrate Up
+CREATE TABLE users (id bigint PRIMARY KEY, password text NOT NULL);
+
+-- +migrate Down
+DROP TABLE users;
@@ -0,0 +1,5 @@
+-- +migrate Up notransaction
+CREATE INDEX CONCURRENTLY users_password_idx ON users (password);
+
+-- +migrate Down
+DROP INDEX users_password_idx;
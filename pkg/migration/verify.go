@@ -0,0 +1,294 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package migration
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DefaultSchema is the live schema compared by Verify if none is set.
+const DefaultSchema = "public"
+
+type VerifyOptions struct {
+	// Schema is the live schema to compare against the migrations
+	Schema         string
+	TableName      string
+	MigrationAsset AssetOptions
+}
+
+// DriftKind tells how an object of the live schema differs from the migrations.
+type DriftKind int
+
+const (
+	// DriftMissing is an object created by the migrations that isn't on the live schema
+	DriftMissing DriftKind = iota
+	// DriftUnexpected is an object of the live schema that isn't created by the migrations
+	DriftUnexpected
+	// DriftChanged is an object with a different definition on the live schema
+	DriftChanged
+)
+
+// Drift is a difference between the schema created by the migrations and the live one.
+type Drift struct {
+	Kind     DriftKind
+	Object   string
+	Expected string
+	Actual   string
+}
+
+func (d Drift) String() string {
+	switch d.Kind {
+	case DriftMissing:
+		return fmt.Sprintf("- %s: %s", d.Object, d.Expected)
+	case DriftUnexpected:
+		return fmt.Sprintf("+ %s: %s", d.Object, d.Actual)
+	default:
+		return fmt.Sprintf("~ %s\n    expected: %s\n    actual:   %s", d.Object, d.Expected, d.Actual)
+	}
+}
+
+// Snapshot maps the objects of a schema, like "column profiles.email", to their definitions.
+type Snapshot map[string]string
+
+// Verify applies all the migrations to a scratch schema and compares it with the live one. The scratch
+// schema is dropped afterwards. The returned drifts are empty if both schemas match.
+func Verify(ctx context.Context, pool *pgxpool.Pool, opts VerifyOptions) ([]Drift, error) {
+	if opts.Schema == "" {
+		opts.Schema = DefaultSchema
+	}
+	if opts.TableName == "" {
+		opts.TableName = DefaultTableName
+	}
+
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, err
+	}
+	scratch := "verify_" + hex.EncodeToString(suffix)
+
+	if _, err := pool.Exec(ctx, "CREATE SCHEMA "+pgx.Identifier{scratch}.Sanitize()); err != nil {
+		return nil, fmt.Errorf("failed to create scratch schema: %w", err)
+	}
+
+	defer func() {
+		// the context could be cancelled already, the schema must be removed anyway
+		_, _ = pool.Exec(context.WithoutCancel(ctx), "DROP SCHEMA IF EXISTS "+pgx.Identifier{scratch}.Sanitize()+" CASCADE")
+	}()
+
+	// the public schema is kept on the path for the extensions, the new objects are created on the first one
+	config := pool.Config().Copy()
+	config.ConnConfig.RuntimeParams["search_path"] = scratch + ", public"
+
+	scratchPool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to scratch schema: %w", err)
+	}
+	defer scratchPool.Close()
+
+	err = RunMigrations(ctx, scratchPool, Options{
+		TableName:      opts.TableName,
+		Output:         io.Discard,
+		MigrationAsset: opts.MigrationAsset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate scratch schema: %w", err)
+	}
+
+	// the tracking tables aren't created by the migrations
+	ignored := []string{opts.TableName, DefaultSeedTableName, DefaultSeedTableName + "_fixtures"}
+
+	expected, err := Introspect(ctx, pool, scratch, ignored...)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, err := Introspect(ctx, pool, opts.Schema, ignored...)
+	if err != nil {
+		return nil, err
+	}
+
+	return Diff(expected, actual), nil
+}
+
+// Introspect reads the tables, columns, indexes, constraints and domains of the schema. The schema name is
+// removed from the definitions so snapshots of different schemas can be compared.
+func Introspect(ctx context.Context, pool *pgxpool.Pool, schema string, ignoredTables ...string) (Snapshot, error) {
+	snapshot := make(Snapshot)
+	qualifiers := []string{pgx.Identifier{schema}.Sanitize() + ".", schema + "."}
+
+	add := func(object, table, definition string) {
+		if slices.Contains(ignoredTables, table) {
+			return
+		}
+		for _, qualifier := range qualifiers {
+			definition = strings.ReplaceAll(definition, qualifier, "")
+		}
+		snapshot[object] = definition
+	}
+
+	queries := []struct {
+		name  string
+		query string
+		scan  func(rows pgx.Rows) error
+	}{
+		{
+			name: "tables",
+			query: `SELECT c.relname, CASE c.relkind WHEN 'v' THEN 'view' WHEN 'm' THEN 'materialized view' ELSE 'table' END
+				FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+				WHERE n.nspname = $1 AND c.relkind IN ('r', 'p', 'v', 'm')`,
+			scan: func(rows pgx.Rows) error {
+				var name, kind string
+				_, err := pgx.ForEachRow(rows, []any{&name, &kind}, func() error {
+					add(kind+" "+name, name, kind)
+					return nil
+				})
+				return err
+			},
+		},
+		{
+			name: "columns",
+			query: `SELECT c.relname, a.attname, format_type(a.atttypid, a.atttypmod), a.attnotnull,
+					coalesce(pg_get_expr(d.adbin, d.adrelid), ''), a.attidentity::text, a.attgenerated::text
+				FROM pg_attribute a
+					JOIN pg_class c ON c.oid = a.attrelid
+					JOIN pg_namespace n ON n.oid = c.relnamespace
+					LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+				WHERE n.nspname = $1 AND c.relkind IN ('r', 'p', 'v', 'm') AND a.attnum > 0 AND NOT a.attisdropped`,
+			scan: func(rows pgx.Rows) error {
+				var table, column, dataType, defaultValue, identity, generated string
+				var notNull bool
+				_, err := pgx.ForEachRow(rows, []any{&table, &column, &dataType, &notNull, &defaultValue, &identity, &generated}, func() error {
+					add("column "+table+"."+column, table, columnDefinition(dataType, notNull, defaultValue, identity, generated))
+					return nil
+				})
+				return err
+			},
+		},
+		{
+			name:  "indexes",
+			query: `SELECT tablename, indexname, indexdef FROM pg_indexes WHERE schemaname = $1`,
+			scan: func(rows pgx.Rows) error {
+				var table, name, definition string
+				_, err := pgx.ForEachRow(rows, []any{&table, &name, &definition}, func() error {
+					add("index "+name, table, definition)
+					return nil
+				})
+				return err
+			},
+		},
+		{
+			name: "constraints",
+			query: `SELECT c.relname, con.conname, pg_get_constraintdef(con.oid)
+				FROM pg_constraint con
+					JOIN pg_class c ON c.oid = con.conrelid
+					JOIN pg_namespace n ON n.oid = con.connamespace
+				WHERE n.nspname = $1`,
+			scan: func(rows pgx.Rows) error {
+				var table, name, definition string
+				_, err := pgx.ForEachRow(rows, []any{&table, &name, &definition}, func() error {
+					add("constraint "+table+"."+name, table, definition)
+					return nil
+				})
+				return err
+			},
+		},
+		{
+			name: "domains",
+			query: `SELECT t.typname, format_type(t.typbasetype, t.typtypmod), t.typnotnull,
+					coalesce(string_agg(pg_get_constraintdef(con.oid), ' ' ORDER BY con.conname), '')
+				FROM pg_type t
+					JOIN pg_namespace n ON n.oid = t.typnamespace
+					LEFT JOIN pg_constraint con ON con.contypid = t.oid
+				WHERE n.nspname = $1 AND t.typtype = 'd'
+				GROUP BY t.typname, t.typbasetype, t.typtypmod, t.typnotnull`,
+			scan: func(rows pgx.Rows) error {
+				var name, baseType, checks string
+				var notNull bool
+				_, err := pgx.ForEachRow(rows, []any{&name, &baseType, &notNull, &checks}, func() error {
+					definition := columnDefinition(baseType, notNull, "", "", "")
+					if checks != "" {
+						definition += " " + checks
+					}
+					add("domain "+name, "", definition)
+					return nil
+				})
+				return err
+			},
+		},
+	}
+
+	for _, q := range queries {
+		rows, err := pool.Query(ctx, q.query, schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s of schema %s: %w", q.name, schema, err)
+		}
+		if err := q.scan(rows); err != nil {
+			return nil, fmt.Errorf("failed to read %s of schema %s: %w", q.name, schema, err)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// Diff returns the differences between the expected and actual snapshots, sorted by object.
+func Diff(expected, actual Snapshot) []Drift {
+	objects := slices.Sorted(maps.Keys(expected))
+	for object := range actual {
+		if _, ok := expected[object]; !ok {
+			objects = append(objects, object)
+		}
+	}
+	slices.Sort(objects)
+
+	var drifts []Drift
+	for _, object := range objects {
+		want, inExpected := expected[object]
+		got, inActual := actual[object]
+
+		switch {
+		case !inActual:
+			drifts = append(drifts, Drift{Kind: DriftMissing, Object: object, Expected: want})
+		case !inExpected:
+			drifts = append(drifts, Drift{Kind: DriftUnexpected, Object: object, Actual: got})
+		case want != got:
+			drifts = append(drifts, Drift{Kind: DriftChanged, Object: object, Expected: want, Actual: got})
+		}
+	}
+
+	return drifts
+}
+
+func columnDefinition(dataType string, notNull bool, defaultValue, identity, generated string) string {
+	definition := dataType
+	if notNull {
+		definition += " NOT NULL"
+	}
+
+	switch {
+	case generated == "s":
+		definition += " GENERATED ALWAYS AS (" + defaultValue + ") STORED"
+	case defaultValue != "":
+		definition += " DEFAULT " + defaultValue
+	}
+
+	switch identity {
+	case "a":
+		definition += " GENERATED ALWAYS AS IDENTITY"
+	case "d":
+		definition += " GENERATED BY DEFAULT AS IDENTITY"
+	}
+
+	return definition
+}
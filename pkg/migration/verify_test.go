@@ -0,0 +1,45 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	expected := Snapshot{
+		"table users":                 "table",
+		"column users.email":          "email NOT NULL",
+		"column users.name":           "text",
+		"index users_email_idx":       "CREATE UNIQUE INDEX users_email_idx ON users USING btree (email)",
+		"constraint users.users_pkey": "PRIMARY KEY (id)",
+	}
+	actual := Snapshot{
+		"table users":                 "table",
+		"column users.email":          "email NOT NULL",
+		"column users.name":           "character varying(50)",
+		"column users.nickname":       "text",
+		"constraint users.users_pkey": "PRIMARY KEY (id)",
+	}
+
+	assert.Empty(t, Diff(expected, expected))
+
+	drifts := Diff(expected, actual)
+	assert.Equal(t, []Drift{
+		{Kind: DriftChanged, Object: "column users.name", Expected: "text", Actual: "character varying(50)"},
+		{Kind: DriftUnexpected, Object: "column users.nickname", Actual: "text"},
+		{Kind: DriftMissing, Object: "index users_email_idx", Expected: "CREATE UNIQUE INDEX users_email_idx ON users USING btree (email)"},
+	}, drifts)
+
+	assert.Equal(t, "+ column users.nickname: text", drifts[1].String())
+}
+
+func TestColumnDefinition(t *testing.T) {
+	assert.Equal(t, "bigint NOT NULL GENERATED ALWAYS AS IDENTITY", columnDefinition("bigint", true, "", "a", ""))
+	assert.Equal(t, "timestamp with time zone NOT NULL DEFAULT now()", columnDefinition("timestamp with time zone", true, "now()", "", ""))
+	assert.Equal(t, "text GENERATED ALWAYS AS (lower(name)) STORED", columnDefinition("text", false, "lower(name)", "", "s"))
+}
@@ -62,8 +62,11 @@ func GetTableName[T Modelable](m T) string {
 		return m.TableName()
 	}
 
-	name := GetModelName[T](m)
+	return TableNameFor(GetModelName[T](m))
+}
 
+// TableNameFor returns the default table name of a model by its name, e.g. Profile is stored on profiles.
+func TableNameFor(name string) string {
 	return inflection.Plural(dbscan.SnakeCaseMapper(name))
 }
 
@@ -43,6 +43,9 @@ func TestTableName(t *testing.T) {
 
 	name = GetTableName(&GlobalCompany{})
 	assert.Equal(t, "company", name)
+
+	assert.Equal(t, "local_companies", TableNameFor("LocalCompany"))
+	assert.Equal(t, "profiles", TableNameFor("profile"))
 }
 
 func TestModelName(t *testing.T) {
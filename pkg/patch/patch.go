@@ -0,0 +1,501 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+// Package patch applies JSON Merge Patch (RFC 7396) and JSON Patch (RFC 6902) documents.
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+)
+
+const (
+	MIMEApplicationMergePatch = "application/merge-patch+json"
+	MIMEApplicationJSONPatch  = "application/json-patch+json"
+)
+
+var (
+	// ErrUnsupportedMediaType is returned when the content type isn't a known patch format.
+	ErrUnsupportedMediaType = errors.New("unsupported patch media type")
+	// ErrInvalidPatch is returned when the patch document is malformed.
+	ErrInvalidPatch = errors.New("invalid patch document")
+	// ErrCannotApply is returned when a valid patch can't be applied to the document, e.g. a path doesn't exist
+	// or a test operation failed.
+	ErrCannotApply = errors.New("patch cannot be applied")
+)
+
+// Patch modifies a JSON document.
+type Patch interface {
+	Apply(doc []byte) ([]byte, error)
+}
+
+// IsPatch returns true if the content type is one of the supported patch formats.
+func IsPatch(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == MIMEApplicationMergePatch || mediaType == MIMEApplicationJSONPatch
+}
+
+// Parse reads the patch document using the format of the content type.
+func Parse(contentType string, data []byte) (Patch, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMediaType, contentType)
+	}
+
+	switch mediaType {
+	case MIMEApplicationMergePatch:
+		return ParseMergePatch(data)
+	case MIMEApplicationJSONPatch:
+		return ParseJSONPatch(data)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMediaType, mediaType)
+	}
+}
+
+// ApplyTo applies the patch to the JSON representation of current and decodes the result into dst. The patched
+// document can't have fields unknown to dst.
+func ApplyTo(p Patch, current, dst any) error {
+	doc, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+
+	result, err := p.Apply(doc)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(result))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(dst); err != nil {
+		return fmt.Errorf("%w: %w", ErrCannotApply, err)
+	}
+
+	return nil
+}
+
+// decode reads a JSON value keeping the numbers as written, so they aren't altered by a round trip.
+func decode(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after the JSON value")
+	}
+
+	return value, nil
+}
+
+// MergePatch is a JSON Merge Patch document, the members of the objects replace the ones of the target and the
+// null members are removed.
+type MergePatch struct {
+	patch any
+}
+
+func ParseMergePatch(data []byte) (*MergePatch, error) {
+	value, err := decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+	}
+
+	return &MergePatch{patch: value}, nil
+}
+
+func (p *MergePatch) Apply(doc []byte) ([]byte, error) {
+	target, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mergePatch(target, p.patch))
+}
+
+func mergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = mergePatch(targetObject[key], value)
+		}
+	}
+
+	return targetObject
+}
+
+// Operation is a single step of a JSON Patch.
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// JSONPatch is a list of operations applied in order, if one fails the document is left unchanged.
+type JSONPatch struct {
+	operations []operation
+}
+
+// operation is a validated Operation, with the pointers parsed and the value decoded.
+type operation struct {
+	op    string
+	path  pointer
+	from  pointer
+	value any
+}
+
+func ParseJSONPatch(data []byte) (*JSONPatch, error) {
+	var ops []Operation
+	if err := json.Unmarshal(data, &ops); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPatch, err)
+	}
+
+	p := &JSONPatch{operations: make([]operation, 0, len(ops))}
+	for i, op := range ops {
+		parsed, err := parseOperation(op)
+		if err != nil {
+			return nil, fmt.Errorf("%w: operation %d: %w", ErrInvalidPatch, i, err)
+		}
+		p.operations = append(p.operations, parsed)
+	}
+
+	return p, nil
+}
+
+func parseOperation(op Operation) (operation, error) {
+	var err error
+	result := operation{op: op.Op}
+
+	if result.path, err = parsePointer(op.Path); err != nil {
+		return result, err
+	}
+
+	switch op.Op {
+	case "add", "replace", "test":
+		if len(op.Value) == 0 {
+			return result, errors.New("missing value")
+		}
+		if result.value, err = decode(op.Value); err != nil {
+			return result, err
+		}
+	case "move", "copy":
+		if result.from, err = parsePointer(op.From); err != nil {
+			return result, err
+		}
+	case "remove":
+	default:
+		return result, fmt.Errorf("unknown operation %q", op.Op)
+	}
+
+	return result, nil
+}
+
+func (p *JSONPatch) Apply(doc []byte) ([]byte, error) {
+	value, err := decode(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, op := range p.operations {
+		if value, err = op.apply(value); err != nil {
+			return nil, fmt.Errorf("%w: operation %d (%s %s): %w", ErrCannotApply, i, op.op, op.path, err)
+		}
+	}
+
+	return json.Marshal(value)
+}
+
+func (op operation) apply(doc any) (any, error) {
+	switch op.op {
+	case "add":
+		// the patch can be applied more than once, so the documents can't share the value
+		return add(doc, op.path, deepCopy(op.value))
+	case "remove":
+		return remove(doc, op.path)
+	case "replace":
+		if len(op.path) == 0 {
+			return deepCopy(op.value), nil
+		}
+		doc, err := remove(doc, op.path)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, op.path, deepCopy(op.value))
+	case "move":
+		if op.from.isPrefixOf(op.path) {
+			return nil, errors.New("cannot move a value into itself")
+		}
+		value, err := op.from.get(doc)
+		if err != nil {
+			return nil, err
+		}
+		if doc, err = remove(doc, op.from); err != nil {
+			return nil, err
+		}
+		return add(doc, op.path, value)
+	case "copy":
+		value, err := op.from.get(doc)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, op.path, deepCopy(value))
+	case "test":
+		value, err := op.path.get(doc)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(value, op.value) {
+			return nil, errors.New("test failed")
+		}
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("unknown operation %q", op.op)
+	}
+}
+
+func add(doc any, path pointer, value any) (any, error) {
+	return path.update(doc, func(container any, token string) (any, error) {
+		switch c := container.(type) {
+		case map[string]any:
+			c[token] = value
+			return c, nil
+		case []any:
+			if token == "-" {
+				return append(c, value), nil
+			}
+			i, err := index(token, len(c)+1)
+			if err != nil {
+				return nil, err
+			}
+			return append(c[:i], append([]any{value}, c[i:]...)...), nil
+		default:
+			return nil, fmt.Errorf("cannot add %q to a scalar", token)
+		}
+	}, func(any) (any, error) {
+		return value, nil
+	})
+}
+
+func remove(doc any, path pointer) (any, error) {
+	return path.update(doc, func(container any, token string) (any, error) {
+		switch c := container.(type) {
+		case map[string]any:
+			if _, ok := c[token]; !ok {
+				return nil, fmt.Errorf("member %q not found", token)
+			}
+			delete(c, token)
+			return c, nil
+		case []any:
+			i, err := index(token, len(c))
+			if err != nil {
+				return nil, err
+			}
+			return append(c[:i], c[i+1:]...), nil
+		default:
+			return nil, fmt.Errorf("cannot remove %q from a scalar", token)
+		}
+	}, func(any) (any, error) {
+		return nil, errors.New("cannot remove the whole document")
+	})
+}
+
+func deepCopy(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		c := make(map[string]any, len(v))
+		for key, item := range v {
+			c[key] = deepCopy(item)
+		}
+		return c
+	case []any:
+		c := make([]any, len(v))
+		for i, item := range v {
+			c[i] = deepCopy(item)
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// equal compares two decoded JSON values, the numbers are compared by value so 1 and 1.0 are equal.
+func equal(a, b any) bool {
+	switch x := a.(type) {
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, value := range x {
+			other, ok := y[key]
+			if !ok || !equal(value, other) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		y, ok := b.([]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if x == y {
+			return true
+		}
+		fx, errX := x.Float64()
+		fy, errY := y.Float64()
+		return errX == nil && errY == nil && fx == fy
+	default:
+		return a == b
+	}
+}
+
+// pointer is a parsed JSON Pointer (RFC 6901), the empty pointer references the whole document.
+type pointer []string
+
+func parsePointer(s string) (pointer, error) {
+	if s == "" {
+		return pointer{}, nil
+	}
+	if !strings.HasPrefix(s, "/") {
+		return nil, fmt.Errorf("invalid pointer %q", s)
+	}
+
+	tokens := strings.Split(s[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+func (p pointer) String() string {
+	var sb strings.Builder
+	for _, token := range p {
+		sb.WriteString("/")
+		sb.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+	return sb.String()
+}
+
+// isPrefixOf returns true if other is a child of the location of p.
+func (p pointer) isPrefixOf(other pointer) bool {
+	if len(p) >= len(other) {
+		return false
+	}
+	for i := range p {
+		if p[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (p pointer) get(doc any) (any, error) {
+	current := doc
+	for _, token := range p {
+		var err error
+		if current, err = child(current, token); err != nil {
+			return nil, err
+		}
+	}
+	return current, nil
+}
+
+// update walks to the parent of the referenced location and replaces it with the result of fn. Arrays can be
+// reallocated, so every level returns the new value to its parent. The root function is used for the empty pointer.
+func (p pointer) update(doc any, fn func(container any, token string) (any, error), root func(any) (any, error)) (any, error) {
+	if len(p) == 0 {
+		return root(doc)
+	}
+	if len(p) == 1 {
+		return fn(doc, p[0])
+	}
+
+	next, err := child(doc, p[0])
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := p[1:].update(next, fn, root)
+	if err != nil {
+		return nil, err
+	}
+
+	switch c := doc.(type) {
+	case map[string]any:
+		c[p[0]] = updated
+	case []any:
+		i, _ := index(p[0], len(c))
+		c[i] = updated
+	}
+
+	return doc, nil
+}
+
+func child(container any, token string) (any, error) {
+	switch c := container.(type) {
+	case map[string]any:
+		value, ok := c[token]
+		if !ok {
+			return nil, fmt.Errorf("member %q not found", token)
+		}
+		return value, nil
+	case []any:
+		i, err := index(token, len(c))
+		if err != nil {
+			return nil, err
+		}
+		return c[i], nil
+	default:
+		return nil, fmt.Errorf("cannot get %q from a scalar", token)
+	}
+}
+
+// index parses an array index, it must be lower than size.
+func index(token string, size int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+
+	i := 0
+	for _, r := range token {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("invalid array index %q", token)
+		}
+		i = i*10 + int(r-'0')
+		if i >= size {
+			return 0, fmt.Errorf("array index %q out of bounds", token)
+		}
+	}
+
+	return i, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package patch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePatch(t *testing.T) {
+	// examples from RFC 7396, appendix A
+	tests := []struct {
+		doc, patch, result string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"e":null}`, `{"a":1}`, `{"e":null,"a":1}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, test := range tests {
+		p, err := Parse(MIMEApplicationMergePatch, []byte(test.patch))
+		require.NoError(t, err)
+
+		result, err := p.Apply([]byte(test.doc))
+		require.NoError(t, err, test.patch)
+		assert.JSONEq(t, test.result, string(result), test.patch)
+	}
+}
+
+func TestJSONPatch(t *testing.T) {
+	// examples from RFC 6902, appendix A
+	tests := []struct {
+		doc, patch, result string
+	}{
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`, `{"baz":"qux","foo":"bar"}`},
+		{`{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`, `{"foo":["bar","qux","baz"]}`},
+		{`{"baz":"qux","foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, `{"foo":"bar"}`},
+		{`{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`, `{"foo":["bar","baz"]}`},
+		{`{"baz":"qux","foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"boo"}]`, `{"baz":"boo","foo":"bar"}`},
+		{`{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			`[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			`{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`},
+		{`{"foo":["all","grass","cows","eat"]}`, `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			`{"foo":["all","cows","eat","grass"]}`},
+		{`{"baz":"qux","foo":["a",2,"c"]}`,
+			`[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2.0}]`,
+			`{"baz":"qux","foo":["a",2,"c"]}`},
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`,
+			`{"foo":"bar","child":{"grandchild":{}}}`},
+		{`{"foo":["bar"]}`, `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`, `{"foo":["bar",["abc","def"]]}`},
+		{`{"/":9,"~1":10}`, `[{"op":"test","path":"/~01","value":10},{"op":"copy","from":"/~1","path":"/a"}]`,
+			`{"/":9,"~1":10,"a":9}`},
+		{`{"foo":null}`, `[{"op":"test","path":"/foo","value":null}]`, `{"foo":null}`},
+		{`{"foo":"bar"}`, `[{"op":"replace","path":"","value":[1]}]`, `[1]`},
+	}
+
+	for _, test := range tests {
+		p, err := Parse(MIMEApplicationJSONPatch+"; charset=utf-8", []byte(test.patch))
+		require.NoError(t, err, test.patch)
+
+		result, err := p.Apply([]byte(test.doc))
+		require.NoError(t, err, test.patch)
+		assert.JSONEq(t, test.result, string(result), test.patch)
+	}
+}
+
+func TestJSONPatchErrors(t *testing.T) {
+	invalid := []string{
+		`{"op":"add"}`,
+		`[{"op":"add","path":"/a"}]`,
+		`[{"op":"unknown","path":"/a"}]`,
+		`[{"op":"remove","path":"a"}]`,
+	}
+	for _, data := range invalid {
+		_, err := Parse(MIMEApplicationJSONPatch, []byte(data))
+		assert.ErrorIs(t, err, ErrInvalidPatch, data)
+	}
+
+	failed := []struct{ doc, patch string }{
+		{`{"baz":"qux"}`, `[{"op":"test","path":"/baz","value":"bar"}]`},
+		{`{"foo":"bar"}`, `[{"op":"add","path":"/baz/bat","value":"qux"}]`},
+		{`{"foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`},
+		{`{"foo":[1]}`, `[{"op":"add","path":"/foo/2","value":2}]`},
+		{`{"foo":[1]}`, `[{"op":"replace","path":"/foo/01","value":2}]`},
+		{`{"foo":{"bar":1}}`, `[{"op":"move","from":"/foo","path":"/foo/bar/baz"}]`},
+	}
+	for _, test := range failed {
+		p, err := Parse(MIMEApplicationJSONPatch, []byte(test.patch))
+		require.NoError(t, err, test.patch)
+
+		_, err = p.Apply([]byte(test.doc))
+		assert.ErrorIs(t, err, ErrCannotApply, test.patch)
+	}
+
+	_, err := Parse("application/json", []byte(`{}`))
+	assert.ErrorIs(t, err, ErrUnsupportedMediaType)
+}
+
+func TestApplyTo(t *testing.T) {
+	type request struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+	current := request{Name: "John", Email: "john@example.com"}
+
+	p, err := ParseMergePatch([]byte(`{"name":"Jane"}`))
+	require.NoError(t, err)
+
+	var result request
+	require.NoError(t, ApplyTo(p, &current, &result))
+	assert.Equal(t, request{Name: "Jane", Email: "john@example.com"}, result)
+
+	p, err = ParseMergePatch([]byte(`{"id":1}`))
+	require.NoError(t, err)
+	assert.ErrorIs(t, ApplyTo(p, &current, &result), ErrCannotApply)
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+// Package principal carries the authenticated caller of a request on its context.
+package principal
+
+import (
+	"context"
+	"slices"
+)
+
+const (
+	// TypeAPIKey is a principal authenticated with the X-API-Key header
+	TypeAPIKey = "api_key"
+	// TypeUser is a principal authenticated with a token issued by the login
+	TypeUser = "user"
+)
+
+// ScopeAdmin grants access to the admin endpoints and to the resources of every owner.
+const ScopeAdmin = "admin"
+
+type principalKey struct{}
+
+// Principal is the authenticated caller, the subject is the owner of the credentials.
+type Principal struct {
+	Type    string
+	ID      string
+	Subject string
+	Scopes  []string
+}
+
+// HasScope returns true if the principal was granted the scope.
+func (p *Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+// HasScopes returns true if the principal was granted all the scopes.
+func (p *Principal) HasScopes(scopes []string) bool {
+	for _, scope := range scopes {
+		if !p.HasScope(scope) {
+			return false
+		}
+	}
+	return true
+}
+
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of the request, if it was authenticated.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
@@ -0,0 +1,31 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package principal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContext(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	p := &Principal{Type: TypeAPIKey, ID: "1", Subject: "john", Scopes: []string{"read", "write"}}
+	result, ok := FromContext(NewContext(context.Background(), p))
+	assert.True(t, ok)
+	assert.Same(t, p, result)
+}
+
+func TestHasScopes(t *testing.T) {
+	p := &Principal{Scopes: []string{"read", "write"}}
+	assert.True(t, p.HasScope("read"))
+	assert.False(t, p.HasScope("admin"))
+	assert.True(t, p.HasScopes([]string{"read", "write"}))
+	assert.True(t, p.HasScopes(nil))
+	assert.False(t, p.HasScopes([]string{"read", "admin"}))
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package ratelimit
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"go.megpoid.dev/go-skel/pkg/principal"
+)
+
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRateLimitPolicy    = "RateLimit-Policy"
+)
+
+var ErrTooManyRequests = echo.NewHTTPError(http.StatusTooManyRequests, "too many requests")
+
+type Config struct {
+	Skipper middleware.Skipper
+	Store   Store
+	// Limit applies to all the requests of a principal
+	Limit Limit
+	// Routes have their own limits, counted apart from the default one. They are keyed by the method and the
+	// path of the route, like "POST /profiles/:id".
+	Routes map[string]Limit
+	// KeyFunc identifies the principal of the request, by default the authenticated principal, the JWT subject or
+	// the client IP
+	KeyFunc func(ctx echo.Context) string
+}
+
+// Middleware rejects the requests over the limit of the principal with a 429. Every response includes the
+// RateLimit headers, and the rejected ones a Retry-After. The requests are allowed if the store fails.
+func Middleware(config Config) echo.MiddlewareFunc {
+	if config.Skipper == nil {
+		config.Skipper = middleware.DefaultSkipper
+	}
+	if config.KeyFunc == nil {
+		config.KeyFunc = Principal
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if config.Skipper(ctx) {
+				return next(ctx)
+			}
+
+			route := ctx.Request().Method + " " + ctx.Path()
+			key := config.KeyFunc(ctx)
+			limit, ok := config.Routes[route]
+			if ok {
+				key += ":" + route
+			} else {
+				limit = config.Limit
+			}
+
+			result, err := config.Store.Take(ctx.Request().Context(), key, limit)
+			if err != nil {
+				slog.ErrorContext(ctx.Request().Context(), "Cannot check the rate limit", slog.String("error", err.Error()))
+				return next(ctx)
+			}
+
+			header := ctx.Response().Header()
+			header.Set(HeaderRateLimitLimit, strconv.Itoa(limit.Requests))
+			header.Set(HeaderRateLimitRemaining, strconv.Itoa(result.Remaining))
+			header.Set(HeaderRateLimitReset, seconds(result.Reset))
+			header.Set(HeaderRateLimitPolicy, strconv.Itoa(limit.Requests)+";w="+seconds(limit.Period))
+
+			if !result.Allowed {
+				header.Set(echo.HeaderRetryAfter, seconds(result.RetryAfter))
+				return ErrTooManyRequests
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+// Principal returns the authenticated principal, the subject of the verified JWT or the client IP of the request,
+// in that order. The credentials sent by the client are only used once the authentication verified them, so a
+// caller can't get a new limit by sending other credentials.
+func Principal(ctx echo.Context) string {
+	if p, ok := principal.FromContext(ctx.Request().Context()); ok {
+		return p.Type + ":" + p.ID
+	}
+
+	if token, ok := ctx.Get("user").(*jwt.Token); ok && token.Valid {
+		if subject, err := token.Claims.GetSubject(); err == nil && subject != "" {
+			return "sub:" + subject
+		}
+	}
+
+	return "ip:" + ctx.RealIP()
+}
+
+// ClientIP returns the client IP of the request. The keys are apart from the ones of Principal, so the limit of
+// the unauthenticated requests can share the store.
+func ClientIP(ctx echo.Context) string {
+	return "client:" + ctx.RealIP()
+}
+
+// seconds rounds up the duration, so the clients don't retry too early.
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+// Package ratelimit limits the requests of every principal with a token bucket, implemented with the generic cell
+// rate algorithm so the state of a bucket is a single timestamp.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidLimit = errors.New("invalid rate limit")
+
+// Limit allows a burst of Requests, refilled over the Period.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// ParseLimit reads a limit in the form requests/period, like 100/1m. The period can omit the number, like 100/m.
+func ParseLimit(value string) (Limit, error) {
+	requests, period, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("%w: %q", ErrInvalidLimit, value)
+	}
+
+	n, err := strconv.Atoi(requests)
+	if err != nil || n <= 0 {
+		return Limit{}, fmt.Errorf("%w: %q", ErrInvalidLimit, value)
+	}
+
+	if period != "" && (period[0] < '0' || period[0] > '9') {
+		period = "1" + period
+	}
+
+	duration, err := time.ParseDuration(period)
+	if err != nil || duration <= 0 {
+		return Limit{}, fmt.Errorf("%w: %q", ErrInvalidLimit, value)
+	}
+
+	return Limit{Requests: n, Period: duration}, nil
+}
+
+func (l Limit) String() string {
+	return strconv.Itoa(l.Requests) + "/" + l.Period.String()
+}
+
+// interval is the time needed to refill a single request.
+func (l Limit) interval() time.Duration {
+	return l.Period / time.Duration(l.Requests)
+}
+
+// Result is the state of the bucket after taking a request.
+type Result struct {
+	Limit     Limit
+	Allowed   bool
+	Remaining int
+	// Reset is the time until the bucket is full again
+	Reset time.Duration
+	// RetryAfter is the time until the next request is allowed, only set when the request isn't allowed
+	RetryAfter time.Duration
+}
+
+// Store keeps the buckets, shared by the servers or local to this one.
+type Store interface {
+	// Take removes a request from the bucket of the key.
+	Take(ctx context.Context, key string, limit Limit) (*Result, error)
+}
+
+// take runs the algorithm over the theoretical arrival time of the bucket, returning the new one.
+func take(now, tat time.Time, limit Limit) (time.Time, *Result) {
+	if tat.Before(now) {
+		tat = now
+	}
+
+	newTat := tat.Add(limit.interval())
+	allowAt := newTat.Add(-limit.Period)
+	if now.Before(allowAt) {
+		return tat, &Result{
+			Limit:      limit,
+			Reset:      tat.Sub(now),
+			RetryAfter: allowAt.Sub(now),
+		}
+	}
+
+	return newTat, &Result{
+		Limit:     limit,
+		Allowed:   true,
+		Remaining: int(now.Sub(allowAt) / limit.interval()),
+		Reset:     newTat.Sub(now),
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.megpoid.dev/go-skel/pkg/principal"
+)
+
+func TestParseLimit(t *testing.T) {
+	limit, err := ParseLimit("100/1m")
+	require.NoError(t, err)
+	assert.Equal(t, Limit{Requests: 100, Period: time.Minute}, limit)
+
+	limit, err = ParseLimit(" 10/h ")
+	require.NoError(t, err)
+	assert.Equal(t, Limit{Requests: 10, Period: time.Hour}, limit)
+
+	for _, value := range []string{"", "100", "0/1m", "-1/1m", "a/1m", "10/", "10/0s", "10/week"} {
+		_, err := ParseLimit(value)
+		assert.ErrorIs(t, err, ErrInvalidLimit, value)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	limit := Limit{Requests: 3, Period: 3 * time.Second}
+
+	for i := range 3 {
+		result, err := store.Take(ctx, "key", limit)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 2-i, result.Remaining)
+		assert.Equal(t, time.Duration(i+1)*time.Second, result.Reset)
+	}
+
+	result, err := store.Take(ctx, "key", limit)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Second, result.RetryAfter)
+	assert.Equal(t, 3*time.Second, result.Reset)
+
+	// the other keys have their own bucket
+	result, err = store.Take(ctx, "other", limit)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+
+	// a single request is refilled after the interval
+	now = now.Add(time.Second)
+	result, err = store.Take(ctx, "key", limit)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	// the full buckets are removed
+	now = now.Add(time.Hour)
+	_, err = store.Take(ctx, "key", limit)
+	require.NoError(t, err)
+	assert.Len(t, store.buckets, 1)
+}
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Limit) (*Result, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestFallbackStore(t *testing.T) {
+	store := NewFallbackStore(failingStore{}, NewMemoryStore())
+	result, err := store.Take(context.Background(), "key", Limit{Requests: 1, Period: time.Minute})
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+}
+
+// newServer authenticates the requests with any X-API-Key, like the authentication middleware.
+func newServer(config Config) *echo.Echo {
+	e := echo.New()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if key := ctx.Request().Header.Get("X-API-Key"); key != "" {
+				p := &principal.Principal{Type: principal.TypeAPIKey, ID: key}
+				ctx.SetRequest(ctx.Request().WithContext(principal.NewContext(ctx.Request().Context(), p)))
+			}
+			return next(ctx)
+		}
+	})
+	e.Use(Middleware(config))
+	e.GET("/profiles", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+	e.POST("/profiles", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusCreated)
+	})
+	return e
+}
+
+func send(e *echo.Echo, method, apiKey string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/profiles", nil)
+	if apiKey != "" {
+		req.Header.Set("X-API-Key", apiKey)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddleware(t *testing.T) {
+	e := newServer(Config{
+		Store:  NewMemoryStore(),
+		Limit:  Limit{Requests: 2, Period: time.Minute},
+		Routes: map[string]Limit{"POST /profiles": {Requests: 1, Period: time.Hour}},
+	})
+
+	rec := send(e, http.MethodGet, "secret")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get(HeaderRateLimitLimit))
+	assert.Equal(t, "1", rec.Header().Get(HeaderRateLimitRemaining))
+	assert.Equal(t, "30", rec.Header().Get(HeaderRateLimitReset))
+	assert.Equal(t, "2;w=60", rec.Header().Get(HeaderRateLimitPolicy))
+
+	assert.Equal(t, http.StatusOK, send(e, http.MethodGet, "secret").Code)
+
+	rec = send(e, http.MethodGet, "secret")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "0", rec.Header().Get(HeaderRateLimitRemaining))
+	assert.Equal(t, "30", rec.Header().Get(echo.HeaderRetryAfter))
+
+	// the route limit is counted apart
+	assert.Equal(t, http.StatusCreated, send(e, http.MethodPost, "secret").Code)
+	rec = send(e, http.MethodPost, "secret")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "3600", rec.Header().Get(echo.HeaderRetryAfter))
+
+	// other principals aren't affected
+	assert.Equal(t, http.StatusOK, send(e, http.MethodGet, "other").Code)
+	assert.Equal(t, http.StatusOK, send(e, http.MethodGet, "").Code)
+}
+
+func TestMiddlewareStoreError(t *testing.T) {
+	e := newServer(Config{Store: failingStore{}, Limit: Limit{Requests: 1, Period: time.Minute}})
+
+	rec := send(e, http.MethodGet, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(HeaderRateLimitLimit))
+}
+
+func TestPrincipal(t *testing.T) {
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "192.0.2.1:1234"
+	// the unverified credentials are ignored
+	req.Header.Set("X-API-Key", "secret")
+	ctx := e.NewContext(req, httptest.NewRecorder())
+	assert.Equal(t, "ip:192.0.2.1", Principal(ctx))
+
+	ctx.Set("user", &jwt.Token{Claims: jwt.RegisteredClaims{Subject: "john"}})
+	assert.Equal(t, "ip:192.0.2.1", Principal(ctx))
+
+	ctx.Set("user", &jwt.Token{Claims: jwt.RegisteredClaims{Subject: "john"}, Valid: true})
+	assert.Equal(t, "sub:john", Principal(ctx))
+
+	p := &principal.Principal{Type: principal.TypeAPIKey, ID: "7"}
+	ctx.SetRequest(req.WithContext(principal.NewContext(req.Context(), p)))
+	assert.Equal(t, "api_key:7", Principal(ctx))
+
+	// the client IP is used even if the credentials are sent
+	assert.Equal(t, "client:192.0.2.1", ClientIP(ctx))
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeScript is the same algorithm as take, using the clock of the redis server so every server sees the same
+// time. The timestamps are in microseconds.
+var takeScript = redis.NewScript(`
+local interval = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then
+    tat = now
+end
+
+local new_tat = tat + interval
+local allow_at = new_tat - period
+if now < allow_at then
+    return {0, allow_at - now, tat - now}
+end
+
+redis.call('SET', KEYS[1], new_tat, 'PX', math.ceil((new_tat - now) / 1000))
+return {1, now - allow_at, new_tat - now}
+`)
+
+// RedisStore keeps the buckets on redis, shared by all the servers.
+type RedisStore struct {
+	client redis.Scripter
+	prefix string
+}
+
+func NewRedisStore(client redis.Scripter, prefix string) *RedisStore {
+	return &RedisStore{client: client, prefix: prefix}
+}
+
+func (s *RedisStore) Take(ctx context.Context, key string, limit Limit) (*Result, error) {
+	interval := limit.interval().Microseconds()
+	values, err := takeScript.Run(ctx, s.client, []string{s.prefix + key}, interval, limit.Period.Microseconds()).Int64Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to take from the bucket: %w", err)
+	}
+	if len(values) != 3 {
+		return nil, fmt.Errorf("failed to take from the bucket: unexpected reply %v", values)
+	}
+
+	if values[0] == 0 {
+		return &Result{
+			Limit:      limit,
+			RetryAfter: time.Duration(values[1]) * time.Microsecond,
+			Reset:      time.Duration(values[2]) * time.Microsecond,
+		}, nil
+	}
+
+	return &Result{
+		Limit:     limit,
+		Allowed:   true,
+		Remaining: int(values[1] / interval),
+		Reset:     time.Duration(values[2]) * time.Microsecond,
+	}, nil
+}
+
+// FallbackStore uses the fallback store while the primary one fails, so the limits are still enforced by every
+// server on its own.
+type FallbackStore struct {
+	primary  Store
+	fallback Store
+}
+
+func NewFallbackStore(primary, fallback Store) *FallbackStore {
+	return &FallbackStore{primary: primary, fallback: fallback}
+}
+
+func (s *FallbackStore) Take(ctx context.Context, key string, limit Limit) (*Result, error) {
+	result, err := s.primary.Take(ctx, key, limit)
+	if err == nil {
+		return result, nil
+	}
+
+	slog.WarnContext(ctx, "Rate limit store failed, using the fallback", slog.String("error", err.Error()))
+	return s.fallback.Take(ctx, key, limit)
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package render
+
+import (
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType          = reflect.TypeFor[time.Time]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// csvColumn is a field of the struct written to the CSV, index is the path of the field on embedded structs.
+type csvColumn struct {
+	name  string
+	index []int
+}
+
+// csvColumns returns the columns of the struct in declaration order, named after the json tags. The fields of
+// the embedded structs without a json name are flattened like encoding/json does.
+func csvColumns(t reflect.Type) []csvColumn {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var columns []csvColumn
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				for _, c := range csvColumns(fieldType) {
+					columns = append(columns, csvColumn{name: c.name, index: append([]int{i}, c.index...)})
+				}
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		columns = append(columns, csvColumn{name: name, index: []int{i}})
+	}
+
+	return columns
+}
+
+// encodeCSV writes a header with the columns of the item type followed by a row per item.
+func encodeCSV[T any](w io.Writer, items []T) error {
+	itemType := reflect.TypeFor[T]()
+	if itemType.Kind() == reflect.Interface && len(items) > 0 {
+		itemType = reflect.TypeOf(items[0])
+	}
+
+	columns := csvColumns(itemType)
+	if len(columns) == 0 {
+		return fmt.Errorf("cannot encode %s as CSV", itemType)
+	}
+
+	writer := csv.NewWriter(w)
+
+	record := make([]string, len(columns))
+	for i, c := range columns {
+		record[i] = c.name
+	}
+	if err := writer.Write(record); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		value := reflect.ValueOf(item)
+		for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+			value = value.Elem()
+		}
+		if !value.IsValid() {
+			continue
+		}
+
+		for i, c := range columns {
+			field, err := value.FieldByIndexErr(c.index)
+			if err != nil {
+				// nil embedded struct
+				record[i] = ""
+				continue
+			}
+
+			if record[i], err = csvValue(field); err != nil {
+				return fmt.Errorf("failed to encode %s: %w", c.name, err)
+			}
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// csvValue formats the field, the nested values without a text representation are encoded as JSON.
+func csvValue(v reflect.Value) (string, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(time.RFC3339Nano), nil
+	}
+
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits()), nil
+	default:
+		data, err := json.Marshal(v.Interface())
+		return string(data), err
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package render
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"go.megpoid.dev/go-skel/pkg/response"
+)
+
+const (
+	HeaderLink       = "Link"
+	HeaderTotalCount = "X-Total-Count"
+)
+
+// setPaginationHeaders adds the links to the other pages (RFC 8288) and the total count if known. The links
+// keep the query of the current request, so the filters and the format are preserved.
+func setPaginationHeaders(ctx echo.Context, pagination *response.Pagination) {
+	var links []string
+
+	link := func(rel string, set map[string]string) {
+		u := *ctx.Request().URL
+		query := u.Query()
+		for _, key := range []string{"after", "before", "page"} {
+			query.Del(key)
+		}
+		for key, value := range set {
+			query.Set(key, value)
+		}
+		u.RawQuery = query.Encode()
+		links = append(links, "<"+requestURL(ctx, &u)+`>; rel="`+rel+`"`)
+	}
+
+	switch {
+	case pagination.CurrentPage != nil:
+		current := *pagination.CurrentPage
+		last := 1
+		if pagination.MaxPage != nil && *pagination.MaxPage > 0 {
+			last = *pagination.MaxPage
+		}
+
+		link("first", map[string]string{"page": "1"})
+		if current > 1 {
+			link("prev", map[string]string{"page": strconv.Itoa(current - 1)})
+		}
+		if current < last {
+			link("next", map[string]string{"page": strconv.Itoa(current + 1)})
+		}
+		link("last", map[string]string{"page": strconv.Itoa(last)})
+
+		if pagination.TotalRecords != nil {
+			ctx.Response().Header().Set(HeaderTotalCount, strconv.Itoa(*pagination.TotalRecords))
+		}
+	default:
+		if pagination.PrevCursor != nil {
+			link("prev", map[string]string{"before": *pagination.PrevCursor})
+		}
+		if pagination.NextCursor != nil {
+			link("next", map[string]string{"after": *pagination.NextCursor})
+		}
+	}
+
+	if len(links) > 0 {
+		ctx.Response().Header().Set(HeaderLink, strings.Join(links, ", "))
+	}
+}
+
+// requestURL returns the absolute URL, using the scheme and host seen by the client.
+func requestURL(ctx echo.Context, u *url.URL) string {
+	u.Scheme = ctx.Scheme()
+	u.Host = ctx.Request().Host
+	return u.String()
+}
@@ -0,0 +1,244 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/ugorji/go/codec"
+	"go.megpoid.dev/go-skel/pkg/conditional"
+	"go.megpoid.dev/go-skel/pkg/model"
+	"go.megpoid.dev/go-skel/pkg/response"
+)
+
+const (
+	MIMEApplicationNDJSON = "application/x-ndjson"
+	MIMETextCSV           = "text/csv"
+	// FormatParam is the query parameter that overrides the Accept header
+	FormatParam = "format"
+)
+
+type Format string
+
+const (
+	FormatJSON    Format = "json"
+	FormatNDJSON  Format = "ndjson"
+	FormatCSV     Format = "csv"
+	FormatMsgpack Format = "msgpack"
+)
+
+// mediaTypes maps the accepted media types to the formats, the first one is used on the Content-Type.
+var mediaTypes = []struct {
+	format Format
+	types  []string
+}{
+	{FormatJSON, []string{echo.MIMEApplicationJSON}},
+	{FormatNDJSON, []string{MIMEApplicationNDJSON, "application/ndjson", "application/jsonl"}},
+	{FormatCSV, []string{MIMETextCSV}},
+	{FormatMsgpack, []string{echo.MIMEApplicationMsgpack, "application/x-msgpack", "application/vnd.msgpack"}},
+}
+
+var msgpackHandle = newMsgpackHandle()
+
+func newMsgpackHandle() *codec.MsgpackHandle {
+	// the codec also reads the json tags, so the keys match the JSON responses
+	h := &codec.MsgpackHandle{}
+	// use the msgpack timestamp extension for time.Time
+	h.WriteExt = true
+	return h
+}
+
+// Negotiate returns the response format from the format query parameter, or the Accept header if missing. JSON
+// is used if neither are set, an error with status 406 is returned if no supported format is accepted.
+func Negotiate(ctx echo.Context) (Format, error) {
+	if name := ctx.QueryParam(FormatParam); name != "" {
+		format := Format(strings.ToLower(name))
+		for _, m := range mediaTypes {
+			if m.format == format {
+				return format, nil
+			}
+		}
+		return "", echo.NewHTTPError(http.StatusNotAcceptable, fmt.Sprintf("unsupported format: %s", name))
+	}
+
+	accept := ctx.Request().Header.Get(echo.HeaderAccept)
+	if accept == "" {
+		return FormatJSON, nil
+	}
+
+	format, ok := negotiateAccept(accept)
+	if !ok {
+		return "", echo.NewHTTPError(http.StatusNotAcceptable, fmt.Sprintf("unsupported media type: %s", accept))
+	}
+
+	return format, nil
+}
+
+// negotiateAccept picks the format with the highest quality on the Accept header. On ties the order of the
+// header is kept, wildcards select JSON.
+func negotiateAccept(accept string) (Format, bool) {
+	type candidate struct {
+		format  Format
+		quality float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(accept, ",") {
+		params := strings.Split(part, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(params[0]))
+
+		quality := 1.0
+		for _, param := range params[1:] {
+			key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if strings.EqualFold(key, "q") {
+				if q, err := strconv.ParseFloat(value, 64); err == nil {
+					quality = q
+				}
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		if mediaType == "*/*" || mediaType == "application/*" {
+			candidates = append(candidates, candidate{FormatJSON, quality})
+			continue
+		}
+
+		for _, m := range mediaTypes {
+			if slices.Contains(m.types, mediaType) || (mediaType == "text/*" && m.format == FormatCSV) {
+				candidates = append(candidates, candidate{m.format, quality})
+				break
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		switch {
+		case a.quality > b.quality:
+			return -1
+		case a.quality < b.quality:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return candidates[0].format, true
+}
+
+// ContentType returns the media type used on the responses with the format.
+func ContentType(format Format) string {
+	switch format {
+	case FormatNDJSON:
+		return MIMEApplicationNDJSON
+	case FormatCSV:
+		return MIMETextCSV + "; charset=utf-8"
+	case FormatMsgpack:
+		return echo.MIMEApplicationMsgpack
+	default:
+		return echo.MIMEApplicationJSONCharsetUTF8
+	}
+}
+
+// Render writes a single value using the negotiated format. CSV and NDJSON responses have a single row.
+func Render(ctx echo.Context, code int, value any) error {
+	format, err := Negotiate(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+
+	switch format {
+	case FormatMsgpack:
+		return writeMsgpack(ctx, code, value)
+	case FormatNDJSON:
+		return writeNDJSON(ctx, code, []any{value})
+	case FormatCSV:
+		return writeCSV(ctx, code, []any{value})
+	default:
+		return ctx.JSON(code, value)
+	}
+}
+
+// List writes the items of the list using the negotiated format. The pagination is sent on the Link header
+// since the CSV and NDJSON formats can't embed it, the JSON and MessagePack bodies also include it. The response
+// has a weak ETag, so the If-None-Match requests get a 304 if the page didn't change.
+func List[T model.Modelable](ctx echo.Context, code int, list *response.ListResponse[T]) error {
+	format, err := Negotiate(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx.Response().Header().Add(echo.HeaderVary, echo.HeaderAccept)
+	setPaginationHeaders(ctx, &list.Pagination)
+
+	// the weak tag is calculated from the JSON of the list, so every format has the same cost
+	data, err := json.Marshal(list)
+	if err != nil {
+		return fmt.Errorf("failed to encode response: %w", err)
+	}
+
+	if conditional.NotModified(ctx, conditional.WeakETag([]byte(format), data), time.Time{}) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+
+	switch format {
+	case FormatMsgpack:
+		return writeMsgpack(ctx, code, list)
+	case FormatNDJSON:
+		return writeNDJSON(ctx, code, list.Items)
+	case FormatCSV:
+		return writeCSV(ctx, code, list.Items)
+	default:
+		return ctx.JSONBlob(code, data)
+	}
+}
+
+func writeMsgpack(ctx echo.Context, code int, value any) error {
+	var data []byte
+	if err := codec.NewEncoderBytes(&data, msgpackHandle).Encode(value); err != nil {
+		return fmt.Errorf("failed to encode response: %w", err)
+	}
+
+	return ctx.Blob(code, echo.MIMEApplicationMsgpack, data)
+}
+
+// writeNDJSON streams the items, one JSON document per line.
+func writeNDJSON[T any](ctx echo.Context, code int, items []T) error {
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, MIMEApplicationNDJSON)
+	res.WriteHeader(code)
+
+	encoder := json.NewEncoder(res)
+	for _, item := range items {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+		res.Flush()
+	}
+
+	return nil
+}
+
+func writeCSV[T any](ctx echo.Context, code int, items []T) error {
+	res := ctx.Response()
+	res.Header().Set(echo.HeaderContentType, ContentType(FormatCSV))
+	res.WriteHeader(code)
+
+	return encodeCSV(res, items)
+}
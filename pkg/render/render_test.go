@@ -0,0 +1,168 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package render
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ugorji/go/codec"
+	"go.megpoid.dev/go-skel/pkg/conditional"
+	"go.megpoid.dev/go-skel/pkg/model"
+	"go.megpoid.dev/go-skel/pkg/paginator"
+	"go.megpoid.dev/go-skel/pkg/paginator/cursor"
+	"go.megpoid.dev/go-skel/pkg/response"
+	"go.megpoid.dev/go-skel/pkg/types"
+)
+
+type profile struct {
+	model.Model
+	Name     string            `json:"name"`
+	Nickname *string           `json:"nickname,omitempty"`
+	Tags     map[string]string `json:"tags"`
+	Secret   string            `json:"-"`
+}
+
+func newProfiles() []*profile {
+	created := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	return []*profile{
+		{Model: model.Model{ID: 1, CreatedAt: created, UpdatedAt: created}, Name: "John, Doe", Nickname: types.AsPointer("JD")},
+		{Model: model.Model{ID: 2, CreatedAt: created, UpdatedAt: created}, Name: "Jane", Tags: map[string]string{"role": "admin"}},
+	}
+}
+
+func newContext(target, accept string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if accept != "" {
+		req.Header.Set(echo.HeaderAccept, accept)
+	}
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		target string
+		accept string
+		format Format
+	}{
+		{"/", "", FormatJSON},
+		{"/", "*/*", FormatJSON},
+		{"/", "text/csv", FormatCSV},
+		{"/", "application/json;q=0.5, application/x-ndjson", FormatNDJSON},
+		{"/", "application/msgpack;q=0.9, text/html, */*;q=0.1", FormatMsgpack},
+		{"/", "text/csv;q=0, application/json", FormatJSON},
+		{"/?format=CSV", "application/json", FormatCSV},
+	}
+
+	for _, test := range tests {
+		ctx, _ := newContext(test.target, test.accept)
+		format, err := Negotiate(ctx)
+		require.NoError(t, err, test.accept)
+		assert.Equal(t, test.format, format, test.accept)
+	}
+
+	for _, test := range []struct{ target, accept string }{{"/", "text/html"}, {"/?format=xml", ""}} {
+		ctx, _ := newContext(test.target, test.accept)
+		_, err := Negotiate(ctx)
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusNotAcceptable, httpErr.Code)
+	}
+}
+
+func TestListCSV(t *testing.T) {
+	ctx, rec := newContext("/profiles?limit=2", "text/csv")
+
+	cur := &paginator.Cursor{}
+	cur.SetCursor(&cursor.Cursor{After: types.AsPointer("next")})
+
+	err := List(ctx, http.StatusOK, response.NewListResponse(newProfiles(), cur))
+	require.NoError(t, err)
+
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, `<http://example.com/profiles?after=next&limit=2>; rel="next"`, rec.Header().Get(HeaderLink))
+	assert.Equal(t, "id,created_at,updated_at,name,nickname,tags\n"+
+		"1,2023-01-02T03:04:05Z,2023-01-02T03:04:05Z,\"John, Doe\",JD,null\n"+
+		"2,2023-01-02T03:04:05Z,2023-01-02T03:04:05Z,Jane,,\"{\"\"role\"\":\"\"admin\"\"}\"\n", rec.Body.String())
+}
+
+func TestListNDJSON(t *testing.T) {
+	ctx, rec := newContext("/profiles?format=ndjson&page=2", "")
+
+	cur := &paginator.Cursor{}
+	cur.SetOffset(&paginator.Page{Items: 2, Total: 6, Page: 2, ItemsPerPage: 2})
+
+	err := List(ctx, http.StatusOK, response.NewListResponse(newProfiles(), cur))
+	require.NoError(t, err)
+
+	assert.Equal(t, MIMEApplicationNDJSON, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "6", rec.Header().Get(HeaderTotalCount))
+	assert.Equal(t, `<http://example.com/profiles?format=ndjson&page=1>; rel="first", `+
+		`<http://example.com/profiles?format=ndjson&page=1>; rel="prev", `+
+		`<http://example.com/profiles?format=ndjson&page=3>; rel="next", `+
+		`<http://example.com/profiles?format=ndjson&page=3>; rel="last"`, rec.Header().Get(HeaderLink))
+
+	lines := bytes.Split(bytes.TrimSpace(rec.Body.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{"id":1,"created_at":"2023-01-02T03:04:05Z","updated_at":"2023-01-02T03:04:05Z",`+
+		`"name":"John, Doe","nickname":"JD","tags":null}`, string(lines[0]))
+}
+
+func TestListMsgpack(t *testing.T) {
+	ctx, rec := newContext("/profiles", "application/msgpack")
+
+	err := List(ctx, http.StatusOK, response.NewListResponse(newProfiles(), &paginator.Cursor{}))
+	require.NoError(t, err)
+	assert.Equal(t, echo.MIMEApplicationMsgpack, rec.Header().Get(echo.HeaderContentType))
+
+	var decoded map[string]any
+	require.NoError(t, codec.NewDecoderBytes(rec.Body.Bytes(), &codec.MsgpackHandle{}).Decode(&decoded))
+
+	items, ok := decoded["items"].([]any)
+	require.True(t, ok)
+	require.Len(t, items, 2)
+	item := items[0].(map[any]any)
+	assert.EqualValues(t, 1, item["id"])
+	assert.Equal(t, []byte("John, Doe"), item["name"])
+	assert.NotContains(t, item, "Secret")
+}
+
+func TestRenderJSON(t *testing.T) {
+	ctx, rec := newContext("/profiles/1", "")
+
+	err := Render(ctx, http.StatusOK, newProfiles()[0])
+	require.NoError(t, err)
+	assert.Equal(t, echo.MIMEApplicationJSON, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, echo.HeaderAccept, rec.Header().Get(echo.HeaderVary))
+}
+
+func TestListNotModified(t *testing.T) {
+	list := response.NewListResponse(newProfiles(), &paginator.Cursor{})
+
+	ctx, rec := newContext("/profiles", "")
+	require.NoError(t, List(ctx, http.StatusOK, list))
+	etag := rec.Header().Get(conditional.HeaderETag)
+	assert.Regexp(t, `^W/`, etag)
+
+	ctx, rec = newContext("/profiles", "")
+	ctx.Request().Header.Set(conditional.HeaderIfNoneMatch, etag)
+	require.NoError(t, List(ctx, http.StatusOK, list))
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	// other formats have their own tag
+	ctx, rec = newContext("/profiles", "text/csv")
+	ctx.Request().Header.Set(conditional.HeaderIfNoneMatch, etag)
+	require.NoError(t, List(ctx, http.StatusOK, list))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,43 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaConnection(t *testing.T) {
+	t.Parallel()
+
+	first := NewTestSchemaConnection(t)
+	second := NewTestSchemaConnection(t)
+	assert.NotEqual(t, first.Schema, second.Schema)
+
+	ctx := context.Background()
+
+	var schema string
+	err := first.Store.Get(ctx, &schema, "SELECT current_schema()")
+	if assert.NoError(t, err) {
+		assert.Equal(t, first.Schema, schema)
+	}
+
+	_, err = first.Store.Exec(ctx, "DELETE FROM profiles")
+	assert.NoError(t, err)
+
+	var count int
+	err = first.Store.Get(ctx, &count, "SELECT count(*) FROM profiles")
+	if assert.NoError(t, err) {
+		assert.Zero(t, count)
+	}
+
+	// the changes of the first schema don't affect the second one
+	err = second.Store.Get(ctx, &count, "SELECT count(*) FROM profiles")
+	if assert.NoError(t, err) {
+		assert.NotZero(t, count)
+	}
+}
@@ -7,6 +7,8 @@ package repo
 import (
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 )
 
 type RepoError struct {
@@ -20,10 +22,41 @@ var (
 	ErrDuplicated = errors.New("repo: duplicated model")
 )
 
+// errorCounts keeps the count of errors created per kind, see ErrorCounts.
+var errorCounts sync.Map
+
 func NewRepoError(err, internal error) error {
+	counter, _ := errorCounts.LoadOrStore(ErrorKind(err), &atomic.Uint64{})
+	counter.(*atomic.Uint64).Add(1)
+
 	return &RepoError{internal: internal, Err: err}
 }
 
+// ErrorKind returns a short name for the repository error, to be used as a metric label.
+func ErrorKind(err error) string {
+	switch {
+	case errors.Is(err, ErrBackend):
+		return "backend"
+	case errors.Is(err, ErrNotFound):
+		return "not_found"
+	case errors.Is(err, ErrDuplicated):
+		return "duplicated"
+	default:
+		return "other"
+	}
+}
+
+// ErrorCounts returns the count of repository errors created since the process started, grouped by kind.
+func ErrorCounts() map[string]uint64 {
+	counts := make(map[string]uint64)
+	errorCounts.Range(func(key, value any) bool {
+		counts[key.(string)] = value.(*atomic.Uint64).Load()
+		return true
+	})
+
+	return counts
+}
+
 func (r *RepoError) Error() string {
 	if r.internal != nil {
 		return fmt.Sprintf("%s: %s", r.Err, r.internal)
@@ -31,3 +31,11 @@ func TestRepoError_Unwrap(t *testing.T) {
 	repoErr := NewRepoError(err, errInternal)
 	assert.ErrorIs(t, repoErr, err)
 }
+
+func TestErrorCounts(t *testing.T) {
+	before := ErrorCounts()["not_found"]
+	_ = NewRepoError(ErrNotFound, errInternal)
+	assert.Equal(t, before+1, ErrorCounts()["not_found"])
+	assert.Equal(t, "backend", ErrorKind(ErrBackend))
+	assert.Equal(t, "other", ErrorKind(err))
+}
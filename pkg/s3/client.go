@@ -20,6 +20,7 @@ type Client interface {
 	Upload(ctx context.Context, key string, r io.Reader) (string, error)
 	Download(ctx context.Context, key string, w io.WriterAt) (int64, error)
 	ListObjects(ctx context.Context, opts ListOptions) (*ListOutput, error)
+	Ping(ctx context.Context) error
 }
 
 type ClientImpl struct {
@@ -159,3 +160,15 @@ func (c *ClientImpl) ListObjects(ctx context.Context, opts ListOptions) (*ListOu
 
 	return &output, nil
 }
+
+// Ping returns an error if the bucket doesn't exist or isn't accessible with the client credentials
+func (c *ClientImpl) Ping(ctx context.Context) error {
+	_, err := c.svc.HeadBucketWithContext(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(c.bucket),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to access bucket: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var _ Client = &FileClient{}
+
+// FileClient stores the objects on a local directory, as a stand-in of S3 for development or single node setups.
+// The directory must be shared between the processes that read and write the objects.
+type FileClient struct {
+	root string
+}
+
+func NewFileClient(root string) *FileClient {
+	return &FileClient{root: root}
+}
+
+// path returns the file path of the key, rejecting the keys that escape from the root directory.
+func (c *FileClient) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid key: %s", key)
+	}
+
+	return filepath.Join(c.root, filepath.FromSlash(key)), nil
+}
+
+func (c *FileClient) Upload(_ context.Context, key string, r io.Reader) (string, error) {
+	path, err := c.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return "", fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	// write to a temporary file first, so a failed upload doesn't leave a partial object
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := io.Copy(file, r); err != nil {
+		_ = file.Close()
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	if err := os.Rename(file.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	return key, nil
+}
+
+func (c *FileClient) Download(_ context.Context, key string, w io.WriterAt) (int64, error) {
+	path, err := c.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download file: %w", err)
+	}
+	defer file.Close()
+
+	n, err := io.Copy(io.NewOffsetWriter(w, 0), file)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download file: %w", err)
+	}
+
+	return n, nil
+}
+
+func (c *FileClient) ListObjects(_ context.Context, opts ListOptions) (*ListOutput, error) {
+	output := &ListOutput{}
+
+	err := filepath.WalkDir(c.root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".upload-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(c.root, path)
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, opts.Prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		output.Items = append(output.Items, ListItem{Key: key, Size: info.Size()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	slices.SortFunc(output.Items, func(a, b ListItem) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	if opts.MaxKeys > 0 && int64(len(output.Items)) > opts.MaxKeys {
+		output.Items = output.Items[:opts.MaxKeys]
+		output.IsTruncated = true
+	}
+
+	return output, nil
+}
+
+// Ping returns an error if the root directory can't be created
+func (c *FileClient) Ping(_ context.Context) error {
+	if err := os.MkdirAll(c.root, 0o750); err != nil {
+		return fmt.Errorf("failed to access directory: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileClient(t *testing.T) {
+	client := NewFileClient(t.TempDir())
+
+	ctx := context.Background()
+	content := "Hello, World!"
+
+	require.NoError(t, client.Ping(ctx))
+
+	key, err := client.Upload(ctx, "imports/foo.txt", strings.NewReader(content))
+	require.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	n, err := client.Download(ctx, key, FakeWriterAt{&buf})
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), n)
+	assert.Equal(t, content, buf.String())
+
+	list, err := client.ListObjects(ctx, ListOptions{Prefix: "imports/"})
+	require.NoError(t, err)
+	assert.Equal(t, []ListItem{{Key: "imports/foo.txt", Size: int64(len(content))}}, list.Items)
+
+	_, err = client.Upload(ctx, "../foo.txt", strings.NewReader(content))
+	assert.Error(t, err)
+}
@@ -52,3 +52,7 @@ func (m MemoryClient) ListObjects(_ context.Context, _ ListOptions) (*ListOutput
 
 	return output, nil
 }
+
+func (m MemoryClient) Ping(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type txContextKey struct{}
+
+// WithTx returns a context that makes the ContextExecutor run the queries on the transaction.
+func WithTx(ctx context.Context, tx Tx) context.Context {
+	return context.WithValue(ctx, txContextKey{}, tx)
+}
+
+// TxFromContext returns the transaction set with WithTx, if any.
+func TxFromContext(ctx context.Context) (Tx, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(Tx)
+	return tx, ok
+}
+
+// ContextExecutor runs the queries on the transaction of the context, falling back to the wrapped executor. The
+// repositories created once at startup can join a transaction started elsewhere this way, the new transactions
+// started inside of it become savepoints.
+type ContextExecutor struct {
+	conn Executor
+}
+
+func NewContextExecutor(conn Executor) *ContextExecutor {
+	return &ContextExecutor{conn: conn}
+}
+
+func (c *ContextExecutor) executor(ctx context.Context) Executor {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx
+	}
+	return c.conn
+}
+
+func (c *ContextExecutor) Begin(ctx context.Context) (*PgxTx, error) {
+	return c.executor(ctx).Begin(ctx)
+}
+
+func (c *ContextExecutor) BeginFunc(ctx context.Context, f func(conn Tx) error) error {
+	return c.executor(ctx).BeginFunc(ctx, f)
+}
+
+func (c *ContextExecutor) Exec(ctx context.Context, query string, arguments ...any) (pgconn.CommandTag, error) {
+	return c.executor(ctx).Exec(ctx, query, arguments...)
+}
+
+func (c *ContextExecutor) Get(ctx context.Context, dst any, query string, args ...any) error {
+	return c.executor(ctx).Get(ctx, dst, query, args...)
+}
+
+func (c *ContextExecutor) Select(ctx context.Context, dest any, query string, args ...any) error {
+	return c.executor(ctx).Select(ctx, dest, query, args...)
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTx records the queries it receives
+type fakeTx struct {
+	queries []string
+}
+
+func (f *fakeTx) Begin(context.Context) (*PgxTx, error) { return nil, nil }
+
+func (f *fakeTx) BeginFunc(_ context.Context, fn func(conn Tx) error) error { return fn(f) }
+
+func (f *fakeTx) Exec(_ context.Context, query string, _ ...any) (pgconn.CommandTag, error) {
+	f.queries = append(f.queries, query)
+	return pgconn.CommandTag{}, nil
+}
+
+func (f *fakeTx) Get(_ context.Context, _ any, query string, _ ...any) error {
+	f.queries = append(f.queries, query)
+	return nil
+}
+
+func (f *fakeTx) Select(_ context.Context, _ any, query string, _ ...any) error {
+	f.queries = append(f.queries, query)
+	return nil
+}
+
+func (f *fakeTx) Commit(context.Context) error { return nil }
+
+func (f *fakeTx) Rollback(context.Context) error { return nil }
+
+func TestContextExecutor(t *testing.T) {
+	pool := &fakeTx{}
+	tx := &fakeTx{}
+	conn := NewContextExecutor(pool)
+
+	ctx := context.Background()
+	_, _ = conn.Exec(ctx, "pool")
+
+	txCtx := WithTx(ctx, tx)
+	_, _ = conn.Exec(txCtx, "tx")
+	_ = conn.Get(txCtx, nil, "tx")
+	_ = conn.BeginFunc(txCtx, func(conn Tx) error {
+		return conn.Select(txCtx, nil, "nested")
+	})
+
+	assert.Equal(t, []string{"pool"}, pool.queries)
+	assert.Equal(t, []string{"tx", "tx", "nested"}, tx.queries)
+
+	current, ok := TxFromContext(txCtx)
+	assert.True(t, ok)
+	assert.Same(t, tx, current)
+	_, ok = TxFromContext(ctx)
+	assert.False(t, ok)
+}
@@ -0,0 +1,368 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	DefaultHubBufferSize = 64
+	DefaultHubMinBackoff = 500 * time.Millisecond
+	DefaultHubMaxBackoff = 30 * time.Second
+)
+
+// Notification is a message received from a LISTEN channel.
+type Notification struct {
+	PID     uint32
+	Channel string
+	Payload string
+}
+
+// Decode unmarshals the JSON payload of the notification into a value of type T.
+func Decode[T any](n *Notification) (T, error) {
+	var result T
+	if err := json.Unmarshal([]byte(n.Payload), &result); err != nil {
+		return result, fmt.Errorf("failed to decode payload from channel %s: %w", n.Channel, err)
+	}
+
+	return result, nil
+}
+
+// Publish sends a notification to the channel. Values other than strings or byte slices are encoded as JSON.
+func Publish(ctx context.Context, db Executor, channel string, payload any) error {
+	var message string
+	switch v := payload.(type) {
+	case string:
+		message = v
+	case []byte:
+		message = string(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to encode payload: %w", err)
+		}
+		message = string(data)
+	}
+
+	if _, err := db.Exec(ctx, "SELECT pg_notify($1, $2)", channel, message); err != nil {
+		return fmt.Errorf("failed to publish to channel %s: %w", channel, err)
+	}
+
+	return nil
+}
+
+// Subscription receives the notifications of a single channel. Notifications are dropped if the subscriber
+// doesn't keep up, so a slow consumer never blocks the rest of the subscribers.
+type Subscription struct {
+	C       <-chan *Notification
+	channel string
+	ch      chan *Notification
+	hub     *Hub
+	once    sync.Once
+	mu      sync.Mutex
+	dropped uint64
+}
+
+// Channel returns the name of the channel of this subscription.
+func (s *Subscription) Channel() string {
+	return s.channel
+}
+
+// Dropped returns the count of notifications discarded because the buffer was full.
+func (s *Subscription) Dropped() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dropped
+}
+
+// Close removes the subscription from the hub and closes the notification channel.
+func (s *Subscription) Close() {
+	s.once.Do(func() {
+		s.hub.unsubscribe(s)
+	})
+}
+
+func (s *Subscription) send(n *Notification) bool {
+	select {
+	case s.ch <- n:
+		return true
+	default:
+		s.mu.Lock()
+		s.dropped++
+		s.mu.Unlock()
+		return false
+	}
+}
+
+// HubConfig represents the configuration of the notification hub.
+type HubConfig struct {
+	ConnConfig *pgx.ConnConfig // Configuration of the dedicated connection
+	BufferSize int             // Notifications buffered per subscriber
+	MinBackoff time.Duration   // Initial wait between reconnection attempts
+	MaxBackoff time.Duration   // Maximum wait between reconnection attempts
+	Logger     *slog.Logger
+}
+
+// Hub owns a dedicated database connection and multiplexes LISTEN channels to many subscribers.
+// The connection is reestablished with backoff if it drops and every channel is listened to again.
+type Hub struct {
+	config      HubConfig
+	mu          sync.Mutex
+	subscribers map[string]map[*Subscription]struct{}
+	listening   map[string]struct{}
+	wake        chan struct{}
+	connected   bool
+}
+
+// NewHub creates a hub, call Run to start receiving notifications.
+func NewHub(config HubConfig) *Hub {
+	if config.BufferSize <= 0 {
+		config.BufferSize = DefaultHubBufferSize
+	}
+	if config.MinBackoff <= 0 {
+		config.MinBackoff = DefaultHubMinBackoff
+	}
+	if config.MaxBackoff < config.MinBackoff {
+		config.MaxBackoff = DefaultHubMaxBackoff
+	}
+	if config.Logger == nil {
+		config.Logger = slog.Default()
+	}
+
+	return &Hub{
+		config:      config,
+		subscribers: make(map[string]map[*Subscription]struct{}),
+		listening:   make(map[string]struct{}),
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+// Subscribe registers a new subscriber on the channel. The channel is listened to on the next loop of Run.
+func (h *Hub) Subscribe(channel string) *Subscription {
+	ch := make(chan *Notification, h.config.BufferSize)
+	sub := &Subscription{C: ch, channel: channel, ch: ch, hub: h}
+
+	h.mu.Lock()
+	subs, ok := h.subscribers[channel]
+	if !ok {
+		subs = make(map[*Subscription]struct{})
+		h.subscribers[channel] = subs
+	}
+	subs[sub] = struct{}{}
+	h.mu.Unlock()
+
+	if !ok {
+		h.notify()
+	}
+
+	return sub
+}
+
+// Connected returns true if the hub has an active database connection.
+func (h *Hub) Connected() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.connected
+}
+
+func (h *Hub) unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	subs := h.subscribers[sub.channel]
+	delete(subs, sub)
+	empty := len(subs) == 0
+	if empty {
+		delete(h.subscribers, sub.channel)
+	}
+	h.mu.Unlock()
+
+	close(sub.ch)
+
+	if empty {
+		h.notify()
+	}
+}
+
+// notify wakes up the Run loop so it can sync the listened channels.
+func (h *Hub) notify() {
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (h *Hub) dispatch(n *Notification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[n.Channel] {
+		if !sub.send(n) {
+			h.config.Logger.Warn("Dropped notification for slow subscriber", slog.String("channel", n.Channel))
+		}
+	}
+}
+
+// pending returns the channels that must be listened and unlistened to match the current subscribers.
+func (h *Hub) pending() (listen []string, unlisten []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for channel := range h.subscribers {
+		if _, ok := h.listening[channel]; !ok {
+			listen = append(listen, channel)
+		}
+	}
+
+	for channel := range h.listening {
+		if _, ok := h.subscribers[channel]; !ok {
+			unlisten = append(unlisten, channel)
+		}
+	}
+
+	return listen, unlisten
+}
+
+func (h *Hub) setConnected(connected bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.connected = connected
+	if !connected {
+		clear(h.listening)
+	}
+}
+
+func (h *Hub) sync(ctx context.Context, conn *pgx.Conn) error {
+	listen, unlisten := h.pending()
+
+	for _, channel := range listen {
+		if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			return fmt.Errorf("failed to listen to channel %s: %w", channel, err)
+		}
+		h.mu.Lock()
+		h.listening[channel] = struct{}{}
+		h.mu.Unlock()
+	}
+
+	for _, channel := range unlisten {
+		if _, err := conn.Exec(ctx, "UNLISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			return fmt.Errorf("failed to unlisten channel %s: %w", channel, err)
+		}
+		h.mu.Lock()
+		delete(h.listening, channel)
+		h.mu.Unlock()
+	}
+
+	return nil
+}
+
+// closeAll closes the channel of every subscriber, used when the hub stops. The channels are closed after releasing
+// the lock, so a concurrent Close can finish its unsubscribe.
+func (h *Hub) closeAll() {
+	h.mu.Lock()
+	var subs []*Subscription
+	for channel, channelSubs := range h.subscribers {
+		for sub := range channelSubs {
+			subs = append(subs, sub)
+		}
+		delete(h.subscribers, channel)
+	}
+	h.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.once.Do(func() {
+			close(sub.ch)
+		})
+	}
+}
+
+// Run connects to the database and delivers notifications until the context is canceled.
+// Connection errors are logged and retried with an exponential backoff. Once stopped, the
+// channels of all the subscribers are closed.
+func (h *Hub) Run(ctx context.Context) error {
+	if h.config.ConnConfig == nil {
+		return errors.New("hub: missing connection config")
+	}
+
+	defer h.closeAll()
+
+	backoff := h.config.MinBackoff
+
+	for {
+		err := h.listen(ctx, func() {
+			// reset the backoff once the connection is established
+			backoff = h.config.MinBackoff
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		h.config.Logger.Error("Notification hub disconnected, retrying",
+			slog.String("error", err.Error()), slog.Duration("backoff", backoff))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, h.config.MaxBackoff)
+	}
+}
+
+func (h *Hub) listen(ctx context.Context, connected func()) error {
+	conn, err := pgx.ConnectConfig(ctx, h.config.ConnConfig.Copy())
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+
+	defer func() {
+		h.setConnected(false)
+		closeCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_ = conn.Close(closeCtx)
+	}()
+
+	h.setConnected(true)
+	connected()
+
+	for {
+		if err := h.sync(ctx, conn); err != nil {
+			return err
+		}
+
+		waitCtx, cancel := context.WithCancel(ctx)
+		go func() {
+			select {
+			case <-h.wake:
+				cancel()
+			case <-waitCtx.Done():
+			}
+		}()
+
+		n, err := conn.WaitForNotification(waitCtx)
+		woken := waitCtx.Err() != nil && ctx.Err() == nil
+		cancel()
+
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case err != nil && woken && !conn.IsClosed():
+			// interrupted to sync the listened channels
+			continue
+		case err != nil:
+			return err
+		}
+
+		h.dispatch(&Notification{PID: n.PID, Channel: n.Channel, Payload: n.Payload})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHubDispatch(t *testing.T) {
+	hub := NewHub(HubConfig{BufferSize: 1})
+	first := hub.Subscribe("events")
+	second := hub.Subscribe("events")
+	other := hub.Subscribe("other")
+
+	hub.dispatch(&Notification{Channel: "events", Payload: "1"})
+
+	assert.Equal(t, "1", (<-first.C).Payload)
+	assert.Equal(t, "1", (<-second.C).Payload)
+	assert.Len(t, other.C, 0)
+}
+
+func TestHubSlowSubscriber(t *testing.T) {
+	hub := NewHub(HubConfig{BufferSize: 1})
+	slow := hub.Subscribe("events")
+	fast := hub.Subscribe("events")
+
+	hub.dispatch(&Notification{Channel: "events", Payload: "1"})
+	assert.Equal(t, "1", (<-fast.C).Payload)
+	hub.dispatch(&Notification{Channel: "events", Payload: "2"})
+	assert.Equal(t, "2", (<-fast.C).Payload)
+
+	assert.Equal(t, uint64(1), slow.Dropped())
+	assert.Equal(t, uint64(0), fast.Dropped())
+	assert.Equal(t, "1", (<-slow.C).Payload)
+}
+
+func TestHubPending(t *testing.T) {
+	hub := NewHub(HubConfig{})
+	sub := hub.Subscribe("events")
+
+	listen, unlisten := hub.pending()
+	assert.Equal(t, []string{"events"}, listen)
+	assert.Empty(t, unlisten)
+
+	hub.listening["events"] = struct{}{}
+	sub.Close()
+
+	listen, unlisten = hub.pending()
+	assert.Empty(t, listen)
+	assert.Equal(t, []string{"events"}, unlisten)
+
+	_, ok := <-sub.C
+	assert.False(t, ok)
+}
+
+func TestDecode(t *testing.T) {
+	type payload struct {
+		ID int64 `json:"id"`
+	}
+
+	result, err := Decode[payload](&Notification{Channel: "events", Payload: `{"id": 5}`})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), result.ID)
+
+	_, err = Decode[payload](&Notification{Channel: "events", Payload: "invalid"})
+	assert.Error(t, err)
+}
+
+func TestHubCloseAll(t *testing.T) {
+	hub := NewHub(HubConfig{})
+	sub := hub.Subscribe("events")
+
+	hub.closeAll()
+	_, ok := <-sub.C
+	assert.False(t, ok)
+
+	// closing after the hub stopped must not panic
+	sub.Close()
+}
+
+func TestHubCloseAllConcurrent(t *testing.T) {
+	hub := NewHub(HubConfig{})
+	subs := make([]*Subscription, 100)
+	for i := range subs {
+		subs[i] = hub.Subscribe("events")
+	}
+
+	var wg sync.WaitGroup
+	for _, sub := range subs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sub.Close()
+		}()
+	}
+	hub.closeAll()
+	wg.Wait()
+
+	for _, sub := range subs {
+		_, ok := <-sub.C
+		assert.False(t, ok)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "go.megpoid.dev/go-skel/pkg/sql"
+
+type otelSpanKey struct{}
+
+// OtelTracer is a pgx tracer that creates an OpenTelemetry span for every query. The span is a child of
+// the span on the query context, if any.
+type OtelTracer struct {
+	tracer trace.Tracer
+}
+
+// NewOtelTracer creates a tracer that uses the given provider, or the global provider if nil.
+func NewOtelTracer(provider trace.TracerProvider) *OtelTracer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	return &OtelTracer{tracer: provider.Tracer(tracerName)}
+}
+
+func (t *OtelTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	// don't create root spans for queries made outside a traced operation
+	if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		return ctx
+	}
+
+	statement := NormalizeSQL(data.SQL)
+	attrs := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBQueryText(statement),
+		),
+	}
+
+	operation, _, _ := strings.Cut(statement, " ")
+	operation = strings.ToUpper(operation)
+	if operation != "" {
+		attrs = append(attrs, trace.WithAttributes(semconv.DBOperationName(operation)))
+	} else {
+		operation = "query"
+	}
+
+	if conn != nil {
+		config := conn.Config()
+		attrs = append(attrs, trace.WithAttributes(
+			semconv.DBNamespace(config.Database),
+			semconv.ServerAddress(config.Host),
+			semconv.ServerPort(int(config.Port)),
+		))
+	}
+
+	ctx, span := t.tracer.Start(ctx, operation, attrs...)
+
+	return context.WithValue(ctx, otelSpanKey{}, span)
+}
+
+func (t *OtelTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	// the span is only set if the query started one
+	span, ok := ctx.Value(otelSpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+
+	span.SetAttributes(semconv.DBResponseReturnedRows(int(data.CommandTag.RowsAffected())))
+
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	}
+
+	span.End()
+}
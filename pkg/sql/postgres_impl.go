@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgx/v5/multitracer"
 	"github.com/jackc/pgx/v5/tracelog"
 
 	// import postgres dialect for goqu library
@@ -145,6 +146,11 @@ type Config struct {
 	AfterRelease    func(*pgx.Conn) bool
 	Logger          *slog.Logger
 	OmitArgs        bool
+	// Queries slower than this duration are logged as warnings, zero or negative disables it. The server config
+	// replaces zero with its default, so only a negative value disables it from the flags.
+	SlowQueryThreshold time.Duration
+	// Statistics of the executed queries, nil disables them
+	QueryStats *QueryStats
 }
 
 // NewConnection creates a new connection pool with the given configurationand returns a pointer to the pool.
@@ -167,15 +173,22 @@ func NewConnection(config Config) (*pgxpool.Pool, error) {
 		parseConfig.MaxConns = int32(config.MaxOpenConns)
 	}
 
+	tracers := []pgx.QueryTracer{
+		NewOtelTracer(nil),
+		NewQueryTracer(slog.Default(), config.SlowQueryThreshold, config.QueryStats),
+	}
+
 	if config.Logger != nil {
 		adapterLogger := NewLogger(config.Logger, config.OmitArgs)
 
-		parseConfig.ConnConfig.Tracer = &tracelog.TraceLog{
+		tracers = append(tracers, &tracelog.TraceLog{
 			Logger:   adapterLogger,
 			LogLevel: tracelog.LogLevelTrace,
-		}
+		})
 	}
 
+	parseConfig.ConnConfig.Tracer = multitracer.New(tracers...)
+
 	pool, err := pgxpool.NewWithConfig(context.Background(), parseConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database, aborting: %w", err)
@@ -0,0 +1,265 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxStatements is the maximum count of distinct statements tracked by QueryStats.
+	DefaultMaxStatements = 1000
+	// OtherStatements groups the queries received after reaching the maximum count of statements.
+	OtherStatements = "(other)"
+	// querySamples is the count of recent durations kept per statement to calculate the percentiles.
+	querySamples = 512
+)
+
+// QueryStat is a snapshot of the statistics of a single normalized statement.
+type QueryStat struct {
+	Statement string
+	Calls     int64
+	Errors    int64
+	Rows      int64
+	Total     time.Duration
+	Mean      time.Duration
+	P95       time.Duration
+	Max       time.Duration
+}
+
+type queryStat struct {
+	calls   int64
+	errors  int64
+	rows    int64
+	total   time.Duration
+	max     time.Duration
+	samples []time.Duration
+	next    int
+}
+
+func (s *queryStat) record(duration time.Duration, rows int64, failed bool) {
+	s.calls++
+	s.rows += rows
+	s.total += duration
+	s.max = max(s.max, duration)
+	if failed {
+		s.errors++
+	}
+
+	if len(s.samples) < querySamples {
+		s.samples = append(s.samples, duration)
+	} else {
+		s.samples[s.next] = duration
+		s.next = (s.next + 1) % querySamples
+	}
+}
+
+// percentile returns the p-th percentile of the recent samples.
+func (s *queryStat) percentile(p float64) time.Duration {
+	if len(s.samples) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(s.samples)
+	slices.Sort(sorted)
+
+	return sorted[int(float64(len(sorted)-1)*p)]
+}
+
+// QueryStats keeps in-process statistics of the executed queries, grouped by normalized statement.
+type QueryStats struct {
+	mu            sync.Mutex
+	maxStatements int
+	stats         map[string]*queryStat
+	since         time.Time
+}
+
+// NewQueryStats creates an empty statistics store. If maxStatements is zero then DefaultMaxStatements is used.
+func NewQueryStats(maxStatements int) *QueryStats {
+	if maxStatements <= 0 {
+		maxStatements = DefaultMaxStatements
+	}
+
+	return &QueryStats{
+		maxStatements: maxStatements,
+		stats:         make(map[string]*queryStat),
+		since:         time.Now(),
+	}
+}
+
+// Record adds the execution of a normalized statement to the statistics.
+func (q *QueryStats) Record(statement string, duration time.Duration, rows int64, failed bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stat, ok := q.stats[statement]
+	if !ok {
+		if len(q.stats) >= q.maxStatements {
+			statement = OtherStatements
+			stat = q.stats[statement]
+		}
+		if stat == nil {
+			stat = &queryStat{}
+			q.stats[statement] = stat
+		}
+	}
+
+	stat.record(duration, rows, failed)
+}
+
+// Snapshot returns the statistics of every statement, sorted by total time in descending order.
+func (q *QueryStats) Snapshot() []QueryStat {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	results := make([]QueryStat, 0, len(q.stats))
+	for statement, stat := range q.stats {
+		results = append(results, QueryStat{
+			Statement: statement,
+			Calls:     stat.calls,
+			Errors:    stat.errors,
+			Rows:      stat.rows,
+			Total:     stat.total,
+			Mean:      stat.total / time.Duration(stat.calls),
+			P95:       stat.percentile(0.95),
+			Max:       stat.max,
+		})
+	}
+
+	slices.SortFunc(results, func(a, b QueryStat) int {
+		if c := cmp.Compare(b.Total, a.Total); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Statement, b.Statement)
+	})
+
+	return results
+}
+
+// Since returns the time when the statistics started to be collected.
+func (q *QueryStats) Since() time.Time {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.since
+}
+
+// Reset removes all the collected statistics.
+func (q *QueryStats) Reset() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	clear(q.stats)
+	q.since = time.Now()
+}
+
+// NormalizeSQL replaces the literals and placeholders of the query with "?", collapses lists of values and
+// whitespace, so the same statement executed with different arguments is grouped together.
+func NormalizeSQL(query string) string {
+	var sb strings.Builder
+	sb.Grow(len(query))
+
+	space := false
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			space = sb.Len() > 0
+			continue
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			// skip the comment until the end of the line
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+			space = sb.Len() > 0
+			continue
+		}
+
+		if space {
+			sb.WriteByte(' ')
+			space = false
+		}
+
+		switch {
+		case c == '\'':
+			// string literal, quotes are escaped by doubling them
+			for i++; i < len(query); i++ {
+				if query[i] == '\'' {
+					if i+1 < len(query) && query[i+1] == '\'' {
+						i++
+						continue
+					}
+					break
+				}
+			}
+			sb.WriteByte('?')
+		case c == '$' && i+1 < len(query) && isDigit(query[i+1]):
+			for i+1 < len(query) && isDigit(query[i+1]) {
+				i++
+			}
+			sb.WriteByte('?')
+		case isDigit(c) && (i == 0 || !isIdentifier(query[i-1])):
+			for i+1 < len(query) && (isDigit(query[i+1]) || query[i+1] == '.') {
+				i++
+			}
+			sb.WriteByte('?')
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return collapseLists(sb.String())
+}
+
+// collapseLists replaces lists of placeholders like "(?, ?, ?)" with "(?, ...)".
+func collapseLists(query string) string {
+	var sb strings.Builder
+	sb.Grow(len(query))
+
+	for i := 0; i < len(query); i++ {
+		sb.WriteByte(query[i])
+		if query[i] != '?' {
+			continue
+		}
+
+		// look ahead for repeated ", ?" items
+		j, repeated := i+1, false
+		for {
+			k := j
+			for k < len(query) && query[k] == ' ' {
+				k++
+			}
+			if k >= len(query) || query[k] != ',' {
+				break
+			}
+			k++
+			for k < len(query) && query[k] == ' ' {
+				k++
+			}
+			if k >= len(query) || query[k] != '?' {
+				break
+			}
+			j, repeated = k+1, true
+		}
+
+		if repeated {
+			sb.WriteString(", ...")
+			i = j - 1
+		}
+	}
+
+	return sb.String()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifier(c byte) bool {
+	return c == '_' || c == '.' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
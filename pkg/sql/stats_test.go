@@ -0,0 +1,80 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSQL(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"SELECT *  FROM profiles\n\tWHERE id = $1", "SELECT * FROM profiles WHERE id = ?"},
+		{"SELECT * FROM profiles WHERE email = 'john@example.com' LIMIT 10", "SELECT * FROM profiles WHERE email = ? LIMIT ?"},
+		{"SELECT * FROM profiles WHERE name = 'it''s'", "SELECT * FROM profiles WHERE name = ?"},
+		{`SELECT * FROM "profiles" WHERE "id" IN ($1, $2, $3)`, `SELECT * FROM "profiles" WHERE "id" IN (?, ...)`},
+		{"INSERT INTO t1 (a, b) VALUES ($1,$2) -- comment\n", "INSERT INTO t1 (a, b) VALUES (?, ...)"},
+		{"SELECT 1.5", "SELECT ?"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, NormalizeSQL(test.query))
+	}
+}
+
+func TestQueryStats(t *testing.T) {
+	stats := NewQueryStats(2)
+
+	for i := 1; i <= 100; i++ {
+		stats.Record("SELECT ?", time.Duration(i)*time.Millisecond, 1, i == 100)
+	}
+	stats.Record("UPDATE t SET a = ?", time.Second, 5, false)
+	stats.Record("DELETE FROM t", time.Millisecond, 0, false)
+
+	result := stats.Snapshot()
+	assert.Len(t, result, 3)
+
+	assert.Equal(t, "SELECT ?", result[0].Statement)
+	assert.Equal(t, int64(100), result[0].Calls)
+	assert.Equal(t, int64(1), result[0].Errors)
+	assert.Equal(t, int64(100), result[0].Rows)
+	assert.Equal(t, 95*time.Millisecond, result[0].P95)
+	assert.Equal(t, 100*time.Millisecond, result[0].Max)
+	assert.Equal(t, 5050*time.Millisecond/100, result[0].Mean)
+
+	assert.Equal(t, "UPDATE t SET a = ?", result[1].Statement)
+	assert.Equal(t, OtherStatements, result[2].Statement)
+
+	stats.Reset()
+	assert.Empty(t, stats.Snapshot())
+}
+
+func TestQueryTracer(t *testing.T) {
+	var buf bytes.Buffer
+	stats := NewQueryStats(0)
+	tracer := NewQueryTracer(slog.New(slog.NewTextHandler(&buf, nil)), time.Nanosecond, stats)
+
+	ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "SELECT * FROM t WHERE id = $1"})
+	time.Sleep(time.Millisecond)
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 3")})
+
+	result := stats.Snapshot()
+	assert.Len(t, result, 1)
+	assert.Equal(t, "SELECT * FROM t WHERE id = ?", result[0].Statement)
+	assert.Equal(t, int64(3), result[0].Rows)
+
+	assert.Contains(t, buf.String(), "Slow query")
+	assert.Contains(t, buf.String(), "caller=")
+}
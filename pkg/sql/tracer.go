@@ -0,0 +1,121 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"log/slog"
+	"path"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	sql   string
+	start time.Time
+}
+
+// packages skipped when looking for the caller of a query
+var tracerSkipPackages = func() []string {
+	pkg := reflect.TypeOf(QueryTracer{}).PkgPath()
+	return []string{
+		"runtime.",
+		"github.com/jackc/pgx/",
+		"github.com/georgysavva/scany/",
+		"github.com/doug-martin/goqu/",
+		pkg + ".",
+		path.Dir(pkg) + "/repo.",
+	}
+}()
+
+// QueryTracer is a pgx tracer that logs the queries slower than a threshold and records the statistics
+// of every query.
+type QueryTracer struct {
+	logger    *slog.Logger
+	threshold time.Duration
+	stats     *QueryStats
+}
+
+// NewQueryTracer creates a tracer. Slow query logging is disabled if threshold is zero or negative and statistics
+// are not recorded if stats is nil.
+func NewQueryTracer(l *slog.Logger, threshold time.Duration, stats *QueryStats) *QueryTracer {
+	if l == nil {
+		l = slog.Default()
+	}
+
+	return &QueryTracer{logger: l, threshold: threshold, stats: stats}
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryTraceKey{}, &queryTrace{sql: data.SQL, start: time.Now()})
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	trace, ok := ctx.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		return
+	}
+
+	duration := time.Since(trace.start)
+	slow := t.threshold > 0 && duration >= t.threshold
+
+	if t.stats == nil && !slow {
+		return
+	}
+
+	statement := NormalizeSQL(trace.sql)
+	rows := data.CommandTag.RowsAffected()
+
+	if t.stats != nil {
+		t.stats.Record(statement, duration, rows, data.Err != nil)
+	}
+
+	if slow {
+		attrs := []slog.Attr{
+			slog.String("sql", statement),
+			slog.Duration("duration", duration),
+			slog.Int64("rows", rows),
+			slog.String("caller", queryCaller()),
+		}
+		if data.Err != nil {
+			attrs = append(attrs, slog.String("error", data.Err.Error()))
+		}
+
+		t.logger.LogAttrs(ctx, slog.LevelWarn, "Slow query", attrs...)
+	}
+}
+
+// queryCaller returns the location of the first function outside the database packages.
+func queryCaller() string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+		if !skipFrame(frame.Function) {
+			return frame.File + ":" + strconv.Itoa(frame.Line)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+func skipFrame(function string) bool {
+	for _, prefix := range tracerSkipPackages {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+	return false
+}
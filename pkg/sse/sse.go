@@ -0,0 +1,127 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package sse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	MIMETextEventStream = "text/event-stream"
+	DefaultKeepAlive    = 15 * time.Second
+)
+
+// Event represents a single message of a Server-Sent Events stream.
+type Event struct {
+	ID    string
+	Event string
+	Retry time.Duration
+	// Data is sent as is if it's a string or byte slice, other values are encoded as JSON.
+	Data any
+}
+
+// Encode writes the event using the text/event-stream format.
+func (e *Event) Encode(w io.Writer) error {
+	var buf bytes.Buffer
+
+	if e.ID != "" {
+		buf.WriteString("id: " + e.ID + "\n")
+	}
+	if e.Event != "" {
+		buf.WriteString("event: " + e.Event + "\n")
+	}
+	if e.Retry > 0 {
+		buf.WriteString(fmt.Sprintf("retry: %d\n", e.Retry.Milliseconds()))
+	}
+
+	var data string
+	switch v := e.Data.(type) {
+	case nil:
+	case string:
+		data = v
+	case []byte:
+		data = string(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to encode event data: %w", err)
+		}
+		data = string(encoded)
+	}
+
+	for _, line := range strings.Split(data, "\n") {
+		buf.WriteString("data: " + line + "\n")
+	}
+	buf.WriteString("\n")
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// Writer sends events to a http response, flushing after every write.
+type Writer struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+// NewWriter prepares the response for streaming. The write deadline of the server is removed
+// so the stream can outlive the server write timeout.
+func NewWriter(w http.ResponseWriter) (*Writer, error) {
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !isNotSupported(err) {
+		return nil, fmt.Errorf("failed to clear write deadline: %w", err)
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", MIMETextEventStream)
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	s := &Writer{w: w, rc: rc}
+	if err := s.flush(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Send writes the event and flushes it to the client.
+func (s *Writer) Send(event Event) error {
+	if err := event.Encode(s.w); err != nil {
+		return err
+	}
+
+	return s.flush()
+}
+
+// KeepAlive sends a comment line so proxies don't close an idle stream.
+func (s *Writer) KeepAlive() error {
+	if _, err := io.WriteString(s.w, ": keep-alive\n\n"); err != nil {
+		return err
+	}
+
+	return s.flush()
+}
+
+func (s *Writer) flush() error {
+	if err := s.rc.Flush(); err != nil && !isNotSupported(err) {
+		return err
+	}
+
+	return nil
+}
+
+func isNotSupported(err error) bool {
+	return errors.Is(err, http.ErrNotSupported)
+}
@@ -0,0 +1,45 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package sse
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventEncode(t *testing.T) {
+	var sb strings.Builder
+	event := Event{ID: "1", Event: "state", Retry: time.Second, Data: "line1\nline2"}
+	err := event.Encode(&sb)
+	assert.NoError(t, err)
+	assert.Equal(t, "id: 1\nevent: state\nretry: 1000\ndata: line1\ndata: line2\n\n", sb.String())
+}
+
+func TestEventEncodeJSON(t *testing.T) {
+	var sb strings.Builder
+	event := Event{Event: "progress", Data: map[string]int{"current": 1}}
+	err := event.Encode(&sb)
+	assert.NoError(t, err)
+	assert.Equal(t, "event: progress\ndata: {\"current\":1}\n\n", sb.String())
+}
+
+func TestWriter(t *testing.T) {
+	rec := httptest.NewRecorder()
+	w, err := NewWriter(rec)
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.Send(Event{Event: "state", Data: "running"}))
+	assert.NoError(t, w.KeepAlive())
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, MIMETextEventStream, rec.Header().Get("Content-Type"))
+	assert.Equal(t, "event: state\ndata: running\n\n: keep-alive\n\n", rec.Body.String())
+	assert.True(t, rec.Flushed)
+}
@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
 )
 
 // used to validate that the implementation matches the interface
@@ -21,15 +23,41 @@ type AsynqTask struct {
 	client    *asynq.Client
 }
 
-func (u *AsynqTask) Enqueue(ctx context.Context, task *asynq.Task) (string, error) {
-	info, err := u.client.EnqueueContext(ctx, task, asynq.Queue(DefaultQueueName), asynq.Retention(24*time.Hour))
+// Enqueue adds the task to the default queue, unless another queue is given in the options. The trace context is
+// added to the metadata of JSON object payloads, so the task options must be passed on enqueue instead of on the
+// task creation.
+func (u *AsynqTask) Enqueue(ctx context.Context, task *asynq.Task, opts ...asynq.Option) (string, error) {
+	opts = append([]asynq.Option{asynq.Queue(DefaultQueueName), asynq.Retention(24 * time.Hour)}, opts...)
+
+	ctx, span, payload := startEnqueueSpan(ctx, task, queueName(opts))
+	defer span.End()
+
+	task = asynq.NewTask(task.Type(), payload, opts...)
+
+	info, err := u.client.EnqueueContext(ctx, task)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return "", fmt.Errorf("failed to enqueue task: %w", err)
 	}
 
+	span.SetAttributes(semconv.MessagingMessageID(info.ID))
+
 	return info.ID, err
 }
 
+// queueName returns the queue set by the options, the last one wins like on asynq.
+func queueName(opts []asynq.Option) string {
+	name := DefaultQueueName
+	for _, opt := range opts {
+		if opt.Type() == asynq.QueueOpt {
+			name = opt.Value().(string)
+		}
+	}
+
+	return name
+}
+
 func (u *AsynqTask) GetTaskInfo(_ context.Context, queue, id string) (*Info, error) {
 	info, err := u.inspector.GetTaskInfo(queue, id)
 	if err != nil {
@@ -63,6 +91,13 @@ func (u *AsynqTask) GetTaskInfo(_ context.Context, queue, id string) (*Info, err
 		status.Error = info.LastErr
 	}
 
+	if len(info.Result) > 0 {
+		var response Response
+		if err := json.Unmarshal(info.Result, &response); err == nil {
+			status.Progress = response.Progress
+		}
+	}
+
 	return status, nil
 }
 
@@ -84,6 +119,31 @@ func (u *AsynqTask) GetTaskResponse(_ context.Context, queue, id string) (*Respo
 	return response, nil
 }
 
+// ReportProgress stores the progress of a running task, it is replaced once the task writes its result.
+func ReportProgress(t *asynq.Task, progress Progress) error {
+	data, err := json.Marshal(Response{Progress: &progress})
+	if err != nil {
+		return fmt.Errorf("failed to encode progress: %w", err)
+	}
+
+	if _, err := t.ResultWriter().Write(data); err != nil {
+		return fmt.Errorf("failed to write progress: %w", err)
+	}
+
+	return nil
+}
+
+// Inspector returns the inspector used to query the state of the queues.
+func (u *AsynqTask) Inspector() *asynq.Inspector {
+	return u.inspector
+}
+
+// Ping returns an error if the Redis server doesn't respond. The context is ignored since the client doesn't
+// support it, so the caller must enforce its own timeout.
+func (u *AsynqTask) Ping(_ context.Context) error {
+	return u.client.Ping()
+}
+
 func NewClient(redis asynq.RedisClientOpt) *AsynqTask {
 	return &AsynqTask{
 		inspector: asynq.NewInspector(redis),
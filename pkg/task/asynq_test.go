@@ -0,0 +1,18 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package task
+
+import (
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueName(t *testing.T) {
+	assert.Equal(t, DefaultQueueName, queueName(nil))
+	assert.Equal(t, DefaultQueueName, queueName([]asynq.Option{asynq.MaxRetry(3)}))
+	assert.Equal(t, "critical", queueName([]asynq.Option{asynq.Queue(DefaultQueueName), asynq.Queue("critical")}))
+}
@@ -13,15 +13,23 @@ import (
 const DefaultQueueName = "default"
 
 type Task interface {
-	Enqueue(ctx context.Context, task *asynq.Task) (string, error)
+	Enqueue(ctx context.Context, task *asynq.Task, opts ...asynq.Option) (string, error)
 	GetTaskInfo(ctx context.Context, queue, id string) (*Info, error)
 	GetTaskResponse(ctx context.Context, queue, id string) (*Response, error)
 }
 
 type Info struct {
-	ID    string `json:"id"`
-	State string `json:"status"`
-	Error string `json:"error"`
+	ID       string    `json:"id"`
+	State    string    `json:"status"`
+	Error    string    `json:"error"`
+	Progress *Progress `json:"progress,omitempty"`
+}
+
+// Progress is reported by a running task to inform how much work has been completed.
+type Progress struct {
+	Current int64  `json:"current"`
+	Total   int64  `json:"total"`
+	Message string `json:"message,omitempty"`
 }
 
 type Error struct {
@@ -30,7 +38,8 @@ type Error struct {
 }
 
 type Response struct {
-	ContentType string `json:"content_type"`
-	Data        any    `json:"data"`
-	Error       *Error `json:"error,omitempty"`
+	ContentType string    `json:"content_type"`
+	Data        any       `json:"data"`
+	Error       *Error    `json:"error,omitempty"`
+	Progress    *Progress `json:"progress,omitempty"`
 }
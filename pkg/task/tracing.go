@@ -0,0 +1,131 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package task
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/hibiken/asynq"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// MetadataKey is the payload field used to carry the task metadata, like the trace context.
+	MetadataKey = "_metadata"
+
+	tracerName      = "go.megpoid.dev/go-skel/pkg/task"
+	messagingSystem = "asynq"
+)
+
+// InjectMetadata adds the metadata to a JSON object payload. Other payloads are returned unchanged.
+func InjectMetadata(payload []byte, metadata map[string]string) []byte {
+	if len(metadata) == 0 || !bytes.HasPrefix(bytes.TrimSpace(payload), []byte("{")) {
+		return payload
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return payload
+	}
+
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		return payload
+	}
+	fields[MetadataKey] = encoded
+
+	result, err := json.Marshal(fields)
+	if err != nil {
+		return payload
+	}
+
+	return result
+}
+
+// ExtractMetadata returns the metadata of the payload, or nil if it doesn't have any.
+func ExtractMetadata(payload []byte) map[string]string {
+	if !bytes.HasPrefix(bytes.TrimSpace(payload), []byte("{")) {
+		return nil
+	}
+
+	var fields struct {
+		Metadata map[string]string `json:"_metadata"`
+	}
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil
+	}
+
+	return fields.Metadata
+}
+
+// startEnqueueSpan starts the producer span of the task and returns the payload with the trace context.
+func startEnqueueSpan(ctx context.Context, t *asynq.Task, queue string) (context.Context, trace.Span, []byte) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "send "+t.Type(),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", messagingSystem),
+			semconv.MessagingOperationTypeSend,
+			semconv.MessagingOperationName("send"),
+			semconv.MessagingDestinationName(queue),
+			attribute.String("task.type", t.Type()),
+		),
+	)
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	return ctx, span, InjectMetadata(t.Payload(), carrier)
+}
+
+// TracingMiddleware starts a consumer span for every processed task, linked to the trace of the request
+// that enqueued it.
+func TracingMiddleware() asynq.MiddlewareFunc {
+	tracer := otel.Tracer(tracerName)
+
+	return func(next asynq.Handler) asynq.Handler {
+		return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+			if metadata := ExtractMetadata(t.Payload()); metadata != nil {
+				ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(metadata))
+			}
+
+			attrs := []attribute.KeyValue{
+				attribute.String("messaging.system", messagingSystem),
+				semconv.MessagingOperationTypeProcess,
+				semconv.MessagingOperationName("process"),
+				attribute.String("task.type", t.Type()),
+			}
+			if id, ok := asynq.GetTaskID(ctx); ok {
+				attrs = append(attrs, semconv.MessagingMessageID(id))
+			}
+			if queue, ok := asynq.GetQueueName(ctx); ok {
+				attrs = append(attrs, semconv.MessagingDestinationName(queue))
+			}
+			if retry, ok := asynq.GetRetryCount(ctx); ok {
+				attrs = append(attrs, attribute.Int("task.retry_count", retry))
+			}
+
+			ctx, span := tracer.Start(ctx, "process "+t.Type(),
+				trace.WithSpanKind(trace.SpanKindConsumer),
+				trace.WithAttributes(attrs...),
+			)
+			defer span.End()
+
+			err := next.ProcessTask(ctx, t)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			return err
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMetadata(t *testing.T) {
+	payload := InjectMetadata([]byte(`{"delay": 5}`), map[string]string{"traceparent": "value"})
+
+	var fields map[string]any
+	assert.NoError(t, json.Unmarshal(payload, &fields))
+	assert.Equal(t, float64(5), fields["delay"])
+	assert.Equal(t, map[string]string{"traceparent": "value"}, ExtractMetadata(payload))
+
+	// only JSON objects can carry metadata
+	assert.Equal(t, []byte("raw"), InjectMetadata([]byte("raw"), map[string]string{"traceparent": "value"}))
+	assert.Nil(t, ExtractMetadata([]byte("raw")))
+}
+
+func TestTracePropagation(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "request")
+	_, span, payload := startEnqueueSpan(ctx, asynq.NewTask("test:task", []byte(`{}`)), DefaultQueueName)
+	span.End()
+	parent.End()
+
+	var processed trace.SpanContext
+	handler := TracingMiddleware()(asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+		processed = trace.SpanContextFromContext(ctx)
+		return nil
+	}))
+
+	assert.NoError(t, handler.ProcessTask(context.Background(), asynq.NewTask("test:task", payload)))
+	assert.Equal(t, parent.SpanContext().TraceID(), processed.TraceID())
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 3)
+	assert.Equal(t, "process test:task", spans[2].Name())
+	assert.Equal(t, span.SpanContext().SpanID(), spans[2].Parent().SpanID())
+}
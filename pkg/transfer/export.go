@@ -0,0 +1,168 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.megpoid.dev/go-skel/pkg/repo/filter"
+	"go.megpoid.dev/go-skel/pkg/sql"
+)
+
+type ExportOptions struct {
+	Table  string
+	Format Format
+	// Where filters the rows with the syntax of the API, e.g. "status__eq=active&age__gte=18"
+	Where string
+}
+
+// Export streams the rows of the table to the writer using COPY and returns the exported row count. The generated
+// columns are skipped, so the output can be imported again.
+func Export(ctx context.Context, pool *pgxpool.Pool, w io.Writer, opts ExportOptions) (int64, error) {
+	columns, err := tableColumns(ctx, pool, opts.Table)
+	if err != nil {
+		return 0, err
+	}
+
+	selected := make([]any, 0, len(columns))
+	for _, c := range columns {
+		if !c.generated {
+			selected = append(selected, goqu.I(c.name))
+		}
+	}
+
+	query := sql.NewQueryBuilder().From(goqu.I(opts.Table)).Select(selected...)
+
+	if opts.Where != "" {
+		conditions, err := ParseWhere(opts.Where)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, condition := range conditions {
+			if _, ok := findColumn(columns, condition.Field); !ok {
+				return 0, fmt.Errorf("unknown column on filter: %s", condition.Field)
+			}
+		}
+
+		query, err = filter.New(filter.WithRules(filterRules(columns)...), filter.WithConditions(conditions...)).Apply(query)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// COPY doesn't accept parameters, so the values are interpolated
+	selectSQL, _, err := query.ToSQL()
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate SQL query: %w", err)
+	}
+
+	var copySQL string
+	switch opts.Format {
+	case FormatNDJSON:
+		copySQL = "COPY (SELECT row_to_json(t) FROM (" + selectSQL + ") t) TO STDOUT"
+		w = &copyTextWriter{w: w}
+	default:
+		copySQL = "COPY (" + selectSQL + ") TO STDOUT WITH (FORMAT csv, HEADER true)"
+	}
+
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	tag, err := conn.Conn().PgConn().CopyTo(ctx, w, copySQL)
+	if err != nil {
+		return 0, fmt.Errorf("failed to export %s: %w", opts.Table, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// ParseWhere parses the filters using the syntax of the API query, e.g. "status__eq=active&age__gte=18".
+func ParseWhere(where string) ([]filter.Condition, error) {
+	values, err := url.ParseQuery(where)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	var conditions []filter.Condition
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		parts := strings.Split(key, "__")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid filter: %s", key)
+		}
+		for _, value := range values[key] {
+			conditions = append(conditions, filter.Condition{
+				Field:     parts[0],
+				Operation: filter.OperationType(parts[1]),
+				Value:     value,
+			})
+		}
+	}
+
+	return conditions, nil
+}
+
+// filterRules allows filtering by any column, using the type of the column to parse the values.
+func filterRules(columns []column) []filter.Rule {
+	rules := make([]filter.Rule, 0, len(columns))
+	for _, c := range columns {
+		rule := filter.Rule{Key: c.name, Type: filter.VariableString, AcceptNull: c.nullable}
+		switch c.category {
+		case "N":
+			if slices.Contains([]string{"smallint", "integer", "bigint"}, c.dataType) {
+				rule.Type = filter.VariableInteger
+			} else {
+				rule.Type = filter.VariableDecimal
+			}
+		case "B":
+			rule.Type = filter.VariableBool
+		case "D":
+			if c.dataType == "date" {
+				rule.Type = filter.VariableDate
+			} else {
+				rule.Type = filter.VariableTimestamp
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// copyTextWriter undoes the escaping of the COPY text format. The JSON rows don't have control characters, so
+// the only escaped character is the backslash.
+type copyTextWriter struct {
+	w       io.Writer
+	escaped bool
+}
+
+func (c *copyTextWriter) Write(p []byte) (int, error) {
+	buf := make([]byte, 0, len(p))
+	for _, b := range p {
+		if !c.escaped && b == '\\' {
+			c.escaped = true
+			continue
+		}
+		c.escaped = false
+		buf = append(buf, b)
+	}
+
+	if _, err := c.w.Write(buf); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
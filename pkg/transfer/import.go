@@ -0,0 +1,635 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// DefaultBatchSize is the count of rows copied to the database at once.
+	DefaultBatchSize = 1000
+
+	stagingTable = "transfer_staging"
+	// lineColumn has the line of the staged row, the name is reserved so it doesn't clash with the imported columns
+	lineColumn  = "_transfer_line"
+	maxLineSize = 16 * 1024 * 1024
+)
+
+type ImportOptions struct {
+	Table  string
+	Format Format
+	// ConflictTarget are the columns of the unique constraint used to update the rows that already exist
+	ConflictTarget []string
+	// Rejects receives the rows that couldn't be imported as JSON lines with the line number and error
+	Rejects   io.Writer
+	BatchSize int
+}
+
+type ImportResult struct {
+	Imported int64
+	Rejected int64
+}
+
+// Rejection is a row that couldn't be imported.
+type Rejection struct {
+	Line  int64              `json:"line"`
+	Error string             `json:"error"`
+	Row   map[string]*string `json:"row,omitempty"`
+}
+
+type record struct {
+	line   int64
+	values []*string
+}
+
+// Import reads the rows in CSV or NDJSON format and inserts them into the table, in a single transaction. The
+// rows are copied to a staging table in batches, then inserted with the values cast to the column types. If a
+// batch fails the rows are inserted one by one, so only the invalid ones are rejected.
+//
+// The columns are taken from the CSV header or the keys of the first JSON object. Like COPY, the unquoted empty
+// CSV fields are imported as NULL and the quoted ones as empty strings. The missing JSON keys are NULL too.
+func Import(ctx context.Context, pool *pgxpool.Pool, r io.Reader, opts ImportOptions) (*ImportResult, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+
+	available, err := tableColumns(ctx, pool, opts.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	var reader recordReader
+	switch opts.Format {
+	case FormatNDJSON:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(nil, maxLineSize)
+		reader = &jsonReader{scanner: scanner}
+	default:
+		reader = newCSVReader(r)
+	}
+
+	names, err := reader.header()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]column, 0, len(names))
+	for _, name := range names {
+		if name == lineColumn {
+			return nil, fmt.Errorf("column %s is reserved and can't be imported", name)
+		}
+		c, ok := findColumn(available, name)
+		if !ok {
+			return nil, fmt.Errorf("unknown column %s on table %s", name, opts.Table)
+		}
+		if c.generated {
+			return nil, fmt.Errorf("column %s is generated and can't be imported", name)
+		}
+		columns = append(columns, c)
+	}
+
+	for _, target := range opts.ConflictTarget {
+		if !slices.Contains(names, target) {
+			return nil, fmt.Errorf("conflict column %s isn't imported", target)
+		}
+	}
+
+	imp := &importer{
+		table:   opts.Table,
+		columns: columns,
+		target:  opts.ConflictTarget,
+		rejects: opts.Rejects,
+		result:  &ImportResult{},
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		err := tx.Rollback(ctx)
+		if err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slog.Error("Failed to rollback transaction", slog.String("error", err.Error()))
+		}
+	}()
+
+	if err := imp.createStaging(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	batch := make([]record, 0, opts.BatchSize)
+	for {
+		rec, err := reader.next(names)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *parseError
+		if errors.As(err, &parseErr) {
+			if err := imp.reject(parseErr.line, parseErr.err, nil); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		batch = append(batch, rec)
+		if len(batch) == opts.BatchSize {
+			if err := imp.flush(ctx, tx, batch); err != nil {
+				return nil, err
+			}
+			batch = batch[:0]
+		}
+	}
+
+	if len(batch) > 0 {
+		if err := imp.flush(ctx, tx, batch); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := imp.syncIdentities(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return imp.result, nil
+}
+
+type importer struct {
+	table   string
+	columns []column
+	target  []string
+	rejects io.Writer
+	result  *ImportResult
+}
+
+func (i *importer) createStaging(ctx context.Context, tx pgx.Tx) error {
+	definitions := []string{lineColumn + " bigint NOT NULL"}
+	for _, c := range i.columns {
+		definitions = append(definitions, pgx.Identifier{c.name}.Sanitize()+" text")
+	}
+
+	_, err := tx.Exec(ctx, "CREATE TEMPORARY TABLE "+stagingTable+" ("+strings.Join(definitions, ", ")+") ON COMMIT DROP")
+	if err != nil {
+		return fmt.Errorf("failed to create staging table: %w", err)
+	}
+
+	return nil
+}
+
+// flush copies the batch to the staging table and inserts it into the table.
+func (i *importer) flush(ctx context.Context, tx pgx.Tx, batch []record) error {
+	names := []string{lineColumn}
+	for _, c := range i.columns {
+		names = append(names, c.name)
+	}
+
+	_, err := tx.CopyFrom(ctx, pgx.Identifier{stagingTable}, names, pgx.CopyFromSlice(len(batch), func(n int) ([]any, error) {
+		row := make([]any, 0, len(names))
+		row = append(row, batch[n].line)
+		for _, value := range batch[n].values {
+			row = append(row, value)
+		}
+		return row, nil
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to copy rows: %w", err)
+	}
+
+	if err := i.insert(ctx, tx, ""); err != nil {
+		// find the invalid rows, inserting them one by one
+		for _, rec := range batch {
+			if err := i.insert(ctx, tx, "WHERE "+lineColumn+" = $1", rec.line); err != nil {
+				if err := i.reject(rec.line, err, rec.values); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if _, err := tx.Exec(ctx, "TRUNCATE "+stagingTable); err != nil {
+		return fmt.Errorf("failed to clear staging table: %w", err)
+	}
+
+	return nil
+}
+
+// insert moves the staging rows to the table inside a savepoint, so a failure doesn't abort the transaction.
+func (i *importer) insert(ctx context.Context, tx pgx.Tx, where string, args ...any) error {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+
+	tag, err := savepoint.Exec(ctx, i.insertSQL(where), args...)
+	if err != nil {
+		_ = savepoint.Rollback(ctx)
+		return err
+	}
+
+	if err := savepoint.Commit(ctx); err != nil {
+		return err
+	}
+
+	i.result.Imported += tag.RowsAffected()
+
+	return nil
+}
+
+func (i *importer) insertSQL(where string) string {
+	names := make([]string, 0, len(i.columns))
+	values := make([]string, 0, len(i.columns))
+	overriding := ""
+
+	for _, c := range i.columns {
+		name := pgx.Identifier{c.name}.Sanitize()
+		names = append(names, name)
+		values = append(values, name+"::"+c.dataType)
+		if c.identity {
+			overriding = " OVERRIDING SYSTEM VALUE"
+		}
+	}
+
+	if where != "" {
+		where = " " + where
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (%s)%s SELECT %s FROM %s%s ORDER BY %s",
+		tableIdentifier(i.table), strings.Join(names, ", "), overriding, strings.Join(values, ", "), stagingTable, where, lineColumn)
+
+	if len(i.target) == 0 {
+		return query
+	}
+
+	targets := make([]string, 0, len(i.target))
+	for _, target := range i.target {
+		targets = append(targets, pgx.Identifier{target}.Sanitize())
+	}
+
+	var updates []string
+	for _, c := range i.columns {
+		// the identity columns can't be updated
+		if c.identity || slices.Contains(i.target, c.name) {
+			continue
+		}
+		name := pgx.Identifier{c.name}.Sanitize()
+		updates = append(updates, name+" = EXCLUDED."+name)
+	}
+
+	if len(updates) == 0 {
+		return query + " ON CONFLICT (" + strings.Join(targets, ", ") + ") DO NOTHING"
+	}
+
+	return query + " ON CONFLICT (" + strings.Join(targets, ", ") + ") DO UPDATE SET " + strings.Join(updates, ", ")
+}
+
+// syncIdentities moves the identity sequences past the imported IDs, so the next inserts don't conflict.
+func (i *importer) syncIdentities(ctx context.Context, tx pgx.Tx) error {
+	for _, c := range i.columns {
+		if !c.identity {
+			continue
+		}
+
+		name := pgx.Identifier{c.name}.Sanitize()
+		_, err := tx.Exec(ctx, fmt.Sprintf("SELECT setval(pg_get_serial_sequence($1, $2), coalesce(max(%s), 1), max(%s) IS NOT NULL) FROM %s",
+			name, name, tableIdentifier(i.table)), tableIdentifier(i.table), c.name)
+		if err != nil {
+			return fmt.Errorf("failed to update sequence of %s: %w", c.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (i *importer) reject(line int64, reason error, values []*string) error {
+	i.result.Rejected++
+
+	if i.rejects == nil {
+		return nil
+	}
+
+	rejection := Rejection{Line: line, Error: reason.Error()}
+	if values != nil {
+		rejection.Row = make(map[string]*string, len(values))
+		for n, value := range values {
+			rejection.Row[i.columns[n].name] = value
+		}
+	}
+
+	if err := json.NewEncoder(i.rejects).Encode(rejection); err != nil {
+		return fmt.Errorf("failed to write rejected row: %w", err)
+	}
+
+	return nil
+}
+
+// parseError is a row that can't be read, the next rows can still be imported.
+type parseError struct {
+	line int64
+	err  error
+}
+
+func (e *parseError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.line, e.err)
+}
+
+type recordReader interface {
+	// header returns the imported columns
+	header() ([]string, error)
+	// next returns the values of the next row in the order of the columns
+	next(columns []string) (record, error)
+}
+
+// csvReader reads the rows like COPY in CSV format, an unquoted empty field is NULL and a quoted one is an empty
+// string. encoding/csv can't tell them apart, so the records are parsed here. Like encoding/csv the empty lines are
+// skipped and every record must have the fields of the header.
+type csvReader struct {
+	reader *bufio.Reader
+	// line is the count of lines read
+	line   int64
+	fields int
+}
+
+func newCSVReader(r io.Reader) *csvReader {
+	return &csvReader{reader: bufio.NewReader(r)}
+}
+
+func (r *csvReader) header() ([]string, error) {
+	fields, _, err := r.read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("the CSV file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	header := make([]string, len(fields))
+	for n, field := range fields {
+		if field != nil {
+			header[n] = *field
+		}
+	}
+	r.fields = len(header)
+
+	return header, nil
+}
+
+func (r *csvReader) next(_ []string) (record, error) {
+	values, line, err := r.read()
+	if err != nil {
+		return record{}, err
+	}
+
+	if len(values) != r.fields {
+		return record{}, &parseError{line: line, err: csv.ErrFieldCount}
+	}
+
+	return record{line: line, values: values}, nil
+}
+
+// read returns the fields of the next record and the line where it starts, the unquoted empty fields are nil. A
+// malformed record is skipped up to the end of its line.
+func (r *csvReader) read() ([]*string, int64, error) {
+	for {
+		fields, line, err := r.readRecord()
+		if err != nil {
+			return nil, line, err
+		}
+
+		// an empty line
+		if len(fields) == 1 && fields[0] == nil {
+			continue
+		}
+
+		return fields, line, nil
+	}
+}
+
+func (r *csvReader) readRecord() ([]*string, int64, error) {
+	line := r.line + 1
+
+	var fields []*string
+	var field strings.Builder
+	quoted, inQuotes, empty := false, false, true
+
+	endField := func() {
+		if quoted || field.Len() > 0 {
+			value := field.String()
+			fields = append(fields, &value)
+		} else {
+			fields = append(fields, nil)
+		}
+		field.Reset()
+		quoted, empty = false, true
+	}
+
+	fail := func(err error) ([]*string, int64, error) {
+		// skip the rest of the line, so the next records can be read
+		for {
+			c, _, readErr := r.reader.ReadRune()
+			if readErr != nil || c == '\n' {
+				if readErr == nil {
+					r.line++
+				}
+				break
+			}
+		}
+		return nil, line, &parseError{line: line, err: err}
+	}
+
+	for n := 0; ; n++ {
+		c, _, err := r.reader.ReadRune()
+		if errors.Is(err, io.EOF) {
+			if n == 0 {
+				return nil, line, io.EOF
+			}
+			if inQuotes {
+				return nil, line, &parseError{line: line, err: csv.ErrQuote}
+			}
+			// the last line doesn't end with a newline
+			r.line++
+			endField()
+			return fields, line, nil
+		}
+		if err != nil {
+			return nil, line, err
+		}
+
+		if inQuotes {
+			switch c {
+			case '"':
+				next, _, err := r.reader.ReadRune()
+				if err == nil && next == '"' {
+					field.WriteRune('"')
+					continue
+				}
+				if err == nil {
+					_ = r.reader.UnreadRune()
+				}
+				inQuotes = false
+			case '\n':
+				r.line++
+				field.WriteRune(c)
+			default:
+				field.WriteRune(c)
+			}
+			continue
+		}
+
+		switch c {
+		case ',':
+			endField()
+		case '\r':
+			next, _, err := r.reader.ReadRune()
+			if err == nil && next != '\n' {
+				_ = r.reader.UnreadRune()
+			}
+			r.line++
+			endField()
+			return fields, line, nil
+		case '\n':
+			r.line++
+			endField()
+			return fields, line, nil
+		case '"':
+			if !empty {
+				return fail(csv.ErrBareQuote)
+			}
+			quoted, inQuotes, empty = true, true, false
+		default:
+			if quoted {
+				return fail(csv.ErrQuote)
+			}
+			field.WriteRune(c)
+			empty = false
+		}
+	}
+}
+
+type jsonReader struct {
+	scanner *bufio.Scanner
+	line    int64
+	first   map[string]any
+}
+
+func (r *jsonReader) header() ([]string, error) {
+	for {
+		row, err := r.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("the NDJSON file is empty")
+			}
+			return nil, err
+		}
+		if row != nil {
+			r.first = row
+			return slices.Sorted(maps.Keys(row)), nil
+		}
+	}
+}
+
+func (r *jsonReader) next(columns []string) (record, error) {
+	row := r.first
+	r.first = nil
+
+	for row == nil {
+		var err error
+		if row, err = r.read(); err != nil {
+			return record{}, err
+		}
+	}
+
+	values := make([]*string, len(columns))
+	for key, value := range row {
+		n := slices.Index(columns, key)
+		if n < 0 {
+			return record{}, &parseError{line: r.line, err: fmt.Errorf("unknown column %s", key)}
+		}
+
+		text, err := jsonText(value)
+		if err != nil {
+			return record{}, &parseError{line: r.line, err: err}
+		}
+		values[n] = text
+	}
+
+	return record{line: r.line, values: values}, nil
+}
+
+// read returns the next object, or nil if the line is empty.
+func (r *jsonReader) read() (map[string]any, error) {
+	if !r.scanner.Scan() {
+		if err := r.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	r.line++
+
+	data := bytes.TrimSpace(r.scanner.Bytes())
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var row map[string]any
+	if err := decoder.Decode(&row); err != nil {
+		return nil, &parseError{line: r.line, err: err}
+	}
+	if row == nil {
+		return nil, &parseError{line: r.line, err: errors.New("the line isn't an object")}
+	}
+
+	return row, nil
+}
+
+// jsonText converts the JSON value to its text representation, that is cast to the column type by the database.
+func jsonText(value any) (*string, error) {
+	var text string
+
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		text = v
+	case json.Number:
+		text = v.String()
+	case bool:
+		text = fmt.Sprint(v)
+	default:
+		// objects and arrays are imported on json columns
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		text = string(data)
+	}
+
+	return &text, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package transfer
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+)
+
+// ParseFormat returns the format with the given name, or the one of the file extension if empty. CSV is
+// used if neither are set.
+func ParseFormat(name, filename string) (Format, error) {
+	if name == "" {
+		switch path.Ext(strings.TrimSuffix(filename, ".gz")) {
+		case ".ndjson", ".jsonl":
+			return FormatNDJSON, nil
+		default:
+			return FormatCSV, nil
+		}
+	}
+
+	switch format := Format(strings.ToLower(name)); format {
+	case FormatCSV, FormatNDJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unsupported format: %s", name)
+	}
+}
+
+// NewReader returns a reader that decompresses the input if it's gzipped.
+func NewReader(r io.Reader) (io.ReadCloser, error) {
+	buf := bufio.NewReader(r)
+
+	magic, err := buf.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		return gzip.NewReader(buf)
+	}
+
+	return io.NopCloser(buf), nil
+}
+
+type column struct {
+	name     string
+	dataType string
+	category string
+	nullable bool
+	identity bool
+	// generated columns can't be imported
+	generated bool
+}
+
+// tableColumns returns the columns of the table in their declared order.
+func tableColumns(ctx context.Context, pool *pgxpool.Pool, table string) ([]column, error) {
+	rows, err := pool.Query(ctx, `SELECT a.attname, format_type(a.atttypid, a.atttypmod), t.typcategory::text, NOT a.attnotnull,
+			a.attidentity <> '', a.attgenerated <> ''
+		FROM pg_attribute a JOIN pg_type t ON t.oid = a.atttypid
+		WHERE a.attrelid = to_regclass($1) AND a.attnum > 0 AND NOT a.attisdropped
+		ORDER BY a.attnum`, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read columns of %s: %w", table, err)
+	}
+
+	var columns []column
+	var c column
+	_, err = pgx.ForEachRow(rows, []any{&c.name, &c.dataType, &c.category, &c.nullable, &c.identity, &c.generated}, func() error {
+		columns = append(columns, c)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read columns of %s: %w", table, err)
+	}
+
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s not found", table)
+	}
+
+	return columns, nil
+}
+
+func findColumn(columns []column, name string) (column, bool) {
+	for _, c := range columns {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return column{}, false
+}
+
+// tableIdentifier quotes the table name, that can be qualified with the schema.
+func tableIdentifier(table string) string {
+	return pgx.Identifier(strings.Split(table, ".")).Sanitize()
+}
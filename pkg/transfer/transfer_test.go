@@ -0,0 +1,190 @@
+// Copyright 2023 codestation. All rights reserved.
+// Use of this source code is governed by a MIT-license
+// that can be found in the LICENSE file.
+
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.megpoid.dev/go-skel/pkg/repo/filter"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		format   Format
+	}{
+		{"", "", FormatCSV},
+		{"", "profiles.ndjson.gz", FormatNDJSON},
+		{"", "profiles.jsonl", FormatNDJSON},
+		{"", "profiles.csv.gz", FormatCSV},
+		{"NDJSON", "profiles.csv", FormatNDJSON},
+	}
+
+	for _, test := range tests {
+		format, err := ParseFormat(test.name, test.filename)
+		assert.NoError(t, err)
+		assert.Equal(t, test.format, format)
+	}
+
+	_, err := ParseFormat("xml", "")
+	assert.Error(t, err)
+}
+
+func TestParseWhere(t *testing.T) {
+	conditions, err := ParseWhere("last_name__eq=Doe&id__in=1,2&email__has=example%2Ecom")
+	require.NoError(t, err)
+	assert.Equal(t, []filter.Condition{
+		{Field: "email", Operation: filter.OperationHas, Value: "example.com"},
+		{Field: "id", Operation: filter.OperationIn, Value: "1,2"},
+		{Field: "last_name", Operation: filter.OperationEqual, Value: "Doe"},
+	}, conditions)
+
+	_, err = ParseWhere("last_name=Doe")
+	assert.Error(t, err)
+}
+
+func TestCopyTextWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := &copyTextWriter{w: &buf}
+
+	// the escape sequence can be split between writes
+	_, err := w.Write([]byte(`{"path":"C:\\\\temp\\`))
+	require.NoError(t, err)
+	_, err = w.Write([]byte(`\\n"}` + "\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"path":"C:\\temp\\n"}`+"\n", buf.String())
+}
+
+func TestNewReader(t *testing.T) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write([]byte("id,name\n"))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	for _, input := range []io.Reader{&buf, strings.NewReader("id,name\n")} {
+		r, err := NewReader(input)
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, "id,name\n", string(data))
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	reader := newCSVReader(strings.NewReader("id,name\n1,John\n2\n\n3,\n4,\"\"\n5,\"a,\"\"b\"\"\nc\"\r\n6,x\"y\n7,\"z\"w\n8,end"))
+
+	header, err := reader.header()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id", "name"}, header)
+
+	rec, err := reader.next(header)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), rec.line)
+	assert.Equal(t, "John", *rec.values[1])
+
+	_, err = reader.next(header)
+	var parseErr *parseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, int64(3), parseErr.line)
+
+	// the empty line is skipped, the unquoted empty field is NULL
+	rec, err = reader.next(header)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), rec.line)
+	assert.Nil(t, rec.values[1])
+
+	// the quoted empty field is an empty string
+	rec, err = reader.next(header)
+	require.NoError(t, err)
+	require.NotNil(t, rec.values[1])
+	assert.Equal(t, "", *rec.values[1])
+
+	rec, err = reader.next(header)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), rec.line)
+	assert.Equal(t, "a,\"b\"\nc", *rec.values[1])
+
+	// the malformed quotes are rejected, the next rows are still read
+	for _, line := range []int64{9, 10} {
+		_, err = reader.next(header)
+		require.ErrorAs(t, err, &parseErr)
+		assert.Equal(t, line, parseErr.line)
+	}
+
+	rec, err = reader.next(header)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), rec.line)
+	assert.Equal(t, "end", *rec.values[1])
+
+	_, err = reader.next(header)
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestJSONReader(t *testing.T) {
+	input := `{"id": 1, "name": "John", "data": {"admin": true}}
+
+{"id": 2, "name": null}
+{"id": 3, "nickname": "Jane"}
+not json
+`
+	reader := &jsonReader{scanner: bufio.NewScanner(strings.NewReader(input))}
+
+	header, err := reader.header()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"data", "id", "name"}, header)
+
+	rec, err := reader.next(header)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), rec.line)
+	assert.Equal(t, `{"admin":true}`, *rec.values[0])
+	assert.Equal(t, "1", *rec.values[1])
+
+	rec, err = reader.next(header)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), rec.line)
+	assert.Nil(t, rec.values[0])
+	assert.Nil(t, rec.values[2])
+
+	var parseErr *parseError
+	_, err = reader.next(header)
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, int64(4), parseErr.line)
+
+	_, err = reader.next(header)
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, int64(5), parseErr.line)
+
+	_, err = reader.next(header)
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestInsertSQL(t *testing.T) {
+	imp := &importer{
+		table: "public.profiles",
+		columns: []column{
+			{name: "id", dataType: "bigint", identity: true},
+			{name: "email", dataType: "email"},
+			{name: "first_name", dataType: "text"},
+		},
+	}
+
+	assert.Equal(t, `INSERT INTO "public"."profiles" ("id", "email", "first_name") OVERRIDING SYSTEM VALUE `+
+		`SELECT "id"::bigint, "email"::email, "first_name"::text FROM transfer_staging ORDER BY _transfer_line`, imp.insertSQL(""))
+
+	imp.target = []string{"email"}
+	assert.Equal(t, `INSERT INTO "public"."profiles" ("id", "email", "first_name") OVERRIDING SYSTEM VALUE `+
+		`SELECT "id"::bigint, "email"::email, "first_name"::text FROM transfer_staging WHERE _transfer_line = $1 ORDER BY _transfer_line `+
+		`ON CONFLICT ("email") DO UPDATE SET "first_name" = EXCLUDED."first_name"`, imp.insertSQL("WHERE _transfer_line = $1"))
+}